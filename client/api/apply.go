@@ -0,0 +1,48 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hyperhq/hyperd/engine"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// ApplyPods sends the pod specs to be applied, see daemon.ApplyPods.
+func (cli *Client) ApplyPods(specs interface{}, managedBy string, prune, recreate, dryRun bool) ([]*types.PodApplyResult, error) {
+	v := url.Values{}
+	if managedBy != "" {
+		v.Set("managedBy", managedBy)
+	}
+	if prune {
+		v.Set("prune", "yes")
+	}
+	if recreate {
+		v.Set("recreate", "yes")
+	}
+	if dryRun {
+		v.Set("dryRun", "yes")
+	}
+
+	body, _, err := readBody(cli.call("POST", "/pod/apply?"+v.Encode(), specs, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	out := engine.NewOutput()
+	remoteInfo, err := out.AddEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := out.Write(body); err != nil {
+		return nil, fmt.Errorf("Error reading remote info: %s", err)
+	}
+	out.Close()
+
+	var results []*types.PodApplyResult
+	if err := remoteInfo.GetJson("Results", &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
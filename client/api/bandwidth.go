@@ -0,0 +1,23 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+func (c *Client) SetPodBandwidth(podId, ifId string, bw *types.InterfaceBandwidth) error {
+	path := fmt.Sprintf("/pod/%s/interfaces/%s/bandwidth", podId, ifId)
+	r, code, err := readBody(c.call("PUT", path, bw, nil))
+
+	if code == http.StatusNoContent || code == http.StatusOK {
+		return nil
+	} else if code == http.StatusNotFound {
+		return fmt.Errorf("interface %s of pod %s not found", ifId, podId)
+	} else if err != nil {
+		return err
+	} else {
+		return fmt.Errorf("unexpect response code %d: %s", code, string(r))
+	}
+}
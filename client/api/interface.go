@@ -13,6 +13,7 @@ type APIInterface interface {
 	Login(auth dockertypes.AuthConfig, response *dockertypes.AuthResponse) (remove bool, err error)
 
 	Attach(container string, tty bool, stdin io.ReadCloser, stdout, stderr io.Writer) error
+	PortForward(podId string, port int32, conn io.ReadWriteCloser) error
 	CreateExec(containerId string, command []byte, tty bool) (string, error)
 	StartExec(containerId, execId string, tty bool, stdin io.ReadCloser, stdout, stderr io.Writer) error
 	ExecVM(podID string, command []byte, stdin io.ReadCloser, stdout, stderr io.Writer) error
@@ -38,12 +39,28 @@ type APIInterface interface {
 	PausePod(podId string) error
 	UnpausePod(podId string) error
 	KillPod(pod string, sig int) error
+	ApplyPods(specs interface{}, managedBy string, prune, recreate, dryRun bool) ([]*types.PodApplyResult, error)
 
 	// PortMapping APIs
 	ListPortMappings(podId string) ([]*types.PortMapping, error)
 	AddPortMappings(podId string, pms []*types.PortMapping) error
 	DeletePortMappings(podId string, pms []*types.PortMapping) error
 
+	// Service APIs
+	ListServices(podId string) ([]*types.UserService, error)
+	AddServices(podId string, srvs []*types.UserService) error
+	UpdateServices(podId string, srvs []*types.UserService) error
+	MergeServices(podId string, srvs []*types.UserService) error
+	DeleteServices(podId string, srvs []*types.UserService) error
+
+	SetPodBandwidth(podId, ifId string, bw *types.InterfaceBandwidth) error
+
+	// Network APIs
+	CreateNetwork(spec *types.Network) (*types.Network, error)
+	ListNetworks() ([]*types.Network, error)
+	InspectNetwork(name string) (*types.NetworkInfo, error)
+	RemoveNetwork(name string) error
+
 	Build(name string, hasBody bool, body io.Reader) (io.ReadCloser, string, error)
 	Commit(container, repo, author, message string, changes []string, pause bool) (string, error)
 	Load(body io.Reader, name string, refs map[string]string) (io.ReadCloser, string, error)
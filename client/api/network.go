@@ -0,0 +1,72 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+func (c *Client) CreateNetwork(spec *types.Network) (*types.Network, error) {
+	body, code, err := readBody(c.call("POST", "/network/create", spec, nil))
+	if err != nil {
+		return nil, err
+	} else if code != http.StatusCreated && code != http.StatusOK {
+		return nil, fmt.Errorf("unexpect response code %d: %s", code, string(body))
+	}
+
+	var n types.Network
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (c *Client) ListNetworks() ([]*types.Network, error) {
+	body, _, err := readBody(c.call("GET", "/network/list", nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var networks []*types.Network
+	if err := json.Unmarshal(body, &networks); err != nil {
+		return nil, err
+	}
+	return networks, nil
+}
+
+func (c *Client) InspectNetwork(name string) (*types.NetworkInfo, error) {
+	v := url.Values{}
+	v.Set("name", name)
+
+	body, code, err := readBody(c.call("GET", "/network/inspect?"+v.Encode(), nil, nil))
+	if code == http.StatusNotFound {
+		return nil, fmt.Errorf("network %s not found", name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var info types.NetworkInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (c *Client) RemoveNetwork(name string) error {
+	v := url.Values{}
+	v.Set("name", name)
+
+	body, code, err := readBody(c.call("DELETE", "/network?"+v.Encode(), nil, nil))
+	if code == http.StatusNoContent || code == http.StatusOK {
+		return nil
+	} else if code == http.StatusNotFound {
+		return fmt.Errorf("network %s not found", name)
+	} else if err != nil {
+		return err
+	} else {
+		return fmt.Errorf("unexpect response code %d: %s", code, string(body))
+	}
+}
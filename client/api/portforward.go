@@ -0,0 +1,17 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+)
+
+// PortForward tunnels the connection to the TCP port of the pod, it returns
+// once the tunnel is closed.
+func (cli *Client) PortForward(podId string, port int32, conn io.ReadWriteCloser) error {
+	v := url.Values{}
+	v.Set("port", strconv.Itoa(int(port)))
+
+	return cli.hijackRequest(fmt.Sprintf("pod/%s/portforward", podId), &v, true, conn, conn, nil)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+func (c *Client) ListServices(podId string) ([]*types.UserService, error) {
+	v := url.Values{}
+	v.Set("podId", podId)
+	body, _, err := readBody(c.call("GET", "/service/list?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var srvs []*types.UserService
+	if err = json.Unmarshal(body, &srvs); err != nil {
+		return nil, err
+	}
+	return srvs, nil
+}
+
+func (c *Client) AddServices(podId string, srvs []*types.UserService) error {
+	return c.modifyServices("POST", "/service/add", podId, srvs, url.Values{})
+}
+
+func (c *Client) UpdateServices(podId string, srvs []*types.UserService) error {
+	return c.modifyServices("POST", "/service/update", podId, srvs, url.Values{})
+}
+
+// MergeServices updates the services with the same addresses, and adds the
+// others, the rest of the services of the pod are kept.
+func (c *Client) MergeServices(podId string, srvs []*types.UserService) error {
+	v := url.Values{}
+	v.Set("merge", "1")
+	return c.modifyServices("POST", "/service/update", podId, srvs, v)
+}
+
+func (c *Client) DeleteServices(podId string, srvs []*types.UserService) error {
+	return c.modifyServices("DELETE", "/service", podId, srvs, url.Values{})
+}
+
+// modifyServices sends the services in the query, which is where the
+// service routes read them from.
+func (c *Client) modifyServices(method, path, podId string, srvs []*types.UserService, v url.Values) error {
+	data, err := json.Marshal(srvs)
+	if err != nil {
+		return err
+	}
+	v.Set("podId", podId)
+	v.Set("services", string(data))
+	_, _, err = readBody(c.call(method, path+"?"+v.Encode(), nil, nil))
+	return err
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+func TestModifyServicesQuery(t *testing.T) {
+	var (
+		method string
+		path   string
+		query  url.Values
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, query = r.Method, r.URL.Path, r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Result": "success"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient("tcp", strings.TrimPrefix(server.URL, "http://"), nil)
+	srvs := []*types.UserService{{
+		ServiceIP:   "10.10.0.24",
+		ServicePort: 2834,
+		Protocol:    "tcp",
+		Hosts:       []*types.UserServiceBackend{{HostIP: "192.168.23.2", HostPort: 2345}},
+	}}
+
+	for _, tc := range []struct {
+		call   func(string, []*types.UserService) error
+		method string
+		path   string
+		merge  string
+	}{
+		{c.AddServices, "POST", "/service/add", ""},
+		{c.UpdateServices, "POST", "/service/update", ""},
+		{c.MergeServices, "POST", "/service/update", "1"},
+		{c.DeleteServices, "DELETE", "/service", ""},
+	} {
+		if err := tc.call("pod1", srvs); err != nil {
+			t.Fatalf("%s %s: %v", tc.method, tc.path, err)
+		}
+		if method != tc.method || !strings.HasSuffix(path, tc.path) {
+			t.Fatalf("expected %s %s, got %s %s", tc.method, tc.path, method, path)
+		}
+		if podId := query.Get("podId"); podId != "pod1" {
+			t.Fatalf("%s: expected podId pod1, got %q", tc.path, podId)
+		}
+		if merge := query.Get("merge"); merge != tc.merge {
+			t.Fatalf("%s: expected merge %q, got %q", tc.path, tc.merge, merge)
+		}
+		var sent []*types.UserService
+		if err := json.Unmarshal([]byte(query.Get("services")), &sent); err != nil {
+			t.Fatalf("%s: failed to parse services %q: %v", tc.path, query.Get("services"), err)
+		}
+		if !reflect.DeepEqual(sent, srvs) {
+			t.Fatalf("%s: expected services %v, got %v", tc.path, srvs, sent)
+		}
+	}
+}
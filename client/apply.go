@@ -0,0 +1,145 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	apitype "github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+type applyOptions struct {
+	PodFile   []string `short:"f" long:"file" value-name:"[]" default-mask:"-" description:"Pod file in JSON or Yaml format, or a directory of them"`
+	Prune     bool     `long:"prune" default-mask:"-" description:"Remove the pods managed by the manager but not in the files"`
+	Recreate  bool     `long:"recreate" default-mask:"-" description:"Recreate the pods which could not be updated in place"`
+	ManagedBy string   `long:"managed-by" value-name:"\"\"" default-mask:"-" description:"Manager of the pods, default \"hyperctl\""`
+}
+
+func (cli *HyperClient) HyperCmdApply(args ...string) error {
+	var opts struct {
+		applyOptions
+		DryRun bool `long:"dry-run" default-mask:"-" description:"Only show the actions, without applying them"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default)
+	parser.Usage = "apply [OPTIONS]\n\nCreate, update or remove the pods to match the pod files"
+	if _, err := parser.ParseArgs(args); err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	results, err := cli.applyPods(&opts.applyOptions, opts.DryRun)
+	if err != nil {
+		return err
+	}
+	return cli.printApplyResults(results, false)
+}
+
+func (cli *HyperClient) HyperCmdDiff(args ...string) error {
+	var opts struct {
+		applyOptions
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default)
+	parser.Usage = "diff [OPTIONS]\n\nShow the changes to be made by applying the pod files"
+	if _, err := parser.ParseArgs(args); err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	results, err := cli.applyPods(&opts.applyOptions, true)
+	if err != nil {
+		return err
+	}
+	return cli.printApplyResults(results, true)
+}
+
+func (cli *HyperClient) applyPods(opts *applyOptions, dryRun bool) ([]*apitype.PodApplyResult, error) {
+	if len(opts.PodFile) == 0 {
+		return nil, fmt.Errorf("\"apply\" requires at least one pod file, please provide it with -f")
+	}
+
+	files, err := podFiles(opts.PodFile)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := make([]*apitype.UserPod, 0, len(files))
+	for _, f := range files {
+		ext := strings.ToLower(filepath.Ext(f))
+		body, err := cli.JsonFromFile(f, false, ext == ".yaml" || ext == ".yml", false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", f, err)
+		}
+		var spec apitype.UserPod
+		if err := json.Unmarshal([]byte(body), &spec); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", f, err)
+		}
+		// the pods are identified by the id, or the name of the file
+		if spec.Id == "" {
+			spec.Id = strings.TrimSuffix(filepath.Base(f), filepath.Ext(f))
+		}
+		specs = append(specs, &spec)
+	}
+
+	return cli.client.ApplyPods(specs, opts.ManagedBy, opts.Prune, opts.Recreate, dryRun)
+}
+
+// podFiles expands the directories to the JSON and Yaml files in them.
+func podFiles(paths []string) ([]string, error) {
+	files := []string{}
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			switch strings.ToLower(filepath.Ext(e.Name())) {
+			case ".json", ".yaml", ".yml":
+				if !e.IsDir() {
+					files = append(files, filepath.Join(path, e.Name()))
+				}
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func (cli *HyperClient) printApplyResults(results []*apitype.PodApplyResult, changes bool) error {
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+			fmt.Fprintf(cli.err, "pod %s: %s failed: %s\n", r.PodID, r.Action, r.Error)
+			continue
+		}
+		fmt.Fprintf(cli.out, "pod %s: %s\n", r.PodID, r.Action)
+		if changes {
+			for _, c := range r.Changes {
+				fmt.Fprintf(cli.out, "    %s %s %s\n", c.Action, c.Kind, c.Name)
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to apply %d of %d pods", failed, len(results))
+	}
+	return nil
+}
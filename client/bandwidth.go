@@ -0,0 +1,43 @@
+package client
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdBandwidth(args ...string) error {
+	var opts struct {
+		IngressRate  uint64 `long:"ingress-rate" value-name:"0" default-mask:"-" description:"Rate of the traffic to the pod in bits per second, not limited if 0"`
+		IngressBurst uint64 `long:"ingress-burst" value-name:"0" default-mask:"-" description:"Burst of the traffic to the pod in bytes"`
+		EgressRate   uint64 `long:"egress-rate" value-name:"0" default-mask:"-" description:"Rate of the traffic from the pod in bits per second, not limited if 0"`
+		EgressBurst  uint64 `long:"egress-burst" value-name:"0" default-mask:"-" description:"Burst of the traffic from the pod in bytes"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown)
+	parser.Usage = "bandwidth [OPTIONS] POD INTERFACE\n\nLimit the bandwidth of an interface of a running pod, the limits not specified are removed\n"
+
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if len(args) != 2 {
+		return errors.New("need a Pod Id and an interface Id as command parameters")
+	}
+
+	bw := &types.InterfaceBandwidth{
+		IngressRate:  opts.IngressRate,
+		IngressBurst: opts.IngressBurst,
+		EgressRate:   opts.EgressRate,
+		EgressBurst:  opts.EgressBurst,
+	}
+	if err = bw.Validate(); err != nil {
+		return err
+	}
+	return cli.client.SetPodBandwidth(args[0], args[1], bw)
+}
@@ -72,7 +72,13 @@ func (cli *HyperClient) getMethod(args ...string) (func(...string) error, bool)
 		if len(s) == 0 {
 			return nil, false
 		}
-		camelArgs[i] = strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
+		// the words of the commands like port-forward are joined
+		for _, w := range strings.Split(s, "-") {
+			if len(w) == 0 {
+				return nil, false
+			}
+			camelArgs[i] += strings.ToUpper(w[:1]) + strings.ToLower(w[1:])
+		}
 	}
 	methodName := "HyperCmd" + strings.Join(camelArgs, "")
 	method := reflect.ValueOf(cli).MethodByName(methodName)
@@ -43,7 +43,11 @@ type RunFlags struct {
 	CommonFlags
 	Attach bool `short:"a" long:"attach" default-mask:"-" description:"(from podfile) Attach the stdin, stdout and stderr to the container"`
 	Detach bool `short:"d" long:"detach" default-mask:"-" description:"(from cmdline) Not Attach the stdin, stdout and stderr to the container"`
-	Remove bool `long:"rm" default-mask:"-" description:"Automatically remove the pod when it exits"`
+	Remove bool `long:"rm" default-mask:"-" description:"Automatically remove the pod when it exits, the pod without a type runs as a job"`
+	TTL    int  `long:"ttl" value-name:"0" default-mask:"-" description:"Run the pod as a job, and remove the pod the seconds after it exits"`
+
+	Runtime  string `long:"runtime" value-name:"\"\"" default-mask:"-" description:"Boot the pod with the runtime profile of the hyperd config file"`
+	Priority int32  `long:"priority" value-name:"0" default-mask:"-" description:"The eviction priority of the pod, the pods with lower priority are evicted first under host pressure"`
 }
 
 func (cli *HyperClient) ParseCommonOptions(opts *CommonFlags, container bool, args ...string) ([]byte, error) {
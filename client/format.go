@@ -0,0 +1,77 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+// writeList writes the items, a slice, in the format. The table format,
+// which is the default, is written by table, json and yaml are the items
+// as the pod files, and the other formats, optionally prefixed with
+// "go-template=", are the templates executed for each item.
+func (cli *HyperClient) writeList(format string, items interface{}, table func(w io.Writer)) error {
+	switch format {
+	case "", "table":
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		table(w)
+		return w.Flush()
+	case "json":
+		data, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", data)
+		return nil
+	case "yaml":
+		data, err := yaml.Marshal(items)
+		if err != nil {
+			return err
+		}
+		_, err = cli.out.Write(data)
+		return err
+	}
+
+	tmpl, err := template.New("format").Parse(strings.TrimPrefix(format, "go-template="))
+	if err != nil {
+		return fmt.Errorf("invalid format %q: %v", format, err)
+	}
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("could not format %T", items)
+	}
+	for i := 0; i < v.Len(); i++ {
+		if err = tmpl.Execute(cli.out, v.Index(i).Interface()); err != nil {
+			return err
+		}
+		fmt.Fprintln(cli.out)
+	}
+	return nil
+}
+
+// unmarshalFile reads the JSON file, or the YAML file with the .yaml or
+// .yml extension, to v.
+func unmarshalFile(filename string, v interface{}) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, v)
+	default:
+		err = json.Unmarshal(data, v)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %v", filename, err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+func TestWriteList(t *testing.T) {
+	items := []*types.UserServiceBackend{
+		{HostIP: "192.168.0.2", HostPort: 80},
+		{HostIP: "192.168.0.3", HostPort: 8080, Weight: 2},
+	}
+	table := func(w io.Writer) {
+		fmt.Fprintln(w, "Backend\tWeight")
+		for _, b := range items {
+			fmt.Fprintf(w, "%s:%d\t%d\n", b.HostIP, b.HostPort, b.Weight)
+		}
+	}
+
+	for _, c := range []struct {
+		format   string
+		expected string
+		err      bool
+	}{
+		{
+			format: "",
+			expected: "Backend             Weight\n" +
+				"192.168.0.2:80      0\n" +
+				"192.168.0.3:8080    2\n",
+		},
+		{
+			format: "table",
+			expected: "Backend             Weight\n" +
+				"192.168.0.2:80      0\n" +
+				"192.168.0.3:8080    2\n",
+		},
+		{
+			format: "json",
+			expected: "[\n" +
+				"  {\n    \"hostIP\": \"192.168.0.2\",\n    \"hostPort\": 80\n  },\n" +
+				"  {\n    \"hostIP\": \"192.168.0.3\",\n    \"hostPort\": 8080,\n    \"weight\": 2\n  }\n" +
+				"]\n",
+		},
+		{
+			format: "yaml",
+			expected: "- hostip: 192.168.0.2\n  hostport: 80\n  weight: 0\n  state: \"\"\n  weightset: false\n" +
+				"- hostip: 192.168.0.3\n  hostport: 8080\n  weight: 2\n  state: \"\"\n  weightset: false\n",
+		},
+		{
+			format:   "{{.HostIP}}",
+			expected: "192.168.0.2\n192.168.0.3\n",
+		},
+		{
+			format:   "go-template={{.HostIP}} {{.HostPort}}",
+			expected: "192.168.0.2 80\n192.168.0.3 8080\n",
+		},
+		{
+			format: "{{.HostIP",
+			err:    true,
+		},
+		{
+			format: "{{.NoSuchField}}",
+			err:    true,
+		},
+	} {
+		var out bytes.Buffer
+		cli := &HyperClient{out: &out}
+		err := cli.writeList(c.format, items, table)
+		if c.err {
+			if err == nil {
+				t.Errorf("format %q: expected error, got output %q", c.format, out.String())
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("format %q: %v", c.format, err)
+			continue
+		}
+		if out.String() != c.expected {
+			t.Errorf("format %q: expected\n%q\ngot\n%q", c.format, c.expected, out.String())
+		}
+	}
+}
+
+func TestUnmarshalFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hyperctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	expected := []*types.UserService{{
+		ServiceIP:   "10.10.0.24",
+		ServicePort: 2834,
+		Protocol:    "tcp",
+		Hosts:       []*types.UserServiceBackend{{HostIP: "192.168.23.2", HostPort: 2345}},
+	}}
+	jsonData := `[{"serviceIP": "10.10.0.24", "servicePort": 2834, "protocol": "tcp",
+		"hosts": [{"hostIP": "192.168.23.2", "hostPort": 2345}]}]`
+	yamlData := "- serviceip: 10.10.0.24\n  serviceport: 2834\n  protocol: tcp\n" +
+		"  hosts:\n  - hostip: 192.168.23.2\n    hostport: 2345\n"
+
+	for _, c := range []struct {
+		name string
+		data string
+		err  bool
+	}{
+		{name: "services.json", data: jsonData},
+		{name: "services", data: jsonData},
+		{name: "services.yaml", data: yamlData},
+		{name: "services.YML", data: yamlData},
+		{name: "invalid.json", data: yamlData, err: true},
+		{name: "invalid.yaml", data: "- serviceip: [", err: true},
+		{name: "missing.json", err: true},
+	} {
+		file := filepath.Join(dir, c.name)
+		if c.data != "" {
+			if err := ioutil.WriteFile(file, []byte(c.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var srvs []*types.UserService
+		err := unmarshalFile(file, &srvs)
+		if c.err {
+			if err == nil {
+				t.Errorf("%s: expected error, got %v", c.name, srvs)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(srvs, expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, expected, srvs)
+		}
+	}
+}
@@ -10,10 +10,13 @@ func (cli *HyperClient) HyperCmdHelp(args ...string) error {
   %s [OPTIONS] COMMAND [ARGS...]
 
 Command:
+  apply                  Create, update or remove pods to match the pod files
   attach                 Attach to the input/output of a specified container
+  bandwidth              Limit the bandwidth of an interface of a running pod
   build                  Build an image from a Dockerfile
   commit                 Create a new image from a container's changes
   create                 Create a pod or create a container in a pod
+  diff                   Show the changes to be made by apply
   exec                   Run a command in a specified container
   images                 List images
   info                   Display system-wide information
@@ -21,14 +24,17 @@ Command:
   load                   Load a image from STDIN or tar archive file
   login                  Register or log in to a Docker registry server
   logout                 Log out from a Docker registry server
+  network                List, create, inspect or remove named networks
   pause                  Pause a running pod
-  ports                  Show or modify port mapping rules
+  port-forward           Forward local ports to the ports of a pod
+  portmapping            Show or modify port mapping rules
   pull                   Pull an image from a Docker registry server
   push                   Push an image or a repository to a Docker registry server
   rm                     Remove one or more pods or containers
   rmi                    Remove one or more images
   run                    Create a pod, and launch the new pod
   save                   Save one or more images to a tar archive (streamed to STDOUT by default)
+  service                List, add, update or remove the services of a pod
   start                  Start a pod or container
   stop                   Stop a running pod or container
   unpause                Unpause a paused pod
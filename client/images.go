@@ -2,9 +2,9 @@ package client
 
 import (
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
-	"text/tabwriter"
 	"time"
 
 	"github.com/docker/go-units"
@@ -13,10 +13,19 @@ import (
 	gflag "github.com/jessevdk/go-flags"
 )
 
+type imageListItem struct {
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+	ID         string `json:"id"`
+	Created    int64  `json:"created"`
+	Size       int64  `json:"size"`
+}
+
 func (cli *HyperClient) HyperCmdImages(args ...string) error {
 	var opts struct {
-		All   bool `short:"a" long:"all" description:"Show all images (by default filter out the intermediate image layers)"`
-		Quiet bool `short:"q" long:"quiet" description:"Only show numeric IDs"`
+		All    bool   `short:"a" long:"all" description:"Show all images (by default filter out the intermediate image layers)"`
+		Quiet  bool   `short:"q" long:"quiet" description:"Only show numeric IDs"`
+		Format string `short:"f" long:"format" value-name:"table" default-mask:"-" description:"Output format: table, json, yaml, or a go-template executed for each image, e.g. '{{.Repository}}:{{.Tag}}'"`
 	}
 	var parser = gflag.NewParser(&opts, gflag.Default)
 
@@ -40,31 +49,32 @@ func (cli *HyperClient) HyperCmdImages(args ...string) error {
 	)
 	imagesList = remoteInfo.GetList("imagesList")
 
-	w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
-	if opts.Quiet == false {
-		fmt.Fprintln(w, "REPOSITORY\tTAG\tIMAGE ID\tCREATED\tVIRTUAL SIZE")
-		for _, item := range imagesList {
-			fields := utils.RsplitN(item, ":", 5)
-			date, _ := strconv.ParseUint(fields[3], 0, 64)
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", fields[0], fields[1], fields[2][:12], time.Unix(int64(date), 0).Format("2006-01-02 15:04:05"), getImageSizeString(fields[4]))
-		}
-	} else {
+	if opts.Quiet {
 		for _, item := range imagesList {
 			fields := utils.RsplitN(item, ":", 5)
-			fmt.Fprintf(w, "%s\n", fields[2][:12])
+			fmt.Fprintf(cli.out, "%s\n", fields[2][:12])
 		}
+		return nil
 	}
-	w.Flush()
 
-	return nil
-}
-
-func getImageSizeString(size string) string {
-	s, err := strconv.Atoi(size)
-	if err != nil {
-		return ""
+	images := make([]imageListItem, 0, len(imagesList))
+	for _, item := range imagesList {
+		fields := utils.RsplitN(item, ":", 5)
+		date, _ := strconv.ParseInt(fields[3], 0, 64)
+		size, _ := strconv.ParseInt(fields[4], 10, 64)
+		images = append(images, imageListItem{
+			Repository: fields[0],
+			Tag:        fields[1],
+			ID:         fields[2],
+			Created:    date,
+			Size:       size,
+		})
 	}
-
-	rtn := float64(s)
-	return units.HumanSize(rtn)
+	return cli.writeList(opts.Format, images, func(w io.Writer) {
+		fmt.Fprintln(w, "REPOSITORY\tTAG\tIMAGE ID\tCREATED\tVIRTUAL SIZE")
+		for _, img := range images {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", img.Repository, img.Tag, img.ID[:12],
+				time.Unix(img.Created, 0).Format("2006-01-02 15:04:05"), units.HumanSize(float64(img.Size)))
+		}
+	})
 }
@@ -2,9 +2,11 @@ package client
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/docker/go-units"
+	apitype "github.com/hyperhq/hyperd/types"
 
 	gflag "github.com/jessevdk/go-flags"
 )
@@ -49,9 +51,45 @@ func (cli *HyperClient) HyperCmdInfo(args ...string) error {
 	fmt.Fprintf(cli.out, "Total Memory: %s\n", memTotal)
 	fmt.Fprintf(cli.out, "Operating System: %s\n", remoteInfo.Get("Operating System"))
 
+	var capacity apitype.HostCapacity
+	if remoteInfo.Exists("Capacity") && remoteInfo.GetJson("Capacity", &capacity) == nil {
+		fmt.Fprintf(cli.out, "Capacity:\n")
+		fmt.Fprintf(cli.out, "  CPU: %d committed, %s remaining of %s vcpus\n", capacity.CpuCommitted,
+			capacityString(int64(capacity.CpuRemaining)), capacityString(int64(capacity.Cpu)))
+		fmt.Fprintf(cli.out, "  Memory: %dMB committed, %s remaining of %s MB\n", capacity.MemoryCommitted,
+			capacityString(capacity.MemoryRemaining), capacityString(capacity.Memory))
+	}
+
+	var conflicts []*apitype.IPConflict
+	if remoteInfo.Exists("IPConflicts") && remoteInfo.GetJson("IPConflicts", &conflicts) == nil {
+		fmt.Fprintf(cli.out, "IP Conflicts:\n")
+		for _, c := range conflicts {
+			network := c.Network
+			if network == "" {
+				network = "default"
+			}
+			fmt.Fprintf(cli.out, "  %s (%s): %s\n", c.Ip, network, strings.Join(c.Pods, ", "))
+		}
+	}
+
+	var missing []*apitype.IPAllocation
+	if remoteInfo.Exists("MissingNetworks") && remoteInfo.GetJson("MissingNetworks", &missing) == nil {
+		fmt.Fprintf(cli.out, "Missing Networks:\n")
+		for _, a := range missing {
+			fmt.Fprintf(cli.out, "  %s (%s): %s/%s\n", a.Ip, a.Network, a.Pod, a.Interface)
+		}
+	}
+
 	return nil
 }
 
+func capacityString(c int64) string {
+	if c < 0 {
+		return "unlimited"
+	}
+	return strconv.FormatInt(c, 10)
+}
+
 func getMemSizeString(s int) string {
 	rtn := float64(s)
 	return units.HumanSize(rtn)
@@ -2,18 +2,38 @@ package client
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
-	"text/tabwriter"
 
 	gflag "github.com/jessevdk/go-flags"
 )
 
+type vmListItem struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type podListItem struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	VM     string `json:"vm"`
+	Status string `json:"status"`
+}
+
+type containerListItem struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Pod    string `json:"pod"`
+	Status string `json:"status"`
+}
+
 func (cli *HyperClient) HyperCmdList(args ...string) error {
 	var opts struct {
-		Pod   string `short:"p" long:"pod" value-name:"\"\"" description:"only list the specified pod"`
-		VM    string `short:"m" long:"vm" value-name:"\"\"" description:"only list resources on the specified vm"`
-		Quiet bool   `short:"q" long:"quiet" value-name:"\"\"" description:"Quiet mode"`
+		Pod    string `short:"p" long:"pod" value-name:"\"\"" description:"only list the specified pod"`
+		VM     string `short:"m" long:"vm" value-name:"\"\"" description:"only list resources on the specified vm"`
+		Quiet  bool   `short:"q" long:"quiet" value-name:"\"\"" description:"Quiet mode"`
+		Format string `short:"f" long:"format" value-name:"table" default-mask:"-" description:"Output format: table, json, yaml, or a go-template executed for each item, e.g. '{{.ID}}'"`
 	}
 
 	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown)
@@ -54,7 +74,6 @@ func (cli *HyperClient) HyperCmdList(args ...string) error {
 		return fmt.Errorf("Found an error while getting %s list: %s", item, remoteInfo.Get("Error"))
 	}
 
-	w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
 	if item == "vm" {
 		vmResponse = remoteInfo.GetList("vmData")
 	}
@@ -67,55 +86,65 @@ func (cli *HyperClient) HyperCmdList(args ...string) error {
 
 	//fmt.Printf("Item is %s\n", item)
 	if item == "vm" {
-		if !opts.Quiet {
-			fmt.Fprintln(w, "VM name\tStatus")
-		}
-
+		vms := make([]*vmListItem, 0, len(vmResponse))
 		for _, vm := range vmResponse {
 			fields := strings.Split(vm, ":")
-			if opts.Quiet {
-				fmt.Fprintf(w, "%s\n", fields[0])
-			} else {
-				fmt.Fprintf(w, "%s\t%s\n", fields[0], fields[2])
+			vms = append(vms, &vmListItem{Name: fields[0], Status: fields[2]})
+		}
+		if opts.Quiet {
+			for _, vm := range vms {
+				fmt.Fprintf(cli.out, "%s\n", vm.Name)
 			}
+			return nil
 		}
+		return cli.writeList(opts.Format, vms, func(w io.Writer) {
+			fmt.Fprintln(w, "VM name\tStatus")
+			for _, vm := range vms {
+				fmt.Fprintf(w, "%s\t%s\n", vm.Name, vm.Status)
+			}
+		})
 	}
 
 	if item == "pod" {
-		if !opts.Quiet {
-			fmt.Fprintln(w, "POD ID\tPOD Name\tVM name\tStatus")
-		}
-
+		pods := make([]*podListItem, 0, len(podResponse))
 		for _, p := range podResponse {
 			fields := strings.Split(p, ":")
-			if opts.Quiet {
-				fmt.Fprintf(w, "%s\n", fields[0])
-			} else {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", fields[0], fields[1], fields[2], fields[3])
+			pods = append(pods, &podListItem{ID: fields[0], Name: fields[1], VM: fields[2], Status: fields[3]})
+		}
+		if opts.Quiet {
+			for _, p := range pods {
+				fmt.Fprintf(cli.out, "%s\n", p.ID)
 			}
+			return nil
 		}
+		return cli.writeList(opts.Format, pods, func(w io.Writer) {
+			fmt.Fprintln(w, "POD ID\tPOD Name\tVM name\tStatus")
+			for _, p := range pods {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.ID, p.Name, p.VM, p.Status)
+			}
+		})
 	}
 
-	if item == "container" {
-		if !opts.Quiet {
-			fmt.Fprintln(w, "Container ID\tName\tPOD ID\tStatus")
-		}
-
-		for _, c := range containerResponse {
-			fields := strings.Split(c, ":")
-			if opts.Quiet {
-				fmt.Fprintf(w, "%s\n", fields[0])
-			} else {
-				name := fields[1]
-				if len(name) > 0 {
-					if name[0] == '/' {
-						name = name[1:]
-					}
-				}
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", fields[0], name, fields[2], fields[3])
-			}
+	containers := make([]*containerListItem, 0, len(containerResponse))
+	for _, c := range containerResponse {
+		fields := strings.Split(c, ":")
+		containers = append(containers, &containerListItem{
+			ID:     fields[0],
+			Name:   strings.TrimPrefix(fields[1], "/"),
+			Pod:    fields[2],
+			Status: fields[3],
+		})
+	}
+	if opts.Quiet {
+		for _, c := range containers {
+			fmt.Fprintf(cli.out, "%s\n", c.ID)
 		}
+		return nil
 	}
-	w.Flush()
-	return nil
+	return cli.writeList(opts.Format, containers, func(w io.Writer) {
+		fmt.Fprintln(w, "Container ID\tName\tPOD ID\tStatus")
+		for _, c := range containers {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.ID, c.Name, c.Pod, c.Status)
+		}
+	})
 }
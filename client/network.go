@@ -0,0 +1,122 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdNetwork(args ...string) error {
+	var opts struct {
+		Subnet     string   `long:"subnet" value-name:"\"\"" default-mask:"-" description:"Subnet of the network in CIDR format (only valid for create)"`
+		Gateway    string   `long:"gateway" value-name:"\"\"" default-mask:"-" description:"Address of the bridge, the first address of the subnet by default (only valid for create)"`
+		Bridge     string   `long:"bridge" value-name:"\"\"" default-mask:"-" description:"Bridge device of the network, \"hy-<name>\" by default (only valid for create)"`
+		Mtu        uint64   `long:"mtu" value-name:"0" default-mask:"-" description:"MTU of the bridge and the interfaces (only valid for create)"`
+		Masquerade bool     `long:"masquerade" default-mask:"-" description:"Masquerade the traffic leaving the network (only valid for create)"`
+		Labels     []string `short:"l" long:"label" value-name:"[]" default-mask:"-" description:"Add labels for the network (only valid for create)"`
+		Format     string   `short:"f" long:"format" value-name:"table" default-mask:"-" description:"Output format: table, json, yaml, or a go-template executed for each network, e.g. '{{.Subnet}}' (only valid for ls)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "network ls|create|inspect|rm [OPTIONS] [NETWORK]\n\nList, create, inspect or remove the named networks\n"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	switch cmd {
+	case "ls":
+		networks, err := cli.client.ListNetworks()
+		if err != nil {
+			return err
+		}
+		return cli.writeList(opts.Format, networks, func(w io.Writer) {
+			fmt.Fprintln(w, "Name\tSubnet\tGateway\tBridge\tMasquerade\tCreated")
+			for _, n := range networks {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\t%s\n", n.Name, n.Subnet, n.Gateway, n.Bridge, n.Masquerade,
+					time.Unix(n.Created, 0).Format(time.RFC3339))
+			}
+		})
+	case "create":
+		if len(args) != 1 {
+			return errors.New("need a network name as command parameter")
+		}
+		if opts.Subnet == "" {
+			return errors.New("the subnet of the network is required")
+		}
+		spec := &types.Network{
+			Name:       args[0],
+			Subnet:     opts.Subnet,
+			Gateway:    opts.Gateway,
+			Bridge:     opts.Bridge,
+			Mtu:        opts.Mtu,
+			Masquerade: opts.Masquerade,
+			Labels:     make(map[string]string),
+		}
+		for _, label := range opts.Labels {
+			parts := strings.SplitN(label, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("incorrect label %s, the format is key=value", label)
+			}
+			spec.Labels[parts[0]] = parts[1]
+		}
+		n, err := cli.client.CreateNetwork(spec)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "network %s is created on bridge %s, subnet %s\n", n.Name, n.Bridge, n.Subnet)
+		return nil
+	case "inspect":
+		if len(args) != 1 {
+			return errors.New("need a network name as command parameter")
+		}
+		info, err := cli.client.InspectNetwork(args[0])
+		if err != nil {
+			return err
+		}
+		n := info.Network
+		fmt.Fprintf(cli.out, "Name:       %s\nSubnet:     %s\nGateway:    %s\nBridge:     %s\nMTU:        %d\nMasquerade: %v\n",
+			n.Name, n.Subnet, n.Gateway, n.Bridge, n.Mtu, n.Masquerade)
+		for k, v := range n.Labels {
+			fmt.Fprintf(cli.out, "Label:      %s=%s\n", k, v)
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "\nPod\tInterface\tIP")
+		for _, ep := range info.Endpoints {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", ep.PodID, ep.InterfaceID, ep.Ip)
+		}
+		w.Flush()
+		return nil
+	case "rm":
+		if len(args) == 0 {
+			return errors.New("need a network name as command parameter")
+		}
+		for _, name := range args {
+			if err := cli.client.RemoveNetwork(name); err != nil {
+				fmt.Fprintf(cli.err, "network %s delete failed: %v\n", name, err)
+			} else {
+				fmt.Fprintf(cli.out, "network %s is successfully deleted!\n", name)
+			}
+		}
+		return nil
+	default:
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	gflag "github.com/jessevdk/go-flags"
+)
+
+type portForward struct {
+	local  string
+	remote int32
+}
+
+func (cli *HyperClient) HyperCmdPortForward(args ...string) error {
+	var opts struct {
+		Address string `long:"address" value-name:"127.0.0.1" default:"127.0.0.1" description:"Address to listen on for the local ports"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown)
+	parser.Usage = "port-forward [OPTIONS] POD [LOCAL_PORT:]REMOTE_PORT [...]\n\nForward the local ports to the ports of a running pod, until interrupted\n"
+
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if len(args) < 2 {
+		return errors.New("need a Pod Id and the ports as command parameters")
+	}
+	podId := args[0]
+
+	forwards := make([]portForward, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		pf, err := parsePortForward(arg)
+		if err != nil {
+			return err
+		}
+		forwards = append(forwards, pf)
+	}
+
+	listeners := make([]net.Listener, 0, len(forwards))
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+	for _, pf := range forwards {
+		l, err := net.Listen("tcp", net.JoinHostPort(opts.Address, pf.local))
+		if err != nil {
+			return err
+		}
+		listeners = append(listeners, l)
+		fmt.Fprintf(cli.out, "Forwarding from %s -> %d\n", l.Addr(), pf.remote)
+		go cli.forwardPort(l, podId, pf.remote)
+	}
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+	<-sigchan
+	return nil
+}
+
+// forwardPort tunnels the connections accepted on the listener to the
+// port of the pod, until the listener is closed.
+func (cli *HyperClient) forwardPort(l net.Listener, podId string, port int32) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			if err := cli.client.PortForward(podId, port, conn); err != nil {
+				fmt.Fprintf(cli.err, "failed to forward %s to port %d: %v\n", conn.RemoteAddr(), port, err)
+			}
+		}()
+	}
+}
+
+// parsePortForward parses [LOCAL_PORT:]REMOTE_PORT, the local port is the
+// same as the remote one if omitted, and a random one if 0.
+func parsePortForward(arg string) (portForward, error) {
+	local, remote := arg, arg
+	if parts := strings.SplitN(arg, ":", 2); len(parts) == 2 {
+		local, remote = parts[0], parts[1]
+	}
+	rp, err := strconv.ParseUint(remote, 10, 16)
+	if err != nil || rp == 0 {
+		return portForward{}, fmt.Errorf("incorrect remote port in %s", arg)
+	}
+	if _, err := strconv.ParseUint(local, 10, 16); err != nil {
+		return portForward{}, fmt.Errorf("incorrect local port in %s", arg)
+	}
+	return portForward{local: local, remote: int32(rp)}, nil
+}
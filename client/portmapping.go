@@ -3,19 +3,26 @@ package client
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/hyperhq/hyperd/types"
 	gflag "github.com/jessevdk/go-flags"
 )
 
+// HyperCmdPorts is the former name of the portmapping command.
 func (cli *HyperClient) HyperCmdPorts(args ...string) error {
+	return cli.HyperCmdPortmapping(args...)
+}
+
+func (cli *HyperClient) HyperCmdPortmapping(args ...string) error {
 	var opts struct {
-		Portmap []string `short:"p" long:"publish" value-name:"[]" default-mask:"-" description:"Publish a container's port to the host, format: -p|--publish [tcp/udp:]hostPort:containerPort (only valid for add and delete)"`
+		Portmap []string `short:"p" long:"publish" value-name:"[]" default-mask:"-" description:"Publish a container's port to the host, format: -p|--publish [tcp/udp:]hostPort:containerPort (only valid for add and rm)"`
+		File    string   `long:"file" value-name:"\"\"" default-mask:"-" description:"Read the rules from a JSON or YAML (.yaml/.yml) file of a list of port mappings (only valid for add and rm)"`
+		Format  string   `short:"f" long:"format" value-name:"table" default-mask:"-" description:"Output format: table, json, yaml, or a go-template executed for each rule, e.g. '{{.HostPort}}' (only valid for ls)"`
 	}
 	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
-	parser.Usage = "ports ls|add|delete [OPTIONS] POD\n\nList or modify port mapping rules of a Pod\n"
+	parser.Usage = "portmapping ls|add|rm [OPTIONS] POD\n\nList or modify port mapping rules of a Pod\n"
 
 	if len(args) == 0 {
 		parser.WriteHelp(cli.err)
@@ -43,16 +50,15 @@ func (cli *HyperClient) HyperCmdPorts(args ...string) error {
 		if err != nil {
 			return err
 		}
-		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
-		fmt.Fprintln(w, "Protocol\tHost Ports\tContainer Ports")
-		for _, pm := range pms {
-			fmt.Fprintf(w, "%s\t%s\t%s\n", pm.Protocol, pm.HostPort, pm.ContainerPort)
-		}
-		w.Flush()
-		return nil
+		return cli.writeList(opts.Format, pms, func(w io.Writer) {
+			fmt.Fprintln(w, "Protocol\tHost Ports\tContainer Ports")
+			for _, pm := range pms {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", pm.Protocol, pm.HostPort, pm.ContainerPort)
+			}
+		})
 	case "add":
 		modFunc = cli.client.AddPortMappings
-	case "delete":
+	case "rm", "delete":
 		modFunc = cli.client.DeletePortMappings
 	default:
 		parser.WriteHelp(cli.err)
@@ -62,11 +68,13 @@ func (cli *HyperClient) HyperCmdPorts(args ...string) error {
 	if len(args) != 1 {
 		return errors.New("need a Pod Id as command parameter")
 	}
-	if len(opts.Portmap) == 0 {
-		return errors.New("no rules to be add or delete")
-	}
 
 	pms := make([]*types.PortMapping, 0, len(opts.Portmap))
+	if opts.File != "" {
+		if err = unmarshalFile(opts.File, &pms); err != nil {
+			return err
+		}
+	}
 	for _, o := range opts.Portmap {
 		pm, err := parsePortMapping(o)
 		if err != nil {
@@ -74,6 +82,9 @@ func (cli *HyperClient) HyperCmdPorts(args ...string) error {
 		}
 		pms = append(pms, pm)
 	}
+	if len(pms) == 0 {
+		return errors.New("no rules to be add or delete")
+	}
 
 	err = modFunc(args[0], pms)
 	if err != nil {
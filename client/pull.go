@@ -60,8 +60,8 @@ func (cli *HyperClient) PullImage(imageName string) error {
 }
 
 func (cli *HyperClient) PullImages(spec *apitype.UserPod) error {
-	for i := range spec.Containers {
-		if err := cli.PullImage(spec.Containers[i].Image); err != nil {
+	for _, c := range spec.AllContainers() {
+		if err := cli.PullImage(c.Image); err != nil {
 			return err
 		}
 	}
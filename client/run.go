@@ -52,6 +52,29 @@ func (cli *HyperClient) HyperCmdRun(args ...string) error {
 		return err
 	}
 
+	// the job pod is removed by hyperd once it exits, the pods of the other
+	// types are removed by the client as before
+	removeByClient := false
+	if opts.Remove || opts.TTL > 0 {
+		if spec.Type == "" {
+			spec.Type = "job"
+		}
+		if spec.Type == "job" {
+			spec.AutoRemove = opts.Remove
+			spec.TtlAfterFinished = int32(opts.TTL)
+		} else if opts.TTL > 0 {
+			return fmt.Errorf("--ttl is only valid for the job pods, the pod type is %s", spec.Type)
+		} else {
+			removeByClient = true
+		}
+	}
+	if opts.Runtime != "" {
+		spec.Runtime = &apitype.UserPodRuntime{Profile: opts.Runtime}
+	}
+	if opts.Priority != 0 {
+		spec.Priority = opts.Priority
+	}
+
 	podId, code, err = cli.client.CreatePod(&spec)
 	if err != nil {
 		if code == http.StatusNotFound {
@@ -72,7 +95,7 @@ func (cli *HyperClient) HyperCmdRun(args ...string) error {
 		fmt.Printf("POD id is %s\n", podId)
 	}
 
-	if opts.Remove {
+	if removeByClient {
 		defer func() {
 			rmerr := cli.client.RmPod(podId)
 			if rmerr != nil {
@@ -106,6 +129,12 @@ func (cli *HyperClient) HyperCmdRun(args ...string) error {
 
 	err = cli.client.StartPod(podId)
 	if err != nil {
+		// the job pod never finishes if it fails to start, remove it here
+		if opts.Remove && !removeByClient {
+			if rmerr := cli.client.RmPod(podId); rmerr != nil {
+				fmt.Fprintf(cli.out, "failed to rm pod, %v\n", rmerr)
+			}
+		}
 		return err
 	}
 
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdService(args ...string) error {
+	var opts struct {
+		ServiceIP          string   `long:"ip" value-name:"\"\"" default-mask:"-" description:"IP address of the service"`
+		Port               int32    `long:"port" value-name:"0" default-mask:"-" description:"Port of the service"`
+		Protocol           string   `long:"protocol" value-name:"tcp" default-mask:"-" description:"Protocol of the service, tcp or udp"`
+		Name               string   `long:"name" value-name:"\"\"" default-mask:"-" description:"Name of the service resolved in the pod (only valid for add and update)"`
+		Backends           []string `short:"b" long:"backend" value-name:"[]" default-mask:"-" description:"Backend of the service, format: -b|--backend ip:port[:weight], the weight 0 drains the backend (only valid for add and update)"`
+		Scheduler          string   `long:"scheduler" value-name:"rr" default-mask:"-" description:"IPVS scheduler, rr|wrr|lc|wlc|lblc|lblcr|dh|sh|sed|nq (only valid for add and update)"`
+		PersistenceTimeout int32    `long:"persistence" value-name:"0" default-mask:"-" description:"Seconds the connections of a client stick to the same backend (only valid for add and update)"`
+		ForwardMode        string   `long:"forward-mode" value-name:"masquerading" default-mask:"-" description:"masquerading, gatewaying or tunneling (only valid for add and update)"`
+		HealthCheck        bool     `long:"health-check" default-mask:"-" description:"Probe the TCP ports of the backends, the unhealthy ones are weighted to 0 (only valid for add and update)"`
+		CheckInterval      int32    `long:"check-interval" value-name:"10" default-mask:"-" description:"Seconds between the health checks"`
+		CheckTimeout       int32    `long:"check-timeout" value-name:"1" default-mask:"-" description:"Seconds to wait for the connection of a health check"`
+		UnhealthyThreshold int32    `long:"unhealthy-threshold" value-name:"3" default-mask:"-" description:"Consecutive failed checks to be unhealthy"`
+		HealthyThreshold   int32    `long:"healthy-threshold" value-name:"1" default-mask:"-" description:"Consecutive successful checks to be healthy again"`
+		File               string   `long:"file" value-name:"\"\"" default-mask:"-" description:"Read the services from a JSON or YAML (.yaml/.yml) file of a list of services (only valid for add, update and rm)"`
+		Format             string   `short:"f" long:"format" value-name:"table" default-mask:"-" description:"Output format: table, json, yaml, or a go-template executed for each service, e.g. '{{.ServiceIP}}' (only valid for ls)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "service ls|add|update|rm [OPTIONS] POD\n\nList, add, update or remove the services of a pod, the services with the same address are replaced on update\n"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if cmd != "ls" && cmd != "add" && cmd != "update" && cmd != "rm" {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	if len(args) != 1 {
+		return errors.New("need a Pod Id as command parameter")
+	}
+	podId := args[0]
+
+	if cmd == "ls" {
+		srvs, err := cli.client.ListServices(podId)
+		if err != nil {
+			return err
+		}
+		return cli.writeList(opts.Format, srvs, func(w io.Writer) {
+			fmt.Fprintln(w, "Name\tService\tProtocol\tScheduler\tBackend\tWeight\tState")
+			for _, srv := range srvs {
+				scheduler := srv.Scheduler
+				if scheduler == "" {
+					scheduler = "rr"
+				}
+				address := net.JoinHostPort(srv.ServiceIP, strconv.Itoa(int(srv.ServicePort)))
+				if len(srv.Hosts) == 0 {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\t\t\n", srv.Name, address, srv.Protocol, scheduler)
+				}
+				for _, b := range srv.Hosts {
+					weight := b.Weight
+					if weight == 0 && !b.WeightSet {
+						weight = 1
+					}
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n", srv.Name, address, srv.Protocol, scheduler,
+						net.JoinHostPort(b.HostIP, strconv.Itoa(int(b.HostPort))), weight, b.State)
+				}
+			}
+		})
+	}
+
+	srvs := []*types.UserService{}
+	if opts.File != "" {
+		if err = unmarshalFile(opts.File, &srvs); err != nil {
+			return err
+		}
+	}
+	if opts.ServiceIP != "" || opts.Port != 0 {
+		if opts.ServiceIP == "" || opts.Port == 0 {
+			return errors.New("both the IP address and the port of the service are required")
+		}
+		srv := &types.UserService{
+			ServiceIP:          opts.ServiceIP,
+			ServicePort:        opts.Port,
+			Protocol:           opts.Protocol,
+			Name:               opts.Name,
+			Scheduler:          opts.Scheduler,
+			PersistenceTimeout: opts.PersistenceTimeout,
+			ForwardMode:        opts.ForwardMode,
+		}
+		if srv.Protocol == "" {
+			srv.Protocol = "tcp"
+		}
+		for _, o := range opts.Backends {
+			b, err := parseServiceBackend(o)
+			if err != nil {
+				return fmt.Errorf("failed to parse backend %s: %v", o, err)
+			}
+			srv.Hosts = append(srv.Hosts, b)
+		}
+		if opts.HealthCheck {
+			srv.HealthCheck = &types.ServiceHealthCheck{
+				Interval:           opts.CheckInterval,
+				Timeout:            opts.CheckTimeout,
+				UnhealthyThreshold: opts.UnhealthyThreshold,
+				HealthyThreshold:   opts.HealthyThreshold,
+			}
+		}
+		srvs = append(srvs, srv)
+	}
+	if len(srvs) == 0 {
+		return errors.New("no service to be added, updated or removed, specify it with --ip and --port, or --file")
+	}
+	for _, srv := range srvs {
+		if cmd == "rm" {
+			continue
+		}
+		if err = srv.Validate(); err != nil {
+			return err
+		}
+	}
+
+	switch cmd {
+	case "add":
+		return cli.client.AddServices(podId, srvs)
+	case "rm":
+		return cli.client.DeleteServices(podId, srvs)
+	}
+	// the services with the same addresses are replaced by hyperd, and the
+	// others are kept
+	return cli.client.MergeServices(podId, srvs)
+}
+
+// parseServiceBackend parses the backend in the format ip:port[:weight].
+func parseServiceBackend(backend string) (*types.UserServiceBackend, error) {
+	fields := strings.Split(backend, ":")
+	if len(fields) != 2 && len(fields) != 3 {
+		return nil, errors.New("the format is ip:port[:weight]")
+	}
+	if net.ParseIP(fields[0]) == nil {
+		return nil, fmt.Errorf("invalid IP address %s", fields[0])
+	}
+	port, err := strconv.ParseUint(fields[1], 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid port %s", fields[1])
+	}
+	b := &types.UserServiceBackend{
+		HostIP:   fields[0],
+		HostPort: int32(port),
+	}
+	if len(fields) == 3 {
+		weight, err := strconv.ParseInt(fields[2], 10, 32)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight %s", fields[2])
+		}
+		b.Weight = int32(weight)
+		b.WeightSet = true
+	}
+	return b, nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hyperhq/hyperd/client/api"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// fakeServiceAPI records the services sent by the service commands.
+type fakeServiceAPI struct {
+	api.APIInterface
+
+	op   string
+	pod  string
+	srvs []*types.UserService
+}
+
+func (f *fakeServiceAPI) record(op, podId string, srvs []*types.UserService) error {
+	f.op, f.pod, f.srvs = op, podId, srvs
+	return nil
+}
+
+func (f *fakeServiceAPI) AddServices(podId string, srvs []*types.UserService) error {
+	return f.record("add", podId, srvs)
+}
+
+func (f *fakeServiceAPI) UpdateServices(podId string, srvs []*types.UserService) error {
+	return f.record("update", podId, srvs)
+}
+
+func (f *fakeServiceAPI) MergeServices(podId string, srvs []*types.UserService) error {
+	return f.record("merge", podId, srvs)
+}
+
+func (f *fakeServiceAPI) DeleteServices(podId string, srvs []*types.UserService) error {
+	return f.record("rm", podId, srvs)
+}
+
+func TestServiceFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hyperctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "services.yaml")
+	data := "- serviceip: 10.10.0.24\n  serviceport: 2834\n  protocol: tcp\n" +
+		"  hosts:\n  - hostip: 192.168.23.2\n    hostport: 2345\n"
+	if err := ioutil.WriteFile(file, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := ioutil.WriteFile(invalid, []byte(`[{"serviceIP": "10.10.0.25", "servicePort": 80, "scheduler": "none"}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fromFile := &types.UserService{
+		ServiceIP:   "10.10.0.24",
+		ServicePort: 2834,
+		Protocol:    "tcp",
+		Hosts:       []*types.UserServiceBackend{{HostIP: "192.168.23.2", HostPort: 2345}},
+	}
+	fromFlags := &types.UserService{
+		ServiceIP:   "10.10.0.25",
+		ServicePort: 53,
+		Protocol:    "udp",
+		Hosts:       []*types.UserServiceBackend{{HostIP: "192.168.23.3", HostPort: 53, Weight: 0, WeightSet: true}},
+	}
+
+	for _, c := range []struct {
+		args     []string
+		op       string
+		expected []*types.UserService
+		err      bool
+	}{
+		{
+			args:     []string{"add", "--file", file, "pod1"},
+			op:       "add",
+			expected: []*types.UserService{fromFile},
+		},
+		{
+			// the services are merged by hyperd on update
+			args:     []string{"update", "--file", file, "pod1"},
+			op:       "merge",
+			expected: []*types.UserService{fromFile},
+		},
+		{
+			args:     []string{"update", "--file", file, "--ip", "10.10.0.25", "--port", "53", "--protocol", "udp", "-b", "192.168.23.3:53:0", "pod1"},
+			op:       "merge",
+			expected: []*types.UserService{fromFile, fromFlags},
+		},
+		{
+			args:     []string{"rm", "--file", file, "pod1"},
+			op:       "rm",
+			expected: []*types.UserService{fromFile},
+		},
+		{
+			args: []string{"add", "--file", invalid, "pod1"},
+			err:  true,
+		},
+		{
+			args: []string{"add", "--file", filepath.Join(dir, "missing.json"), "pod1"},
+			err:  true,
+		},
+		{
+			args: []string{"update", "pod1"},
+			err:  true,
+		},
+		{
+			args: []string{"add", "--ip", "10.10.0.25", "pod1"},
+			err:  true,
+		},
+	} {
+		f := &fakeServiceAPI{}
+		cli := &HyperClient{client: f, out: ioutil.Discard, err: ioutil.Discard}
+		err := cli.HyperCmdService(c.args...)
+		if c.err {
+			if err == nil {
+				t.Errorf("%v: expected error, got %s of %v", c.args, f.op, f.srvs)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", c.args, err)
+			continue
+		}
+		if f.op != c.op || f.pod != "pod1" || !reflect.DeepEqual(f.srvs, c.expected) {
+			t.Errorf("%v: expected %s of %v to pod1, got %s of %v to %s", c.args, c.op, c.expected, f.op, f.srvs, f.pod)
+		}
+	}
+}
@@ -3,8 +3,10 @@ package main
 import (
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/docker/docker/pkg/reexec"
 	"github.com/golang/glog"
 	"github.com/hyperhq/hyperd/daemon"
+	"github.com/hyperhq/hyperd/lib/metrics"
 	"github.com/hyperhq/hyperd/server"
 	"github.com/hyperhq/hyperd/serverrpc"
 	"github.com/hyperhq/hyperd/types"
@@ -101,7 +104,20 @@ func mainDaemon(opt *Options) {
 	if c == nil {
 		return
 	}
-	c.DisableIptables = c.DisableIptables || opt.DisableIptables
+	// the command line options are applied again on config reload
+	override := func(c *types.HyperConfig) {
+		c.DisableIptables = c.DisableIptables || opt.DisableIptables
+		c.RegistryMirrors = append(c.RegistryMirrors, strings.Split(opt.Mirrors, ",")...)
+		c.InsecureRegistries = append(c.InsecureRegistries, strings.Split(opt.InsecureRegistries, ",")...)
+		// the verbosity in command line takes precedence
+		if flagIsSet("v") {
+			c.LogVerbosity = -1
+		}
+	}
+	override(c)
+	if c.LogVerbosity >= 0 {
+		flag.Set("v", strconv.Itoa(c.LogVerbosity))
+	}
 
 	c.AdvertiseEnv()
 	if _, err := os.Stat(c.Root); err != nil {
@@ -111,12 +127,13 @@ func mainDaemon(opt *Options) {
 		}
 	}
 
-	daemon.InitDockerCfg(strings.Split(opt.Mirrors, ","), strings.Split(opt.InsecureRegistries, ","), c.StorageDriver, c.StorageBaseSize, c.Root)
+	daemon.InitDockerCfg(c.RegistryMirrors, c.InsecureRegistries, c.StorageDriver, c.StorageBaseSize, c.Root)
 	d, err := daemon.NewDaemon(c)
 	if err != nil {
 		glog.Errorf("The hyperd create failed, %s", err.Error())
 		return
 	}
+	d.ConfigOverride = override
 
 	// Set the daemon object as the global varibal
 	// which will be used for puller and builder
@@ -127,6 +144,10 @@ func mainDaemon(opt *Options) {
 		return
 	}
 
+	d.ImageGC.Start()
+	d.JobReaper.Start()
+	d.Eviction.Start()
+
 	serverConfig := &server.Config{}
 
 	defaultHost := "unix:///var/run/hyper.sock"
@@ -175,6 +196,17 @@ func mainDaemon(opt *Options) {
 		}()
 	}
 
+	if c.MetricsHost != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metrics.Handler())
+			glog.V(1).Infof("serving metrics at %s", c.MetricsHost)
+			if err := http.ListenAndServe(c.MetricsHost, mux); err != nil {
+				glog.Errorf("Hyper serve metrics error: %v", err)
+			}
+		}()
+	}
+
 	// The serve API routine never exits unless an error occurs
 	// We need to start it as a goroutine and wait on it so
 	// daemon doesn't exit
@@ -183,8 +215,16 @@ func mainDaemon(opt *Options) {
 
 	stopAll := make(chan os.Signal, 1)
 	signal.Notify(stopAll, syscall.SIGINT, syscall.SIGTERM)
+	// SIGHUP stops the daemon but keeps the VMs running, unless SIGHUP is
+	// configured to reload the config file, then SIGUSR2 stops the daemon
 	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, syscall.SIGHUP)
+	reload := make(chan os.Signal, 1)
+	if c.ReloadOnSighup {
+		signal.Notify(stop, syscall.SIGUSR2)
+		signal.Notify(reload, syscall.SIGHUP)
+	} else {
+		signal.Notify(stop, syscall.SIGHUP)
+	}
 
 	glog.V(0).Infof("Hyper daemon: %s %s", utils.VERSION, utils.GITCOMMIT)
 
@@ -197,27 +237,50 @@ func mainDaemon(opt *Options) {
 
 	// Daemon is fully initialized and handling API traffic
 	// Wait for serve API job to complete
-	select {
-	case errAPI := <-serveAPIWait:
-		// If we have an error here it is unique to API (as daemonErr would have
-		// exited the daemon process above)
-		if errAPI != nil {
-			glog.Warningf("Shutting down due to ServeAPI error: %v", errAPI)
+	for running := true; running; {
+		select {
+		case errAPI := <-serveAPIWait:
+			// If we have an error here it is unique to API (as daemonErr would have
+			// exited the daemon process above)
+			if errAPI != nil {
+				glog.Warningf("Shutting down due to ServeAPI error: %v", errAPI)
+			}
+			stopServer()
+			running = false
+		case <-reload:
+			// the reload runs off the signal loop, which keeps handling
+			// the stop signals meanwhile
+			go func() {
+				resp, err := d.ReloadConfig()
+				if err != nil {
+					glog.Errorf("failed to reload config: %v", err)
+				} else {
+					glog.Infof("config reloaded, applied: %v, rejected: %v", resp.Applied, resp.Rejected)
+				}
+			}()
+		case <-stop:
+			stopServer()
+			d.DestroyAndKeepVm()
+			running = false
+		case <-stopAll:
+			stopServer()
+			d.DestroyAllVm()
+			running = false
 		}
-		stopServer()
-		break
-	case <-stop:
-		stopServer()
-		d.DestroyAndKeepVm()
-		break
-	case <-stopAll:
-		stopServer()
-		d.DestroyAllVm()
-		break
 	}
 	d.Shutdown()
 }
 
+func flagIsSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
 func checkKernel(k, major, minor int) error {
 	leastVersionInfo := kernel.VersionInfo{
 		Kernel: k,
@@ -0,0 +1,171 @@
+package daemon
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/errors"
+	"github.com/hyperhq/hyperd/lib/sysinfo"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// the sandbox size of the pods without resource, the same as startSandbox
+const (
+	defaultPodCPU    = 1
+	defaultPodMemory = 128
+)
+
+// maxPods is the limit of the pods with a sandbox, which still applies if
+// the overcommit ratios are not set.
+const maxPods = 1024
+
+// Admission accounts the vcpus and memory committed by the pods with a
+// sandbox against the allocatable capacity of the host, and rejects the
+// pods which do not fit. The capacity is the allocatable resource times
+// the overcommit ratio, a zero ratio means the resource is unlimited, but
+// the number of the pods is limited by maxPods.
+type Admission struct {
+	daemon *Daemon
+
+	lock   sync.Mutex
+	cpu    int
+	memory int64
+	// the pods admitted but whose sandboxes are not booted yet
+	pending map[string]*apitypes.UserResource
+}
+
+func newAdmission(daemon *Daemon, c *apitypes.HyperConfig) *Admission {
+	a := &Admission{
+		daemon:  daemon,
+		pending: make(map[string]*apitypes.UserResource),
+	}
+	a.update(c)
+	return a
+}
+
+// update recalculates the capacity with the config.
+func (a *Admission) update(c *apitypes.HyperConfig) {
+	cpu := c.AllocatableCPU
+	if cpu == 0 {
+		cpu = sysinfo.GetCpuNum()
+	}
+	memory := int64(c.AllocatableMemory)
+	if memory == 0 {
+		if meminfo, err := sysinfo.GetMemInfo(); err == nil {
+			memory = int64(meminfo.MemTotal >> 10)
+		} else {
+			glog.Warningf("failed to get the host memory: %v", err)
+		}
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.cpu, a.memory = -1, -1
+	if c.CPUOvercommitRatio > 0 {
+		a.cpu = int(float64(cpu) * c.CPUOvercommitRatio)
+	}
+	if c.MemoryOvercommitRatio > 0 && memory > 0 {
+		a.memory = int64(float64(memory) * c.MemoryOvercommitRatio)
+	}
+	glog.Infof("allocatable capacity of pods: cpu %d, memory %dMB", a.cpu, a.memory)
+}
+
+func podResource(r *apitypes.UserResource) (int, int64) {
+	cpu, memory := defaultPodCPU, int64(defaultPodMemory)
+	if r != nil && r.Vcpu > 0 {
+		cpu = int(r.Vcpu)
+	}
+	if r != nil && r.Memory > 0 {
+		memory = int64(r.Memory)
+	}
+	return cpu, memory
+}
+
+// committed sums the resources of the pods with a sandbox and the pending
+// ones, and counts them, the lock should be held.
+func (a *Admission) committed() (cpu int, memory int64, pods int) {
+	for _, r := range a.pending {
+		c, m := podResource(r)
+		cpu, memory, pods = cpu+c, memory+m, pods+1
+	}
+	a.daemon.PodList.Foreach(func(p *pod.XPod) error {
+		if _, ok := a.pending[p.Id()]; ok || p.IsStopped() {
+			return nil
+		}
+		c, m := podResource(p.Resource())
+		cpu, memory, pods = cpu+c, memory+m, pods+1
+		return nil
+	})
+	return cpu, memory, pods
+}
+
+// Admit reserves the resources of the pod before booting its sandbox, or
+// returns ErrInsufficientCapacity if the pod does not fit. The release
+// should be called once the sandbox is booted or failed to boot.
+func (a *Admission) Admit(id string, r *apitypes.UserResource) (release func(), err error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if _, ok := a.pending[id]; ok {
+		return nil, fmt.Errorf("pod %s is being admitted", id)
+	}
+	cpu, memory := podResource(r)
+	committedCPU, committedMemory, pods := a.committed()
+	if pods >= maxPods {
+		return nil, errors.ErrInsufficientCapacity.WithArgs(id,
+			fmt.Sprintf("%d pods with a sandbox reach the limit", pods))
+	}
+	if a.cpu >= 0 && committedCPU+cpu > a.cpu {
+		return nil, errors.ErrInsufficientCapacity.WithArgs(id,
+			fmt.Sprintf("requests %d vcpus, %d of %d vcpus remaining", cpu, remaining(a.cpu, committedCPU), a.cpu))
+	}
+	if a.memory >= 0 && committedMemory+memory > a.memory {
+		return nil, errors.ErrInsufficientCapacity.WithArgs(id,
+			fmt.Sprintf("requests %dMB memory, %dMB of %dMB remaining", memory, remaining64(a.memory, committedMemory), a.memory))
+	}
+
+	a.pending[id] = r
+	return func() {
+		a.lock.Lock()
+		delete(a.pending, id)
+		a.lock.Unlock()
+	}, nil
+}
+
+// Capacity returns the capacity and the committed resources of the host.
+func (a *Admission) Capacity() *apitypes.HostCapacity {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	cpu, memory, _ := a.committed()
+	return &apitypes.HostCapacity{
+		Cpu:             int32(a.cpu),
+		CpuCommitted:    int32(cpu),
+		CpuRemaining:    int32(remaining(a.cpu, cpu)),
+		Memory:          a.memory,
+		MemoryCommitted: memory,
+		MemoryRemaining: remaining64(a.memory, memory),
+	}
+}
+
+func remaining(capacity, committed int) int {
+	if capacity < 0 {
+		return -1
+	}
+	if committed > capacity {
+		return 0
+	}
+	return capacity - committed
+}
+
+func remaining64(capacity, committed int64) int64 {
+	if capacity < 0 {
+		return -1
+	}
+	if committed > capacity {
+		return 0
+	}
+	return capacity - committed
+}
@@ -0,0 +1,177 @@
+package daemon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/docker/distribution/registry/api/errcode"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/glog"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/errors"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	// APPLY_SPEC_HASH_LABEL is the hash of the spec the pod was applied with
+	APPLY_SPEC_HASH_LABEL = "sh.hyper.apply.spec-hash"
+	// APPLY_MANAGED_BY_LABEL is the manager of the pods which could be pruned
+	APPLY_MANAGED_BY_LABEL = "sh.hyper.apply.managed-by"
+
+	DefaultApplyManager = "hyperctl"
+
+	// the actions reported by ApplyPods
+	APPLY_CREATE    = "create"
+	APPLY_UPDATE    = "update"
+	APPLY_RECREATE  = "recreate"
+	APPLY_REMOVE    = "remove"
+	APPLY_UNCHANGED = "unchanged"
+)
+
+// ApplyPods makes the pods match the specs: the missing pods are created
+// and started, and the pods whose spec hash label differs are updated in
+// place, or recreated if recreate is set and the update is refused. With
+// prune, the pods labeled with the manager but absent in the specs are
+// removed. A failure of one pod is reported in its result, and the others
+// are applied anyway. With dryRun, the actions are only reported.
+func (daemon *Daemon) ApplyPods(specs []*apitypes.UserPod, managedBy string, prune, recreate, dryRun bool) ([]*apitypes.PodApplyResult, error) {
+	if managedBy == "" {
+		managedBy = DefaultApplyManager
+	}
+
+	applied := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		if spec == nil || spec.Id == "" {
+			return nil, fmt.Errorf("the id of the pods to apply is required")
+		}
+		if applied[spec.Id] {
+			return nil, fmt.Errorf("pod %s is applied more than once", spec.Id)
+		}
+		applied[spec.Id] = true
+	}
+
+	results := make([]*apitypes.PodApplyResult, 0, len(specs))
+	for _, spec := range specs {
+		r := daemon.applyPod(spec, managedBy, recreate, dryRun)
+		if r.Error != "" {
+			glog.Errorf("%s: failed to apply pod: %s", spec.Id, r.Error)
+		}
+		results = append(results, r)
+	}
+
+	if prune {
+		pruned := []string{}
+		daemon.PodList.Foreach(func(p *pod.XPod) error {
+			if !applied[p.Id()] && p.Labels()[APPLY_MANAGED_BY_LABEL] == managedBy {
+				pruned = append(pruned, p.Id())
+			}
+			return nil
+		})
+		sort.Strings(pruned)
+		for _, id := range pruned {
+			r := &apitypes.PodApplyResult{PodID: id, Action: APPLY_REMOVE}
+			if !dryRun {
+				glog.Infof("%s: pruning pod which is not applied by %s", id, managedBy)
+				if _, _, err := daemon.RemovePod(id); err != nil {
+					r.Error = err.Error()
+				}
+			}
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+func (daemon *Daemon) applyPod(spec *apitypes.UserPod, managedBy string, recreate, dryRun bool) *apitypes.PodApplyResult {
+	r := &apitypes.PodApplyResult{PodID: spec.Id}
+	hash, err := applySpecHash(spec)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	if spec.Labels == nil {
+		spec.Labels = make(map[string]string)
+	}
+	// the hash label is set after the pod is applied successfully, so that
+	// a pod failed to be applied is applied again next time
+	delete(spec.Labels, APPLY_SPEC_HASH_LABEL)
+	spec.Labels[APPLY_MANAGED_BY_LABEL] = managedBy
+
+	p, ok := daemon.PodList.Get(spec.Id)
+	if !ok {
+		r.Action = APPLY_CREATE
+		if !dryRun {
+			err = daemon.createAndStartPod(spec)
+		}
+	} else if current := p.Labels()[APPLY_SPEC_HASH_LABEL]; current == hash {
+		r.Action = APPLY_UNCHANGED
+	} else {
+		r.Action = APPLY_UPDATE
+		if current != "" {
+			spec.Labels[APPLY_SPEC_HASH_LABEL] = current
+		}
+		r.Changes, err = daemon.UpdatePod(spec.Id, spec, dryRun)
+		if err != nil && recreate && needRecreate(err) {
+			r.Action, r.Changes = APPLY_RECREATE, nil
+			err = nil
+			delete(spec.Labels, APPLY_SPEC_HASH_LABEL)
+			if !dryRun {
+				glog.Infof("%s: recreating pod which could not be updated in place", spec.Id)
+				if _, _, err = daemon.RemovePod(spec.Id); err == nil {
+					err = daemon.createAndStartPod(spec)
+				}
+			}
+		}
+	}
+	if err == nil && !dryRun && r.Action != APPLY_UNCHANGED {
+		err = daemon.setSpecHash(spec.Id, hash)
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	return r
+}
+
+// setSpecHash labels the pod with the hash of the spec it is applied with.
+func (daemon *Daemon) setSpecHash(id, hash string) error {
+	p, ok := daemon.PodList.Get(id)
+	if !ok {
+		return errors.ErrPodNotFound.WithArgs(id)
+	}
+	return p.SetLabel(map[string]string{APPLY_SPEC_HASH_LABEL: hash}, true)
+}
+
+func (daemon *Daemon) createAndStartPod(spec *apitypes.UserPod) error {
+	p, err := daemon.CreatePod(spec.Id, spec)
+	if err != nil {
+		return err
+	}
+	return daemon.StartPod(p.Id())
+}
+
+// needRecreate tells whether the update failed because the pod should be
+// recreated, i.e. the update is refused, or the pod is not running.
+func needRecreate(err error) bool {
+	derr, ok := err.(errcode.Error)
+	if !ok {
+		return false
+	}
+	return derr.ErrorCode() == errors.ErrPodUpdateRefused || derr.ErrorCode() == errors.ErrPodNotRunning
+}
+
+// applySpecHash returns the hash of the spec without the labels of apply.
+func applySpecHash(spec *apitypes.UserPod) (string, error) {
+	s := proto.Clone(spec).(*apitypes.UserPod)
+	delete(s.Labels, APPLY_SPEC_HASH_LABEL)
+	delete(s.Labels, APPLY_MANAGED_BY_LABEL)
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,178 @@
+package daemon
+
+import (
+	goflag "flag"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/networking/networks"
+	"github.com/hyperhq/hyperd/networking/policy"
+	"github.com/hyperhq/hyperd/networking/portmapping"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// configItem is a key of the config file and the fields of HyperConfig it
+// is parsed to. The item is applied to the running daemon on reload with
+// apply, a nil apply means the item requires restarting the daemon.
+type configItem struct {
+	key    string
+	fields []string
+	apply  func(daemon *Daemon, c *apitypes.HyperConfig) error
+}
+
+var configItems = []configItem{
+	{key: "Root", fields: []string{"Root"}},
+	{key: "Host", fields: []string{"Host"}},
+	{key: "gRPCHost", fields: []string{"GRPCHost"}},
+	{key: "MetricsHost", fields: []string{"MetricsHost"}},
+	{key: "StorageDriver", fields: []string{"StorageDriver"}},
+	{key: "StorageBaseSize", fields: []string{"StorageBaseSize"}},
+	{key: "Hypervisor", fields: []string{"Driver"}},
+	{key: "Kernel", fields: []string{"Kernel"}},
+	{key: "Initrd", fields: []string{"Initrd"}},
+	{key: "Runtime", fields: []string{"Runtimes"}},
+	{key: "Bridge", fields: []string{"Bridge"}},
+	{key: "BridgeIP", fields: []string{"BridgeIP"}},
+	{key: "NetworkPlugin", fields: []string{"NetworkPlugin"}},
+	{key: "CniConfDir", fields: []string{"CniConfDir"}},
+	{key: "CniBinDir", fields: []string{"CniBinDir"}},
+	{key: "DisableDns", fields: []string{"DisableDns"}},
+	{key: "DnsDomain", fields: []string{"DnsDomain"}},
+	{key: "DnsUpstreams", fields: []string{"DnsUpstreams"}},
+	{key: "EnableVsock", fields: []string{"EnableVsock"}},
+	{key: "GDBTCPPort", fields: []string{"GDBTCPPort"}},
+	{key: "ReloadOnSighup", fields: []string{"ReloadOnSighup"}},
+	{key: "ImageGCHighThreshold", fields: []string{"ImageGCHighThreshold"}},
+	{key: "ImageGCLowThreshold", fields: []string{"ImageGCLowThreshold"}},
+	{key: "ImageGCInterval", fields: []string{"ImageGCInterval"}},
+	{key: "ImageGCMinAge", fields: []string{"ImageGCMinAge"}},
+	{key: "EvictionMemoryAvailable", fields: []string{"EvictionMemoryAvailable"}},
+	{key: "EvictionStorageThreshold", fields: []string{"EvictionStorageThreshold"}},
+	{key: "EvictionLogThreshold", fields: []string{"EvictionLogThreshold"}},
+	{key: "EvictionInterval", fields: []string{"EvictionInterval"}},
+	{key: "Logger", fields: []string{"DefaultLog"}, apply: applyDefaultLog},
+	{key: "Log", fields: []string{"DefaultLogOpt"}, apply: applyDefaultLog},
+	{key: "LogVerbosity", fields: []string{"LogVerbosity"}, apply: applyLogVerbosity},
+	{key: "VmFactoryPolicy", fields: []string{"VmFactoryPolicy"}, apply: applyVmFactoryPolicy},
+	{key: "DisableIptables", fields: []string{"DisableIptables"}, apply: applyDisableIptables},
+	{key: "UserlandProxy", fields: []string{"UserlandProxy"}, apply: applyUserlandProxy},
+	{key: "Capacity", fields: []string{"AllocatableCPU", "AllocatableMemory", "CPUOvercommitRatio", "MemoryOvercommitRatio"}, apply: applyCapacity},
+	{key: "Registry", fields: []string{"RegistryMirrors", "InsecureRegistries", "Registries"}, apply: applyRegistry},
+}
+
+func (item *configItem) changed(running, c *apitypes.HyperConfig) bool {
+	rv, cv := reflect.ValueOf(running).Elem(), reflect.ValueOf(c).Elem()
+	for _, f := range item.fields {
+		if !reflect.DeepEqual(rv.FieldByName(f).Interface(), cv.FieldByName(f).Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (item *configItem) copy(running, c *apitypes.HyperConfig) {
+	rv, cv := reflect.ValueOf(running).Elem(), reflect.ValueOf(c).Elem()
+	for _, f := range item.fields {
+		rv.FieldByName(f).Set(cv.FieldByName(f))
+	}
+}
+
+// ReloadConfig re-reads the config file of the daemon, and applies the
+// changed items which could take effect without restarting the daemon or
+// its pods. The items requiring restart are reported as rejected.
+func (daemon *Daemon) ReloadConfig() (*apitypes.ReloadConfigResponse, error) {
+	daemon.configLock.Lock()
+	defer daemon.configLock.Unlock()
+
+	glog.Infof("reloading config file %s", daemon.config.ConfigFile)
+	c := apitypes.NewHyperConfig(daemon.config.ConfigFile)
+	if c == nil {
+		return nil, fmt.Errorf("failed to load config file %s", daemon.config.ConfigFile)
+	}
+	if daemon.ConfigOverride != nil {
+		daemon.ConfigOverride(c)
+	}
+
+	resp := &apitypes.ReloadConfigResponse{
+		Applied:  []string{},
+		Rejected: []*apitypes.ReloadConfigRejected{},
+	}
+	running := *daemon.config
+	for i := range configItems {
+		item := &configItems[i]
+		if !item.changed(&running, c) {
+			continue
+		}
+
+		var reason string
+		if item.apply == nil {
+			reason = "requires restarting hyperd"
+		} else if err := item.apply(daemon, c); err != nil {
+			reason = err.Error()
+		}
+		if reason != "" {
+			glog.Warningf("config %s is not reloaded: %s", item.key, reason)
+			resp.Rejected = append(resp.Rejected, &apitypes.ReloadConfigRejected{
+				Key:    item.key,
+				Reason: reason,
+			})
+			continue
+		}
+
+		glog.Infof("config %s reloaded", item.key)
+		item.copy(&running, c)
+		resp.Applied = append(resp.Applied, item.key)
+	}
+	daemon.config = &running
+
+	return resp, nil
+}
+
+func applyDefaultLog(daemon *Daemon, c *apitypes.HyperConfig) error {
+	daemon.initDefaultLog(c)
+	return nil
+}
+
+func applyLogVerbosity(daemon *Daemon, c *apitypes.HyperConfig) error {
+	if c.LogVerbosity < 0 {
+		return nil
+	}
+	return goflag.Set("v", strconv.Itoa(c.LogVerbosity))
+}
+
+func applyUserlandProxy(daemon *Daemon, c *apitypes.HyperConfig) error {
+	portmapping.SetUserlandProxy(c.UserlandProxy)
+	return nil
+}
+
+func applyVmFactoryPolicy(daemon *Daemon, c *apitypes.HyperConfig) error {
+	return daemon.Factory.UpdatePolicy(c.VmFactoryPolicy)
+}
+
+func applyDisableIptables(daemon *Daemon, c *apitypes.HyperConfig) error {
+	if n := daemon.PodList.CountRunning(); n > 0 {
+		return fmt.Errorf("could not be changed while %d pods are running", n)
+	}
+	if err := portmapping.SetDisableIptables(c.DisableIptables); err != nil {
+		return err
+	}
+	if err := networks.SetDisableIptables(c.DisableIptables); err != nil {
+		return err
+	}
+	if err := policy.SetDisableIptables(c.DisableIptables); err != nil {
+		return err
+	}
+	// the rules of the networks are inserted ahead of the policies
+	return policy.KeepFirst()
+}
+
+func applyCapacity(daemon *Daemon, c *apitypes.HyperConfig) error {
+	daemon.Admission.update(c)
+	return nil
+}
+
+func applyRegistry(daemon *Daemon, c *apitypes.HyperConfig) error {
+	return daemon.ReloadRegistry(c)
+}
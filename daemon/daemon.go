@@ -6,20 +6,23 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/hyperhq/hyperd/daemon/daemondb"
 	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/networking/cni"
+	"github.com/hyperhq/hyperd/networking/networks"
+	"github.com/hyperhq/hyperd/networking/policy"
 	"github.com/hyperhq/hyperd/networking/portmapping"
+	"github.com/hyperhq/hyperd/networking/resolver"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 	"github.com/hyperhq/runv/driverloader"
-	"github.com/hyperhq/runv/factory"
 	"github.com/hyperhq/runv/hypervisor"
 	"github.com/hyperhq/runv/hypervisor/network"
 
 	docker "github.com/docker/docker/daemon"
 	"github.com/docker/docker/daemon/logger/jsonfilelog"
-	"github.com/docker/docker/opts"
 	flag "github.com/docker/docker/pkg/mflag"
 	"github.com/docker/docker/registry"
 	dockerutils "github.com/docker/docker/utils"
@@ -36,23 +39,47 @@ type Daemon struct {
 	ID         string
 	db         *daemondb.DaemonDB
 	PodList    *pod.PodList
-	Factory    factory.Factory
+	Factory    *VmFactory
 	Host       string
 	Storage    Storage
 	Hypervisor string
 	DefaultLog *pod.GlobalLogConfig
+	ImageGC    *ImageGC
+	JobReaper  *JobReaper
+	Eviction   *Eviction
+
+	// Runtimes are the VM factories of the runtime profiles in the config
+	// file, the Factory is the one of the default runtime
+	Runtimes  map[string]*VmFactory
+	Admission *Admission
+
+	// ConfigOverride applies the command line options to the config
+	// reloaded from the config file
+	ConfigOverride func(*apitypes.HyperConfig)
+
+	configLock   sync.Mutex
+	config       *apitypes.HyperConfig
+	registryLock sync.RWMutex
+	registries   map[string]*apitypes.RegistryConfig
+
+	// ipConflicts are the addresses found allocated to more than one
+	// interface on restore, and missingNetworks the ones allocated from
+	// the networks not restored
+	ipConflicts     []*apitypes.IPConflict
+	missingNetworks []*apitypes.IPAllocation
 }
 
 func (daemon *Daemon) Restore() error {
 	//try to migrate lagecy data first
 	err := pod.MigrateLagecyPersistentData(daemon.db, func() *pod.PodFactory {
-		return pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
+		return pod.NewPodFactory(daemon, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
 	})
 	if err != nil {
 		return err
 	}
 
 	if daemon.GetPodNum() == 0 {
+		daemon.restoreIPAllocations(nil)
 		return nil
 	}
 
@@ -63,6 +90,7 @@ func (daemon *Daemon) Restore() error {
 		return errors.New(estr)
 	}
 
+	restored := []*pod.XPod{}
 	for {
 		layout, ok := <-ch
 		if !ok {
@@ -75,7 +103,7 @@ func (daemon *Daemon) Restore() error {
 		}
 
 		glog.V(1).Infof("reloading pod %s: %#v", layout.Id, layout)
-		fc := pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
+		fc := pod.NewPodFactory(daemon, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
 
 		p, err := pod.LoadXPod(fc, layout)
 		if err != nil {
@@ -86,11 +114,25 @@ func (daemon *Daemon) Restore() error {
 		if glog.V(3) {
 			p.Log(pod.TRACE, "containers in pod %s: %v", p.Id(), p.ContainerIds())
 		}
+		restored = append(restored, p)
 	}
 
+	daemon.restoreIPAllocations(restored)
 	return nil
 }
 
+// restoreIPAllocations re-seeds the address allocators, as the restored
+// pods keep their addresses. The conflicts are reported in the system info.
+func (daemon *Daemon) restoreIPAllocations(pods []*pod.XPod) {
+	daemon.ipConflicts, daemon.missingNetworks = pod.RestoreIPAllocations(daemon.db, pods)
+	for _, c := range daemon.ipConflicts {
+		glog.Errorf("ip %s is allocated to more than one interface, of the pods %v", c.Ip, c.Pods)
+	}
+	for _, a := range daemon.missingNetworks {
+		glog.Errorf("ip %s of pod %s is allocated from network %s, which is not restored", a.Ip, a.Pod, a.Network)
+	}
+}
+
 func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 	var tempdir = path.Join(utils.HYPER_ROOT, "run")
 	os.Setenv("TMPDIR", tempdir)
@@ -117,6 +159,7 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 		db:      db,
 		PodList: pod.NewPodList(),
 		Host:    cfg.Host,
+		config:  cfg,
 	}
 
 	daemon.Daemon, err = docker.NewDaemon(dockerCfg, registryCfg)
@@ -136,6 +179,10 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 	daemon.Storage = stor
 	daemon.Storage.Init(cfg)
 
+	if err = daemon.initRegistry(cfg); err != nil {
+		return nil, err
+	}
+
 	err = daemon.initRunV(cfg)
 	if err != nil {
 		return nil, err
@@ -147,6 +194,11 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 	}
 
 	daemon.initDefaultLog(cfg)
+	daemon.Admission = newAdmission(daemon, cfg)
+	daemon.ImageGC = newImageGC(daemon, cfg)
+	daemon.JobReaper = newJobReaper(daemon)
+	daemon.Eviction = newEviction(daemon, cfg)
+	daemon.registerMetrics()
 
 	return daemon, nil
 }
@@ -157,7 +209,6 @@ var (
 )
 
 func presentInHelp(usage string) string { return usage }
-func absentFromHelp(string) string      { return "" }
 
 func InitDockerCfg(mirrors []string, insecureRegistries []string, graphdriver, basesize, root string) {
 	if dockerCfg.LogConfig.Config == nil {
@@ -193,21 +244,7 @@ func InitDockerCfg(mirrors []string, insecureRegistries []string, graphdriver, b
 		dockerutils.EnableDebug()
 	}
 
-	registryOpts := &registry.Options{
-		Mirrors:            opts.NewListOpts(nil),
-		InsecureRegistries: opts.NewListOpts(nil),
-	}
-	registryOpts.InstallFlags(flags, absentFromHelp)
-
-	for _, m := range mirrors {
-		registryOpts.Mirrors.Set(m)
-	}
-
-	for _, ir := range insecureRegistries {
-		registryOpts.InsecureRegistries.Set(ir)
-	}
-
-	registryCfg = registry.NewService(registryOpts)
+	registryCfg = newRegistryService(mirrors, insecureRegistries)
 }
 
 func (daemon *Daemon) initRunV(c *apitypes.HyperConfig) error {
@@ -229,9 +266,9 @@ func (daemon *Daemon) initRunV(c *apitypes.HyperConfig) error {
 		EnableVsock: c.EnableVsock,
 		GDBTCPPort:  c.GDBTCPPort,
 	}
-	daemon.Factory = factory.NewFromPolicy(bootConfig, c.VmFactoryPolicy)
+	daemon.Factory = NewVmFactory(bootConfig, c.VmFactoryPolicy)
 
-	return nil
+	return daemon.initRuntimes(c)
 }
 
 func (daemon *Daemon) initNetworks(c *apitypes.HyperConfig) error {
@@ -257,15 +294,45 @@ func (daemon *Daemon) initNetworks(c *apitypes.HyperConfig) error {
 	if err := portmapping.Setup(network.BridgeIface, fmt.Sprintf("%s", addrs[0].IPNet), c.DisableIptables); err != nil {
 		glog.Errorf("Setup portmapping failed: %v", err)
 	}
+	portmapping.SetUserlandProxy(c.UserlandProxy)
+	networks.SetDisableIptables(c.DisableIptables)
+	daemon.restoreNetworks()
+	if err := policy.Setup(c.DisableIptables); err != nil {
+		glog.Errorf("Setup network policy failed: %v", err)
+	}
+
+	switch c.NetworkPlugin {
+	case "":
+	case "cni":
+		if err := cni.Setup(c.CniConfDir, c.CniBinDir); err != nil {
+			glog.Errorf("Setup CNI failed: %v", err)
+			return err
+		}
+	default:
+		err := fmt.Errorf("unknown network plugin %q", c.NetworkPlugin)
+		glog.Error(err)
+		return err
+	}
+
+	if !c.DisableDns {
+		if err := resolver.Setup(addrs[0].IP.String(), c.DnsDomain, c.DnsUpstreams, daemon.lookupName); err != nil {
+			glog.Errorf("Setup embedded DNS failed, the pods use the DNS of the host: %v", err)
+		}
+	}
 	return nil
 }
 
 func (daemon *Daemon) initDefaultLog(c *apitypes.HyperConfig) {
 	var (
 		driver = c.DefaultLog
-		cfg    = c.DefaultLogOpt
+		cfg    = make(map[string]string)
 	)
 
+	// copy the options, the running config keeps them unchanged
+	for k, v := range c.DefaultLogOpt {
+		cfg[k] = v
+	}
+
 	if driver == "" {
 		driver = jsonfilelog.Name
 	}
@@ -391,7 +458,11 @@ func (daemon *Daemon) Shutdown() error {
 	glog.V(0).Info("The daemon will be shutdown")
 	glog.V(0).Info("Shutdown all VMs")
 
-	daemon.Factory.CloseFactory()
+	daemon.ImageGC.Stop()
+	daemon.JobReaper.Stop()
+	daemon.Eviction.Stop()
+	daemon.closeRuntimes()
+	resolver.Shutdown()
 	daemon.db.Close()
 	glog.Flush()
 	return nil
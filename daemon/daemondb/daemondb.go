@@ -85,6 +85,19 @@ func (d *DaemonDB) DeletePodVolumes(podId string) error {
 	return d.PrefixDelete(prefixVolume(podId))
 }
 
+// Networks
+func (d *DaemonDB) UpdateNetwork(name string, data []byte) error {
+	return d.Update(keyNetwork(name), data)
+}
+
+func (d *DaemonDB) ListNetworks() ([][]byte, error) {
+	return d.PrefixList(prefixNetwork(), nil)
+}
+
+func (d *DaemonDB) DeleteNetwork(name string) error {
+	return d.db.Delete(keyNetwork(name), nil)
+}
+
 // POD to Containers (string to string list)
 func (d *DaemonDB) LagecyGetP2C(id string) ([]string, error) {
 	glog.V(3).Info("try get container list for pod ", id)
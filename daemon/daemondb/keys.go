@@ -10,11 +10,13 @@ const (
 	POD_VM_KEY        = "vm-%s"
 	POD_CONTAINER_KEY = "pod-container-%s"
 	POD_VOLUME_KEY    = "vol-%s-%s"
+	NETWORK_KEY       = "net-%s"
 
 	POD_PREFIX           = "pod-"
 	POD_CONTAINER_PREFIX = "pod-container-"
 	POD_VOLUME_PREFIX    = "vol-%s"
 	POD_VM_PREFIX        = "vm-"
+	NETWORK_PREFIX       = "net-"
 )
 
 //the id is a vm id
@@ -55,3 +57,13 @@ func prefixP2V() []byte {
 func prefixVolume(podId string) []byte {
 	return []byte(fmt.Sprintf(POD_VOLUME_PREFIX, podId))
 }
+
+// the name is a network name
+// and the db content is the network spec
+func keyNetwork(name string) []byte {
+	return []byte(fmt.Sprintf(NETWORK_KEY, name))
+}
+
+func prefixNetwork() []byte {
+	return []byte(NETWORK_PREFIX)
+}
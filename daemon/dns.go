@@ -0,0 +1,66 @@
+package daemon
+
+import (
+	"net"
+	"strings"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+)
+
+// lookupName resolves the names in the domain of the embedded DNS:
+// "<pod>" to the addresses of the pod, "<container>.<pod>" to the
+// addresses of the pod, "<service>.<pod>" to the service IP of the pod,
+// and "<container>" or "<service>" in the pod querying the name.
+func (daemon *Daemon) lookupName(name string, client net.IP) []net.IP {
+	labels := strings.Split(name, ".")
+	switch len(labels) {
+	case 1:
+		if p := daemon.findPodByName(labels[0]); p != nil {
+			return parseIPs(p.Addresses())
+		}
+		if p := daemon.findPodByAddress(client); p != nil {
+			return parseIPs(p.LookupName(labels[0]))
+		}
+	case 2:
+		if p := daemon.findPodByName(labels[1]); p != nil {
+			return parseIPs(p.LookupName(labels[0]))
+		}
+	}
+	return nil
+}
+
+// findPodByName finds the pod by the id, case-insensitively as the names
+// in DNS.
+func (daemon *Daemon) findPodByName(name string) *pod.XPod {
+	if p, ok := daemon.PodList.Get(name); ok {
+		return p
+	}
+	return daemon.PodList.Find(func(p *pod.XPod) bool {
+		return strings.EqualFold(p.Id(), name)
+	})
+}
+
+func (daemon *Daemon) findPodByAddress(ip net.IP) *pod.XPod {
+	if ip == nil {
+		return nil
+	}
+	addr := ip.String()
+	return daemon.PodList.Find(func(p *pod.XPod) bool {
+		for _, a := range p.Addresses() {
+			if a == addr {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func parseIPs(addrs []string) []net.IP {
+	ips := make([]net.IP, 0, len(addrs))
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
@@ -0,0 +1,279 @@
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/docker/docker/daemon/logger/jsonfilelog"
+	"github.com/docker/go-units"
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/lib/sysinfo"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	DefaultEvictionInterval = 10 * time.Second
+
+	// the seconds the containers of an evicted pod are given to exit
+	evictionGracePeriod = 10
+)
+
+// Eviction relieves the host once it runs short of memory, or the storage
+// pool or the log directory fills up over the thresholds. The running pods
+// with the lowest priority are stopped on memory pressure, one in each
+// round, and the next one only if the shortage dropped after the last
+// eviction. Stopping the pods reclaims neither storage nor log space, the
+// unused images are collected on storage pressure instead.
+type Eviction struct {
+	daemon *Daemon
+
+	memoryAvailable  int
+	storageThreshold int
+	logThreshold     int
+	interval         time.Duration
+
+	// the memory shortage (MB) when the last pod was evicted, and the pod
+	lastShortage int
+	lastEvicted  string
+
+	stop chan struct{}
+}
+
+func newEviction(daemon *Daemon, c *apitypes.HyperConfig) *Eviction {
+	e := &Eviction{
+		daemon:           daemon,
+		memoryAvailable:  c.EvictionMemoryAvailable,
+		storageThreshold: c.EvictionStorageThreshold,
+		logThreshold:     c.EvictionLogThreshold,
+		interval:         c.EvictionInterval,
+	}
+	if e.interval <= 0 {
+		e.interval = DefaultEvictionInterval
+	}
+	return e
+}
+
+// Start launches the periodical pressure check, it does nothing if no
+// threshold is configured.
+func (e *Eviction) Start() {
+	if (e.memoryAvailable == 0 && e.storageThreshold == 0 && e.logThreshold == 0) || e.stop != nil {
+		return
+	}
+	glog.V(1).Infof("eviction started, memory available %dMB, storage threshold %d%%, log threshold %d%%, interval %v",
+		e.memoryAvailable, e.storageThreshold, e.logThreshold, e.interval)
+	e.stop = make(chan struct{})
+	go e.loop(e.stop)
+}
+
+func (e *Eviction) Stop() {
+	if e.stop != nil {
+		close(e.stop)
+		e.stop = nil
+	}
+}
+
+func (e *Eviction) loop(stop chan struct{}) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			e.Run()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Run relieves the pressure of the host, and returns the id of the evicted
+// pod, or an empty string.
+func (e *Eviction) Run() string {
+	if message := e.storagePressure(); message != "" {
+		e.collectImages(message)
+	}
+	if message := e.logPressure(); message != "" {
+		glog.Warningf("eviction: %s, no pod is evicted as stopping pods reclaims no log space", message)
+	}
+
+	shortage, message := e.memoryPressure()
+	if shortage <= 0 {
+		e.lastShortage, e.lastEvicted = 0, ""
+		return ""
+	}
+	if e.lastEvicted != "" && shortage >= e.lastShortage {
+		glog.Warningf("eviction: %s, not relieved since pod %s was evicted, no more pod is evicted until it drops",
+			message, e.lastEvicted)
+		return ""
+	}
+
+	candidates := e.candidates()
+	if len(candidates) == 0 {
+		glog.Warningf("eviction: %s, but no pod could be evicted", message)
+		return ""
+	}
+	p := candidates[0]
+	glog.Infof("eviction: %s, evicting pod %s with priority %d", message, p.Id(), p.Priority())
+	podEvictions.Inc("memory")
+	if err := p.Evict(message, evictionGracePeriod); err != nil {
+		glog.Errorf("eviction: failed to evict pod %s: %v", p.Id(), err)
+	}
+	e.lastShortage, e.lastEvicted = shortage, p.Id()
+	return p.Id()
+}
+
+// memoryPressure returns the shortage (MB) of the available memory of the
+// host and the description of it, the shortage is 0 if there is none.
+func (e *Eviction) memoryPressure() (int, string) {
+	if e.memoryAvailable <= 0 {
+		return 0, ""
+	}
+	meminfo, err := sysinfo.GetMemInfo()
+	if err != nil {
+		glog.Warningf("eviction: failed to get the host memory: %v", err)
+		return 0, ""
+	}
+	available := int(meminfo.MemAvailable >> 10)
+	if available >= e.memoryAvailable {
+		return 0, ""
+	}
+	return e.memoryAvailable - available, fmt.Sprintf("the host has %dMB memory available, less than %dMB", available, e.memoryAvailable)
+}
+
+// storagePressure returns the description of the storage usage crossing
+// the threshold, or an empty string.
+func (e *Eviction) storagePressure() string {
+	if e.storageThreshold <= 0 {
+		return ""
+	}
+	usage, err := e.storageUsage()
+	if err != nil {
+		glog.Warningf("eviction: failed to get the storage usage: %v", err)
+	}
+	for name, u := range usage {
+		if u[1] > 0 && u[0]*100 >= int64(e.storageThreshold)*u[1] {
+			return fmt.Sprintf("the %s usage %d/%d exceeds %d%%", name, u[0], u[1], e.storageThreshold)
+		}
+	}
+	return ""
+}
+
+// logPressure returns the description of the log directory usage crossing
+// the threshold, or an empty string.
+func (e *Eviction) logPressure() string {
+	if e.logThreshold <= 0 || e.daemon.DefaultLog.Type != jsonfilelog.Name {
+		return ""
+	}
+	dir := e.daemon.DefaultLog.PathPrefix
+	used, total, err := statfsUsage(dir)
+	if err != nil {
+		glog.Warningf("eviction: failed to get the usage of log directory %s: %v", dir, err)
+		return ""
+	}
+	if total > 0 && used*100 >= int64(e.logThreshold)*total {
+		return fmt.Sprintf("the log directory usage %d/%d exceeds %d%%", used, total, e.logThreshold)
+	}
+	return ""
+}
+
+// collectImages removes the unused images to reclaim the storage.
+func (e *Eviction) collectImages(message string) {
+	glog.Infof("eviction: %s, collecting the unused images", message)
+	resp, err := e.daemon.ImageGC.Run(false, true)
+	if err != nil {
+		glog.Errorf("eviction: failed to collect the unused images: %v", err)
+		return
+	}
+	if message := e.storagePressure(); message != "" {
+		glog.Warningf("eviction: %s after %d images are removed", message, len(resp.Images))
+	}
+}
+
+// storageUsage returns the used and total bytes of the data and metadata
+// of the devicemapper thin pool, or of the filesystem of the storage root.
+func (e *Eviction) storageUsage() (map[string][2]int64, error) {
+	if e.daemon.Storage.Type() == "devicemapper" {
+		sysinfo, err := e.daemon.Daemon.SystemInfo()
+		if err != nil {
+			return nil, err
+		}
+		usage := map[string][2]int64{}
+		for _, pair := range sysinfo.DriverStatus {
+			var (
+				name  string
+				index int
+			)
+			switch pair[0] {
+			case "Data Space Used":
+				name, index = "thin pool data", 0
+			case "Data Space Total":
+				name, index = "thin pool data", 1
+			case "Metadata Space Used":
+				name, index = "thin pool metadata", 0
+			case "Metadata Space Total":
+				name, index = "thin pool metadata", 1
+			default:
+				continue
+			}
+			size, err := units.FromHumanSize(pair[1])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s %q: %v", pair[0], pair[1], err)
+			}
+			u := usage[name]
+			u[index] = size
+			usage[name] = u
+		}
+		if len(usage) > 0 {
+			return usage, nil
+		}
+	}
+
+	used, total, err := statfsUsage(e.daemon.Storage.RootPath())
+	if err != nil {
+		return nil, err
+	}
+	return map[string][2]int64{"storage": {used, total}}, nil
+}
+
+type evictionCandidates []*pod.XPod
+
+func (c evictionCandidates) Len() int      { return len(c) }
+func (c evictionCandidates) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c evictionCandidates) Less(i, j int) bool {
+	if pi, pj := c[i].Priority(), c[j].Priority(); pi != pj {
+		return pi < pj
+	}
+	_, mi := podResource(c[i].Resource())
+	_, mj := podResource(c[j].Resource())
+	if mi != mj {
+		return mi > mj
+	}
+	return c[i].Id() < c[j].Id()
+}
+
+// candidates lists the running pods, the lowest priority and the largest
+// memory first.
+func (e *Eviction) candidates() []*pod.XPod {
+	result := evictionCandidates{}
+	e.daemon.PodList.Foreach(func(p *pod.XPod) error {
+		if p.IsRunning() {
+			result = append(result, p)
+		}
+		return nil
+	})
+	sort.Sort(result)
+	return result
+}
+
+// statfsUsage returns the used and total bytes of the filesystem of path.
+func statfsUsage(path string) (int64, int64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, 0, err
+	}
+	total := int64(st.Blocks) * int64(st.Bsize)
+	used := total - int64(st.Bfree)*int64(st.Bsize)
+	return used, total, nil
+}
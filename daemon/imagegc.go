@@ -0,0 +1,290 @@
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	// images with this label set to "true" are never garbage collected
+	ImageGCPinLabel = "sh.hyper.image.pinned"
+
+	IMAGE_GC_KEY_FMT = "IG-%s"
+
+	DefaultImageGCInterval = 5 * time.Minute
+)
+
+// ImageGC removes the least recently used images which are not referenced
+// by any pod once the disk usage of the image storage exceeds the high
+// threshold, until the usage drops below the low threshold.
+type ImageGC struct {
+	daemon *Daemon
+
+	root     string
+	high     int
+	low      int
+	interval time.Duration
+	minAge   time.Duration
+
+	// lock serializes the gc runs and protects the stats
+	lock  sync.Mutex
+	stats apitypes.ImageGCStats
+	stop  chan struct{}
+}
+
+type imageGCCandidates []*apitypes.ImageGCCandidate
+
+func (c imageGCCandidates) Len() int           { return len(c) }
+func (c imageGCCandidates) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c imageGCCandidates) Less(i, j int) bool { return c[i].LastUsed < c[j].LastUsed }
+
+func newImageGC(daemon *Daemon, c *apitypes.HyperConfig) *ImageGC {
+	gc := &ImageGC{
+		daemon:   daemon,
+		root:     c.Root,
+		high:     c.ImageGCHighThreshold,
+		low:      c.ImageGCLowThreshold,
+		interval: c.ImageGCInterval,
+		minAge:   c.ImageGCMinAge,
+	}
+	if gc.interval <= 0 {
+		gc.interval = DefaultImageGCInterval
+	}
+	return gc
+}
+
+// Start launches the periodical disk usage check, it does nothing if the
+// high threshold is not configured.
+func (gc *ImageGC) Start() {
+	if gc.high == 0 || gc.stop != nil {
+		return
+	}
+	glog.V(1).Infof("image gc started, high threshold %d%%, low threshold %d%%, interval %v", gc.high, gc.low, gc.interval)
+	gc.stop = make(chan struct{})
+	go gc.loop(gc.stop)
+}
+
+func (gc *ImageGC) Stop() {
+	if gc.stop != nil {
+		close(gc.stop)
+		gc.stop = nil
+	}
+}
+
+func (gc *ImageGC) loop(stop chan struct{}) {
+	ticker := time.NewTicker(gc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := gc.Run(false, false); err != nil {
+				glog.Errorf("image gc failed: %v", err)
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Stats returns the accumulated statistics of the gc runs.
+func (gc *ImageGC) Stats() *apitypes.ImageGCStats {
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+	return gc.statsCopy()
+}
+
+// Run does one round of image garbage collection. If force is set, all the
+// unused images are collected regardless of the disk usage; with dryRun the
+// images are only reported, not removed.
+func (gc *ImageGC) Run(dryRun, force bool) (*apitypes.ImageGCResponse, error) {
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+
+	used, total, err := gc.diskUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	inUse := gc.touchImagesInUse()
+
+	resp := &apitypes.ImageGCResponse{
+		Images:     []*apitypes.ImageGCCandidate{},
+		UsedBytes:  used,
+		TotalBytes: total,
+	}
+
+	var target int64
+	if !force {
+		if gc.high == 0 || total == 0 || used*100 < int64(gc.high)*total {
+			resp.Stats = gc.statsCopy()
+			return resp, nil
+		}
+		target = used - total*int64(gc.low)/100
+		glog.V(1).Infof("image gc: disk usage %d/%d exceeds %d%%, try to reclaim %d bytes", used, total, gc.high, target)
+	}
+
+	candidates, err := gc.candidates(inUse)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, img := range candidates {
+		if !force && resp.ReclaimedBytes >= target {
+			break
+		}
+		if !dryRun {
+			if err := gc.remove(img); err != nil {
+				glog.Warningf("image gc: failed to remove image %s: %v", img.Id, err)
+				continue
+			}
+		}
+		resp.Images = append(resp.Images, img)
+		resp.ReclaimedBytes += img.VirtualSize
+	}
+
+	if !dryRun {
+		gc.stats.Runs++
+		gc.stats.RemovedImages += int64(len(resp.Images))
+		gc.stats.ReclaimedBytes += resp.ReclaimedBytes
+		gc.stats.LastRun = time.Now().Unix()
+		glog.V(1).Infof("image gc: removed %d images, reclaimed %d bytes", len(resp.Images), resp.ReclaimedBytes)
+	}
+	resp.Stats = gc.statsCopy()
+	return resp, nil
+}
+
+func (gc *ImageGC) statsCopy() *apitypes.ImageGCStats {
+	stats := gc.stats
+	return &stats
+}
+
+// candidates lists the images which could be collected, the least recently
+// used first.
+func (gc *ImageGC) candidates(inUse map[string]bool) ([]*apitypes.ImageGCCandidate, error) {
+	images, err := gc.daemon.Daemon.Images("", "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	result := imageGCCandidates{}
+	for _, img := range images {
+		if img.Labels[ImageGCPinLabel] == "true" {
+			continue
+		}
+		used := inUse[img.ID]
+		for _, tag := range img.RepoTags {
+			if inUse[tag] {
+				used = true
+				break
+			}
+		}
+		if used {
+			continue
+		}
+
+		lastUsed := gc.lastUsed(img.ID, img.Created)
+		if gc.minAge > 0 && now-lastUsed < int64(gc.minAge/time.Second) {
+			continue
+		}
+		result = append(result, &apitypes.ImageGCCandidate{
+			Id:          img.ID,
+			RepoTags:    img.RepoTags,
+			VirtualSize: img.VirtualSize,
+			LastUsed:    lastUsed,
+		})
+	}
+	sort.Sort(result)
+	return result, nil
+}
+
+// remove untags all the references of the image, the image itself is
+// deleted together with the last reference.
+func (gc *ImageGC) remove(img *apitypes.ImageGCCandidate) error {
+	refs := img.RepoTags
+	if len(refs) == 0 || (len(refs) == 1 && refs[0] == "<none>:<none>") {
+		refs = []string{img.Id}
+	}
+	for _, ref := range refs {
+		if _, err := gc.daemon.Daemon.ImageDelete(ref, false, true); err != nil {
+			return err
+		}
+	}
+	gc.daemon.db.Delete(keyImageGC(img.Id))
+	return nil
+}
+
+// touchImagesInUse records the current time as the last used time of the
+// images referenced by the pods, and returns these images.
+func (gc *ImageGC) touchImagesInUse() map[string]bool {
+	inUse := make(map[string]bool)
+	gc.daemon.PodList.Foreach(func(p *pod.XPod) error {
+		for _, image := range p.ContainerImages() {
+			inUse[image] = true
+			if !strings.HasPrefix(image, "sha256:") && !strings.Contains(image, ":") {
+				inUse[image+":latest"] = true
+			}
+		}
+		return nil
+	})
+
+	now := []byte(strconv.FormatInt(time.Now().Unix(), 10))
+	for image := range inUse {
+		if strings.HasPrefix(image, "sha256:") {
+			gc.daemon.db.Update(keyImageGC(image), now)
+		}
+	}
+	return inUse
+}
+
+func (gc *ImageGC) lastUsed(id string, created int64) int64 {
+	v, err := gc.daemon.db.GetString(keyImageGC(id))
+	if err != nil {
+		return created
+	}
+	t, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return created
+	}
+	return t
+}
+
+// diskUsage returns the used and total bytes of the image storage.
+func (gc *ImageGC) diskUsage() (int64, int64, error) {
+	if gc.daemon.Storage.Type() == "devicemapper" {
+		sysinfo, err := gc.daemon.Daemon.SystemInfo()
+		if err != nil {
+			return 0, 0, err
+		}
+		var used, total int64 = -1, -1
+		for _, pair := range sysinfo.DriverStatus {
+			switch pair[0] {
+			case "Data Space Used":
+				used, err = units.FromHumanSize(pair[1])
+			case "Data Space Total":
+				total, err = units.FromHumanSize(pair[1])
+			}
+			if err != nil {
+				return 0, 0, fmt.Errorf("failed to parse %s %q: %v", pair[0], pair[1], err)
+			}
+		}
+		if used >= 0 && total >= 0 {
+			return used, total, nil
+		}
+	}
+
+	return statfsUsage(gc.root)
+}
+
+func keyImageGC(id string) []byte {
+	return []byte(fmt.Sprintf(IMAGE_GC_KEY_FMT, id))
+}
@@ -0,0 +1,73 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
+)
+
+// JobReapInterval is the period the job reaper checks the finished job pods.
+var JobReapInterval = 5 * time.Second
+
+// JobReaper removes the finished job pods once their autoRemove or
+// ttlAfterFinished is reached.
+type JobReaper struct {
+	daemon *Daemon
+	stop   chan struct{}
+}
+
+func newJobReaper(daemon *Daemon) *JobReaper {
+	return &JobReaper{daemon: daemon}
+}
+
+func (r *JobReaper) Start() {
+	if r.stop != nil {
+		return
+	}
+	r.stop = make(chan struct{})
+	go r.loop(r.stop)
+}
+
+func (r *JobReaper) Stop() {
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+}
+
+func (r *JobReaper) loop(stop chan struct{}) {
+	ticker := time.NewTicker(JobReapInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.Run()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Run removes the expired job pods, and returns their ids.
+func (r *JobReaper) Run() []string {
+	var (
+		now     = time.Now()
+		expired = []string{}
+	)
+	r.daemon.PodList.Foreach(func(p *pod.XPod) error {
+		if p.JobExpired(now) {
+			expired = append(expired, p.Id())
+		}
+		return nil
+	})
+
+	// RemovePod locks the PodList, do not remove inside Foreach
+	for _, id := range expired {
+		glog.Infof("removing finished job pod %s", id)
+		if _, _, err := r.daemon.RemovePod(id); err != nil {
+			glog.Errorf("failed to remove finished job pod %s: %v", id, err)
+		}
+	}
+	return expired
+}
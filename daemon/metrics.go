@@ -0,0 +1,210 @@
+package daemon
+
+import (
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/lib/metrics"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+var (
+	imagePullDuration = metrics.NewHistogramVec("hyperd_image_pull_duration_seconds",
+		"Duration of image pulls.", []float64{1, 5, 10, 30, 60, 120, 300, 600}, "result")
+	imagePullBytes = metrics.NewCounterVec("hyperd_image_pull_bytes_total",
+		"Total size of the pulled images.")
+	podEvictions = metrics.NewCounterVec("hyperd_pod_evictions_total",
+		"Pods evicted under host pressure by the signal.", "signal")
+)
+
+func init() {
+	metrics.MustRegister(imagePullDuration, imagePullBytes, podEvictions)
+}
+
+var podStateNames = map[pod.PodState]string{
+	pod.S_POD_NONE:     "none",
+	pod.S_POD_STARTING: "starting",
+	pod.S_POD_RUNNING:  "running",
+	pod.S_POD_PAUSED:   "paused",
+	pod.S_POD_STOPPED:  "stopped",
+	pod.S_POD_STOPPING: "stopping",
+	pod.S_POD_ERROR:    "error",
+}
+
+func observeImagePull(start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	imagePullDuration.Observe(time.Since(start).Seconds(), result)
+}
+
+// registerMetrics exports the state of the pods, the VM factory and the
+// image gc, which are retrieved on collection.
+func (daemon *Daemon) registerMetrics() {
+	metrics.MustRegister(
+		metrics.NewGaugeFunc("hyperd_pods", "Number of pods by status.", []string{"status"},
+			func(emit func(float64, ...string)) {
+				for state, name := range podStateNames {
+					emit(float64(daemon.PodList.CountStatus(state)), name)
+				}
+			}),
+		metrics.NewGaugeFunc("hyperd_containers", "Number of containers by status.", []string{"status"},
+			func(emit func(float64, ...string)) {
+				count := map[string]int{}
+				daemon.PodList.Foreach(func(p *pod.XPod) error {
+					for _, cid := range p.ContainerIds() {
+						if s := p.ContainerBriefStatus(cid); s != nil {
+							count[s.Status]++
+						}
+					}
+					return nil
+				})
+				for status, n := range count {
+					emit(float64(n), status)
+				}
+			}),
+		metrics.NewGaugeFunc("hyperd_port_mappings", "Number of port mappings in use.", nil,
+			func(emit func(float64, ...string)) {
+				n := 0
+				daemon.PodList.Foreach(func(p *pod.XPod) error {
+					n += len(p.ListPortMappings())
+					return nil
+				})
+				emit(float64(n))
+			}),
+		newPodStatsCollector(daemon),
+		metrics.NewGaugeFunc("hyperd_vm_factory_cached_vms", "Number of cached VMs of the VM factory profile of the runtime.", []string{"runtime", "cpu", "memory"},
+			daemon.collectVmFactory(func(s *apitypes.VMFactoryProfileStatus) float64 { return float64(s.Cached) })),
+		metrics.NewCounterFunc("hyperd_vm_factory_hits_total", "VMs served from the cache of the VM factory profile.", []string{"runtime", "cpu", "memory"},
+			daemon.collectVmFactory(func(s *apitypes.VMFactoryProfileStatus) float64 { return float64(s.Hits) })),
+		metrics.NewCounterFunc("hyperd_vm_factory_misses_total", "VMs booted on demand as the cache of the VM factory profile was empty.", []string{"runtime", "cpu", "memory"},
+			daemon.collectVmFactory(func(s *apitypes.VMFactoryProfileStatus) float64 { return float64(s.Misses) })),
+		metrics.NewCounterFunc("hyperd_image_gc_removed_images_total", "Images removed by the image gc.", nil,
+			func(emit func(float64, ...string)) {
+				emit(float64(daemon.ImageGC.Stats().RemovedImages))
+			}),
+		metrics.NewCounterFunc("hyperd_image_gc_reclaimed_bytes_total", "Bytes reclaimed by the image gc.", nil,
+			func(emit func(float64, ...string)) {
+				emit(float64(daemon.ImageGC.Stats().ReclaimedBytes))
+			}),
+	)
+}
+
+type podStatsSample struct {
+	pod     string
+	cpu     float64
+	memory  float64
+	rxBytes float64
+	txBytes float64
+}
+
+// podStatsCollector exports the stats of the running pods, which are
+// retrieved from the sandboxes once per scrape and shared by the metrics.
+type podStatsCollector struct {
+	daemon *Daemon
+
+	lock    sync.Mutex
+	samples []*podStatsSample
+	metrics []metrics.Collector
+}
+
+func newPodStatsCollector(daemon *Daemon) *podStatsCollector {
+	c := &podStatsCollector{daemon: daemon}
+	c.metrics = []metrics.Collector{
+		metrics.NewCounterFunc("hyperd_pod_cpu_usage_seconds_total", "CPU time consumed by the pod.", []string{"pod"},
+			c.emit(func(s *podStatsSample) float64 { return s.cpu })),
+		metrics.NewGaugeFunc("hyperd_pod_memory_usage_bytes", "Memory usage of the pod.", []string{"pod"},
+			c.emit(func(s *podStatsSample) float64 { return s.memory })),
+		metrics.NewCounterFunc("hyperd_pod_network_receive_bytes_total", "Bytes received by the pod.", []string{"pod"},
+			c.emit(func(s *podStatsSample) float64 { return s.rxBytes })),
+		metrics.NewCounterFunc("hyperd_pod_network_transmit_bytes_total", "Bytes transmitted by the pod.", []string{"pod"},
+			c.emit(func(s *podStatsSample) float64 { return s.txBytes })),
+	}
+	return c
+}
+
+func (c *podStatsCollector) Collect(w io.Writer) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.samples = c.daemon.podStats()
+	for _, m := range c.metrics {
+		m.Collect(w)
+	}
+	c.samples = nil
+}
+
+func (c *podStatsCollector) emit(value func(*podStatsSample) float64) func(emit func(float64, ...string)) {
+	return func(emit func(float64, ...string)) {
+		for _, s := range c.samples {
+			emit(value(s), s.pod)
+		}
+	}
+}
+
+// podStats retrieves the stats of the running pods. The stats are
+// retrieved from the sandboxes concurrently, out of the lock of the pod
+// list.
+func (daemon *Daemon) podStats() []*podStatsSample {
+	pods := []*pod.XPod{}
+	daemon.PodList.Foreach(func(p *pod.XPod) error {
+		if p.IsRunning() {
+			pods = append(pods, p)
+		}
+		return nil
+	})
+
+	var (
+		wg      sync.WaitGroup
+		samples = make([]*podStatsSample, len(pods))
+	)
+	for i, p := range pods {
+		wg.Add(1)
+		go func(i int, p *pod.XPod) {
+			defer wg.Done()
+			stats := p.Stats()
+			if stats == nil {
+				return
+			}
+			s := &podStatsSample{
+				pod:    p.Id(),
+				cpu:    float64(stats.Cpu.Usage.Total) / float64(time.Second),
+				memory: float64(stats.Memory.Usage),
+			}
+			for _, inf := range stats.Network.Interfaces {
+				s.rxBytes += float64(inf.RxBytes)
+				s.txBytes += float64(inf.TxBytes)
+			}
+			samples[i] = s
+		}(i, p)
+	}
+	wg.Wait()
+
+	result := make([]*podStatsSample, 0, len(samples))
+	for _, s := range samples {
+		if s != nil {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// collectVmFactory emits the value of the VM factory profiles of all the
+// runtimes, the runtime label of the default one is empty.
+func (daemon *Daemon) collectVmFactory(value func(*apitypes.VMFactoryProfileStatus) float64) func(emit func(float64, ...string)) {
+	return func(emit func(float64, ...string)) {
+		factories := map[string]*VmFactory{"": daemon.Factory}
+		for name, f := range daemon.Runtimes {
+			factories[name] = f
+		}
+		for runtime, f := range factories {
+			for _, s := range f.Status().Profiles {
+				emit(value(s), runtime, strconv.Itoa(int(s.Profile.Cpu)), strconv.Itoa(int(s.Profile.Memory)))
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/glog"
+
+	"github.com/hyperhq/hyperd/networking/networks"
+	"github.com/hyperhq/hyperd/networking/policy"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// CreateNetwork sets up the named network and saves it, the network is
+// restored on the start of hyperd.
+func (daemon *Daemon) CreateNetwork(spec *apitypes.Network) (*apitypes.Network, error) {
+	spec.Created = time.Now().Unix()
+	n, err := networks.Create(spec)
+	if err != nil {
+		glog.Errorf("failed to create network %s: %v", spec.Name, err)
+		return nil, err
+	}
+
+	data, err := proto.Marshal(n.Spec())
+	if err == nil {
+		err = daemon.db.UpdateNetwork(n.Name(), data)
+	}
+	if err != nil {
+		glog.Errorf("failed to save network %s: %v", n.Name(), err)
+		networks.Remove(n.Name())
+		return nil, err
+	}
+	if err = policy.KeepFirst(); err != nil {
+		glog.Warningf("failed to keep the network policies ahead of network %s: %v", n.Name(), err)
+	}
+	return n.Spec(), nil
+}
+
+func (daemon *Daemon) ListNetworks() []*apitypes.Network {
+	result := []*apitypes.Network{}
+	for _, n := range networks.List() {
+		result = append(result, n.Spec())
+	}
+	return result
+}
+
+func (daemon *Daemon) InspectNetwork(name string) (*apitypes.NetworkInfo, error) {
+	n, err := networks.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return n.Info(), nil
+}
+
+// RemoveNetwork removes the network without any endpoint.
+func (daemon *Daemon) RemoveNetwork(name string) error {
+	if err := networks.Remove(name); err != nil {
+		glog.Errorf("failed to remove network %s: %v", name, err)
+		return err
+	}
+	if err := daemon.db.DeleteNetwork(name); err != nil {
+		glog.Warningf("failed to delete network %s from db: %v", name, err)
+	}
+	return nil
+}
+
+// restoreNetworks sets up the saved networks, a network failing to be set
+// up is skipped, and the pods in it fail to start.
+func (daemon *Daemon) restoreNetworks() {
+	list, err := daemon.db.ListNetworks()
+	if err != nil {
+		glog.Errorf("failed to list networks in db: %v", err)
+		return
+	}
+	for _, data := range list {
+		var spec apitypes.Network
+		if err := proto.Unmarshal(data, &spec); err != nil {
+			glog.Errorf("failed to load network: %v", err)
+			continue
+		}
+		if _, err := networks.Create(&spec); err != nil {
+			glog.Errorf("failed to restore network %s: %v", spec.Name, err)
+		}
+	}
+}
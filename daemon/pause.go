@@ -6,7 +6,7 @@ import (
 	"github.com/golang/glog"
 )
 
-func (daemon Daemon) PausePod(podId string) error {
+func (daemon *Daemon) PausePod(podId string) error {
 	p, ok := daemon.PodList.Get(podId)
 	if !ok {
 		return fmt.Errorf("Can not get Pod info with pod ID(%s)", podId)
@@ -15,7 +15,7 @@ func (daemon Daemon) PausePod(podId string) error {
 	return p.Pause()
 }
 
-func (daemon Daemon) PauseContainer(container string) error {
+func (daemon *Daemon) PauseContainer(container string) error {
 	glog.V(1).Infof("Get container id is %s", container)
 	p, _, ok := daemon.PodList.GetByContainerIdOrName(container)
 	if !ok {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/errors"
+	"github.com/hyperhq/hyperd/networking/resolver"
 	"github.com/hyperhq/hyperd/storage"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
@@ -49,6 +50,8 @@ type ContainerStatus struct {
 	FinishedAt time.Time
 	ExitCode   int
 	Killed     bool
+	// OOM is the OOM state of the exited container
+	OOM string
 
 	sync.RWMutex
 	stateChanged *sync.Cond
@@ -63,6 +66,8 @@ type Container struct {
 	descript *runv.ContainerDescription
 	status   *ContainerStatus
 	streams  *StreamConfig
+	// events is the recent events of the container, guarded by status lock
+	events []*apitypes.ContainerEvent
 
 	logger    LogStatus
 	logPrefix string
@@ -198,6 +203,7 @@ func (c *Container) InfoStatus() *apitypes.ContainerStatus {
 		Waiting:     &apitypes.WaitingStatus{Reason: ""},
 		Running:     &apitypes.RunningStatus{StartedAt: ""},
 		Terminated:  &apitypes.TermStatus{},
+		Events:      append([]*apitypes.ContainerEvent{}, c.events...),
 	}
 	switch c.status.State {
 	case S_CONTAINER_NONE, S_CONTAINER_CREATING:
@@ -208,9 +214,15 @@ func (c *Container) InfoStatus() *apitypes.ContainerStatus {
 			s.Terminated.StartedAt = c.status.StartedAt.Format(time.RFC3339)
 			s.Terminated.FinishedAt = c.status.FinishedAt.Format(time.RFC3339)
 			s.Terminated.ExitCode = int32(c.status.ExitCode)
-			if c.status.ExitCode != 0 || c.status.Killed {
+			if c.status.OOM == oomKilled {
+				s.Terminated.Reason = "OOMKilled"
+				s.Phase = "failed"
+			} else if c.status.ExitCode != 0 || c.status.Killed {
 				s.Terminated.Reason = "Failed"
 				s.Phase = "failed"
+				if c.status.OOM == oomUnknown {
+					s.Terminated.Message = "killed by SIGKILL, whether by the OOM killer is unknown"
+				}
 			} else {
 				s.Terminated.Reason = "Succeeded"
 				s.Phase = "succeeded"
@@ -233,6 +245,14 @@ func (c *Container) Add() error {
 }
 
 func (c *Container) start() error {
+	// the limits are set before any process of the container runs, and the
+	// container is not started without them
+	if err := c.prepareResources(); err != nil {
+		c.Log(ERROR, err)
+		c.addEvent("FailedResources", err.Error())
+		return err
+	}
+
 	if err := c.status.Start(); err != nil {
 		if err == errors.ErrContainerAlreadyRunning {
 			err = nil
@@ -255,6 +275,17 @@ func (c *Container) start() error {
 	c.Log(DEBUG, "container started")
 	c.status.Running(time.Now())
 
+	if err := c.attachResources(); err != nil {
+		c.Log(ERROR, err)
+		c.addEvent("FailedResources", err.Error())
+		c.terminate(true)
+		return err
+	}
+
+	// the failure of postStart hook is reported in the container events,
+	// and does not fail the start
+	c.runHook("postStart", c.spec.PostStart, hookTimeout)
+
 	return nil
 }
 
@@ -610,6 +641,10 @@ func (c *Container) describeContainer(cjson *dockertypes.ContainerJSON) (*runv.C
 			cdesc.Envs[pair[0]] = ""
 		}
 	}
+	// marks the processes to move into the cgroups of the container
+	if c.spec.Resources != nil {
+		cdesc.Envs[resourcesEnv] = c.Id()
+	}
 
 	c.Log(TRACE, "Container Info is \n%#v", cdesc)
 
@@ -669,9 +704,12 @@ func (c *Container) mergeVolumes(cjson *dockertypes.ContainerJSON) {
 	}
 }
 
+// etcHostsVolumeName is the volume of /etc/hosts added to the containers
+const etcHostsVolumeName = "etchosts-volume"
+
 func (c *Container) configEtcHosts() {
 	var (
-		hostsVolumeName = "etchosts-volume"
+		hostsVolumeName = etcHostsVolumeName
 		hostsVolumePath = ""
 		hostsPath       = "/etc/hosts"
 	)
@@ -750,6 +788,9 @@ func (c *Container) createVolumes() error {
     then this container won't be set as the file from hosts. Then a user can specify the content
     of the file.
 
+  If the embedded DNS of the daemon is enabled, the resolv.conf inserted points to it instead
+  of the nameservers of the host.
+
 */
 func (c *Container) configDNS() {
 	c.Log(DEBUG, "configure dns")
@@ -763,7 +804,8 @@ func (c *Container) configDNS() {
 		return
 	}
 
-	if stat, e := os.Stat(resolvconf); e != nil || !stat.Mode().IsRegular() {
+	embedded := resolver.Enabled()
+	if stat, e := os.Stat(resolvconf); !embedded && (e != nil || !stat.Mode().IsRegular()) {
 		c.Log(DEBUG, "Host resolv.conf does not exist or not a regular file, do not insert DNS conf")
 		return
 	}
@@ -783,18 +825,48 @@ func (c *Container) configDNS() {
 		}
 	}
 
+	detail := &apitypes.UserFile{
+		Name:     fileId,
+		Encoding: "raw",
+		Uri:      "file://" + resolvconf,
+	}
+	if embedded {
+		detail.Uri = ""
+		detail.Content = resolver.ResolvConf(c.p.Id(), c.p.globalSpec.DnsSearch, c.p.globalSpec.DnsOptions)
+	}
 	c.spec.Files = append(c.spec.Files, &apitypes.UserFileReference{
 		Path:     resolvconf,
 		Filename: fileId,
 		Perm:     "0644",
-		Detail: &apitypes.UserFile{
-			Name:     fileId,
-			Encoding: "raw",
-			Uri:      "file://" + resolvconf,
-		},
+		Detail:   detail,
 	})
 }
 
+// injectResolvConf replaces the resolv.conf inserted by configDNS with the
+// content, e.g. the DNS returned by the CNI plugins.
+func (c *Container) injectResolvConf(content string) error {
+	var (
+		fileId    = c.p.Id() + "-resolvconf"
+		sharedDir = filepath.Join(hypervisor.BaseDir, c.p.Id(), hypervisor.ShareDirTag)
+	)
+
+	if c.descript == nil {
+		return nil
+	}
+	for _, f := range c.spec.Files {
+		if f.Filename != fileId || f.Detail == nil {
+			continue
+		}
+		c.Log(DEBUG, "replace resolv.conf with %q", content)
+		f.Detail.Uri = ""
+		f.Detail.Encoding = "raw"
+		f.Detail.Content = content
+		return c.p.factory.sd.InjectFile(strings.NewReader(content), c.descript.MountId, f.Path, sharedDir,
+			utils.PermInt(f.Perm), utils.UidInt(f.User), utils.UidInt(f.Group))
+	}
+	return nil
+}
+
 func (c *Container) injectFiles(mountId string) error {
 	if len(c.spec.Files) == 0 {
 		return nil
@@ -1072,12 +1144,14 @@ func (c *Container) waitFinish(timeout int) {
 			}
 		} else {
 			c.Log(INFO, "container exited with code %v (at %v)", r.Code, r.FinishedAt)
+			c.status.SetOOM(c.checkOOM(r.Code))
 			firstStop = c.status.Stopped(r.FinishedAt, r.Code)
 		}
 	}
 
 	if firstStop {
 		c.Log(INFO, "clean up container")
+		go c.p.checkJobFinished()
 
 		//reset streams and loggers, in case restart may use them.
 		oldStreams := c.streams
@@ -1233,6 +1307,7 @@ func (cs *ContainerStatus) Start() error {
 	}
 
 	cs.Killed = false
+	cs.OOM = oomNone
 	cs.State = S_CONTAINER_RUNNING
 	cs.stateChanged.Broadcast()
 
@@ -1245,6 +1320,12 @@ func (cs *ContainerStatus) SetKilled() {
 	cs.Unlock()
 }
 
+func (cs *ContainerStatus) SetOOM(oom string) {
+	cs.Lock()
+	cs.OOM = oom
+	cs.Unlock()
+}
+
 func (cs *ContainerStatus) Running(t time.Time) error {
 	cs.Lock()
 	defer cs.Unlock()
@@ -277,14 +277,22 @@ func (p *XPod) RemoveContainer(id string) error {
 	p.factory.registry.ReleaseContainer(id, c.SpecName())
 	p.statusLock.Lock()
 	delete(p.containers, id)
+	p.removeInitContainer(id)
 	p.statusLock.Unlock()
 
-	//remove volumes from daemondb
+	//remove volumes from daemondb, and from the pod, so that they could be
+	//inserted again by a new container
 	for _, vName := range removedVols {
 		if v, ok := p.volumes[vName]; ok {
 			if err = v.removeFromDB(); err != nil {
 				return err
 			}
+			if ev := v.umount(); ev != nil {
+				v.Log(WARNING, "failed to umount the removed volume: %v", ev)
+			}
+			p.statusLock.Lock()
+			delete(p.volumes, vName)
+			p.statusLock.Unlock()
 		}
 	}
 	// remove container in daemondb.
@@ -420,12 +428,27 @@ func (p *XPod) stopAllContainers(graceful int) error {
 		cList = append(cList, cid)
 	}
 
-	err := p.stopContainers(cList, graceful)
+	// stop the containers in the reverse order of starting them
+	layers, err := p.containerLayers(cList)
 	if err != nil {
-		p.Log(ERROR, "exception during stop all containers: %v", err)
+		err = p.stopContainers(cList, graceful)
+		if err != nil {
+			p.Log(ERROR, "exception during stop all containers: %v", err)
+		}
+		return err
+	}
+	for i := len(layers) - 1; i >= 0; i-- {
+		ids := make([]string, 0, len(layers[i]))
+		for _, c := range layers[i] {
+			ids = append(ids, c.Id())
+		}
+		if err = p.stopContainers(ids, graceful); err != nil {
+			p.Log(ERROR, "exception during stop all containers: %v", err)
+			return err
+		}
 	}
 
-	return err
+	return nil
 }
 
 func (p *XPod) stopContainers(cList []string, graceful int) error {
@@ -455,6 +478,14 @@ func (p *XPod) stopContainers(cList []string, graceful int) error {
 				toc = time.After(waitTime)
 			}
 			forceKill := graceful == 0
+			if !forceKill && c.spec.PreStop != nil {
+				// the preStop hook runs within the graceful timeout
+				timeout := waitTime
+				if graceful < 0 {
+					timeout = hookTimeout
+				}
+				c.runHook("preStop", c.spec.PreStop, timeout)
+			}
 			resChan := p.sandbox.WaitProcess(true, []string{c.Id()}, -1)
 			c.Log(DEBUG, "now, stop container")
 			err := c.terminate(forceKill)
@@ -592,6 +623,9 @@ func (p *XPod) decommissionResources() (err error) {
 		err = nil
 	}
 
+	p.removeNetworkPolicy()
+	p.services.stopHealthCheck()
+
 	for _, c := range p.containers {
 		ec := c.umountRootVol()
 		if ec != nil {
@@ -620,7 +654,7 @@ func (p *XPod) decommissionResources() (err error) {
 
 	cleanupHosts(p.Id())
 	// then it could be start again.
-	p.factory.hosts = HostsCreator(p.Id())
+	p.factory.hosts = HostsCreator(p.Id(), p.globalSpec.HostAliases)
 
 	return err
 }
@@ -0,0 +1,162 @@
+package pod
+
+import (
+	"fmt"
+	"time"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+// healthCheckTimeout is the time a health check command could run.
+const healthCheckTimeout = 10 * time.Second
+
+func (p *XPod) containerBySpecName(name string) *Container {
+	for _, c := range p.containers {
+		if c.SpecName() == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// containerLayers sorts the containers in layers by the dependsOn of them,
+// the containers of a layer depend only on the ones of the earlier layers.
+func (p *XPod) containerLayers(cList []string) ([][]*Container, error) {
+	var (
+		specs = make([]*apitypes.UserContainer, 0, len(cList))
+		byId  = make(map[string]*Container, len(cList))
+	)
+	for _, cid := range cList {
+		if c, ok := p.containers[cid]; ok {
+			specs = append(specs, c.spec)
+			byId[cid] = c
+		}
+	}
+
+	sorted, err := apitypes.SortContainerDependencies(specs)
+	if err != nil {
+		p.Log(ERROR, err)
+		return nil, err
+	}
+
+	layers := make([][]*Container, 0, len(sorted))
+	for _, l := range sorted {
+		layer := make([]*Container, 0, len(l))
+		for _, spec := range l {
+			layer = append(layer, byId[spec.Id])
+		}
+		layers = append(layers, layer)
+	}
+	return layers, nil
+}
+
+// withDependencies returns the container and the containers it depends on,
+// recursively. The dependencies which have reached their conditions already
+// are skipped.
+func (p *XPod) withDependencies(cid string, result map[string]bool) {
+	c, ok := p.containers[cid]
+	if !ok || result[cid] {
+		return
+	}
+	result[cid] = true
+	for _, d := range c.spec.DependsOn {
+		dc := p.containerBySpecName(d.Container)
+		if dc == nil || dc.dependencyReached(d) {
+			continue
+		}
+		p.withDependencies(dc.Id(), result)
+	}
+}
+
+// startContainers starts the containers layer by layer, the containers of
+// a layer are started in parallel once their dependencies are reached.
+func (p *XPod) startContainers(cList []string) error {
+	layers, err := p.containerLayers(cList)
+	if err != nil {
+		return err
+	}
+
+	for _, layer := range layers {
+		future := utils.NewFutureSet()
+		for _, c := range layer {
+			c := c
+			future.Add(c.Id(), func() error {
+				if err := p.waitDependencies(c); err != nil {
+					c.Log(ERROR, err)
+					return err
+				}
+				return c.start()
+			})
+		}
+		if err := future.Wait(ProvisionTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitDependencies waits the dependencies of the container to reach their
+// conditions, the dependencies should have been started.
+func (p *XPod) waitDependencies(c *Container) error {
+	for _, d := range c.spec.DependsOn {
+		dc := p.containerBySpecName(d.Container)
+		if dc == nil {
+			return fmt.Errorf("dependency %s of container %s not found", d.Container, c.SpecName())
+		}
+		switch d.DependencyCondition() {
+		case apitypes.DEPENDENCY_STARTED:
+			// started in the earlier layers
+		case apitypes.DEPENDENCY_HEALTHY:
+			if err := dc.waitHealthy(ProvisionTimeout); err != nil {
+				return err
+			}
+		case apitypes.DEPENDENCY_COMPLETED:
+			if code, _ := dc.GetExitCode(); code != 0 {
+				return fmt.Errorf("dependency %s of container %s exited with code %d", d.Container, c.SpecName(), code)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Container) dependencyReached(d *apitypes.UserContainerDependency) bool {
+	switch d.DependencyCondition() {
+	case apitypes.DEPENDENCY_COMPLETED:
+		c.status.RLock()
+		defer c.status.RUnlock()
+		return c.status.State == S_CONTAINER_CREATED && c.status.FinishedAt != epocZero &&
+			c.status.ExitCode == 0 && !c.status.Killed
+	default:
+		return c.IsRunning()
+	}
+}
+
+// waitHealthy runs the health check of the container until it succeeds.
+func (c *Container) waitHealthy(timeout time.Duration) error {
+	hc := c.spec.HealthCheck
+	if hc == nil || len(hc.Command) == 0 {
+		return fmt.Errorf("container %s has no health check", c.SpecName())
+	}
+	interval := time.Second
+	if hc.Interval > 0 {
+		interval = time.Duration(hc.Interval) * time.Second
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		if !c.IsRunning() {
+			return fmt.Errorf("container %s is not running", c.SpecName())
+		}
+		code, err := c.execSync(hc.Command, healthCheckTimeout)
+		if err == nil && code == 0 {
+			c.Log(DEBUG, "container is healthy")
+			return nil
+		}
+		c.Log(TRACE, "health check failed, code %d: %v", code, err)
+		if time.Now().After(deadline) {
+			return fmt.Errorf("container %s is not healthy in %v", c.SpecName(), timeout)
+		}
+		time.Sleep(interval)
+	}
+}
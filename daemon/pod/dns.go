@@ -0,0 +1,60 @@
+package pod
+
+import (
+	"sort"
+	"strings"
+)
+
+// Addresses returns the addresses of the interfaces of the running pod,
+// sorted by the ids of the interfaces.
+func (p *XPod) Addresses() []string {
+	p.statusLock.RLock()
+	defer p.statusLock.RUnlock()
+
+	if p.status != S_POD_RUNNING {
+		return nil
+	}
+	ids := make([]string, 0, len(p.interfaces))
+	for id := range p.interfaces {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	result := []string{}
+	for _, id := range ids {
+		inf := p.interfaces[id]
+		if inf.descript != nil && inf.descript.Ip != "" {
+			result = append(result, strings.SplitN(inf.descript.Ip, "/", 2)[0])
+		}
+	}
+	return result
+}
+
+// LookupName resolves a name in the running pod, the name of a container
+// is resolved to the addresses of the pod, and the name of a service to
+// the service IP.
+func (p *XPod) LookupName(name string) []string {
+	found := false
+	p.statusLock.RLock()
+	for _, c := range p.containers {
+		if strings.EqualFold(c.SpecName(), name) {
+			found = true
+			break
+		}
+	}
+	p.statusLock.RUnlock()
+	if found {
+		return p.Addresses()
+	}
+
+	if !p.IsRunning() {
+		return nil
+	}
+	srvs, _ := p.GetServices()
+	for _, srv := range srvs {
+		if srv.Name != "" && strings.EqualFold(srv.Name, name) {
+			return []string{srv.ServiceIP}
+		}
+	}
+	return nil
+}
@@ -7,9 +7,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 	"syscall"
 
 	"github.com/golang/glog"
+	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 )
 
@@ -39,7 +41,7 @@ var (
 	defaultHostsFilename = "hosts"
 )
 
-func generateDefaultHosts() ([]byte, error) {
+func generateDefaultHosts(aliases []*apitypes.HostAlias) ([]byte, error) {
 	content := bytes.NewBuffer(nil)
 
 	for _, r := range defaultHosts {
@@ -47,13 +49,19 @@ func generateDefaultHosts() ([]byte, error) {
 			return nil, err
 		}
 	}
+	for _, alias := range aliases {
+		r := Record{Hosts: strings.Join(alias.Hostnames, " "), IP: alias.Ip}
+		if _, err := r.WriteTo(content); err != nil {
+			return nil, err
+		}
+	}
 
 	return content.Bytes(), nil
 }
 
-func HostsCreator(pod string) *utils.Initializer {
+func HostsCreator(pod string, aliases []*apitypes.HostAlias) *utils.Initializer {
 	return utils.NewInitializer(func() {
-		prepareHosts(pod)
+		prepareHosts(pod, aliases)
 	})
 }
 
@@ -64,8 +72,9 @@ func HostsPath(pod string) (hostsDir, hostsPath string) {
 	return
 }
 
-// prepareHosts creates hosts file for given pod
-func prepareHosts(podID string) (string, error) {
+// prepareHosts creates hosts file for given pod, with the host aliases of
+// the pod
+func prepareHosts(podID string, aliases []*apitypes.HostAlias) (string, error) {
 	var err error
 
 	hostsDir, hostsPath := HostsPath(podID)
@@ -80,7 +89,7 @@ func prepareHosts(podID string) (string, error) {
 			return "", err
 		}
 
-		hostsContent, err := generateDefaultHosts()
+		hostsContent, err := generateDefaultHosts(aliases)
 		if err != nil {
 			return "", err
 		}
@@ -0,0 +1,48 @@
+package pod
+
+import (
+	"strconv"
+)
+
+const (
+	// POD_PRIORITY_LABEL is the priority of the pods whose spec does not set
+	// one, the pods with lower priority are evicted first.
+	POD_PRIORITY_LABEL = "sh.hyper.pod.priority"
+
+	POD_REASON_EVICTED = "Evicted"
+)
+
+// Priority returns the eviction priority of the pod, from the spec or the
+// priority label, 0 by default.
+func (p *XPod) Priority() int32 {
+	if p.globalSpec.Priority != 0 {
+		return p.globalSpec.Priority
+	}
+	if v, ok := p.labels[POD_PRIORITY_LABEL]; ok {
+		if priority, err := strconv.ParseInt(v, 10, 32); err == nil {
+			return int32(priority)
+		}
+		p.Log(WARNING, "invalid priority label %s=%q", POD_PRIORITY_LABEL, v)
+	}
+	return 0
+}
+
+// Evict stops the pod to relieve the host, and records the reason in the
+// status of the pod.
+func (p *XPod) Evict(message string, graceful int) error {
+	p.Log(INFO, "evicting the pod: %s", message)
+	p.statusLock.Lock()
+	p.stopReason = POD_REASON_EVICTED
+	p.stopMessage = message
+	p.statusLock.Unlock()
+	if err := p.savePodMeta(); err != nil {
+		p.Log(WARNING, "failed to save the eviction reason: %v", err)
+	}
+
+	if err := p.Stop(graceful); err != nil {
+		p.Log(ERROR, "failed to stop the evicted pod: %v", err)
+		p.ForceQuit()
+		return err
+	}
+	return nil
+}
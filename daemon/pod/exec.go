@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"syscall"
 	"time"
 
@@ -241,3 +242,54 @@ func (p *XPod) ExecVM(cmd string, stdin io.ReadCloser, stdout, stderr io.WriteCl
 	<-wReader.wait
 	return res, err
 }
+
+type discardCloser struct {
+	io.Writer
+}
+
+func (discardCloser) Close() error { return nil }
+
+// execSync runs the command in the container, and returns its exit code.
+// The output of the command is discarded.
+func (c *Container) execSync(cmds []string, timeout time.Duration) (int, error) {
+	if c.p.sandbox == nil || c.descript == nil {
+		return -1, fmt.Errorf("container not ready for exec")
+	}
+
+	execId := fmt.Sprintf("exec-%s", utils.RandStr(10, "alpha"))
+	result := c.p.sandbox.WaitProcess(false, []string{execId}, int(timeout/time.Second))
+	if result == nil {
+		return -1, fmt.Errorf("can not wait exec %v", cmds)
+	}
+
+	var envs []string
+	for e, v := range c.descript.Envs {
+		envs = append(envs, fmt.Sprintf("%s=%s", e, v))
+	}
+	process := &api.Process{
+		Container: c.Id(),
+		Id:        execId,
+		Args:      cmds,
+		Envs:      envs,
+		Workdir:   c.descript.Workdir,
+	}
+	if c.descript.UGI != nil {
+		process.User = c.descript.UGI.User
+		process.Group = c.descript.UGI.Group
+		process.AdditionalGroup = c.descript.UGI.AdditionalGroups
+	}
+
+	tty := &hypervisor.TtyIO{
+		Stdout: discardCloser{ioutil.Discard},
+		Stderr: discardCloser{ioutil.Discard},
+	}
+	if err := c.p.sandbox.AddProcess(process, tty); err != nil {
+		return -1, err
+	}
+
+	r, ok := <-result
+	if !ok {
+		return -1, fmt.Errorf("exec %v timeout or interrupted", cmds)
+	}
+	return r.Code, nil
+}
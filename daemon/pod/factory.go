@@ -38,12 +38,17 @@ type GlobalLogConfig struct {
 	PodIdInPath bool
 }
 
+// VmFactories provides the VM factory of each runtime profile of the pods.
+type VmFactories interface {
+	VmFactory(profile string) (factory.Factory, error)
+}
+
 type PodFactory struct {
 	sd         PodStorage
 	registry   *PodList
 	db         *daemondb.DaemonDB
 	engine     ContainerEngine
-	vmFactory  factory.Factory
+	vmFactory  VmFactories
 	hosts      *utils.Initializer
 	logCfg     *GlobalLogConfig
 	logCreator logger.Creator
@@ -55,7 +60,7 @@ type LogStatus struct {
 	LogPath string
 }
 
-func NewPodFactory(vmFactory factory.Factory, registry *PodList, db *daemondb.DaemonDB, sd PodStorage, eng ContainerEngine, logCfg *GlobalLogConfig) *PodFactory {
+func NewPodFactory(vmFactory VmFactories, registry *PodList, db *daemondb.DaemonDB, sd PodStorage, eng ContainerEngine, logCfg *GlobalLogConfig) *PodFactory {
 	return &PodFactory{
 		sd:        sd,
 		db:        db,
@@ -0,0 +1,94 @@
+package pod
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	// hookTimeout is the time the postStart hook, or the preStop hook
+	// without a graceful timeout, could run.
+	hookTimeout = 30 * time.Second
+	// maxContainerEvents is the number of the recent events kept for a
+	// container.
+	maxContainerEvents = 10
+)
+
+// addEvent records an event of the container, which is reported in the
+// container status.
+func (c *Container) addEvent(reason, message string) {
+	c.status.Lock()
+	c.events = append(c.events, &apitypes.ContainerEvent{
+		Time:    time.Now().Format(time.RFC3339),
+		Reason:  reason,
+		Message: message,
+	})
+	if len(c.events) > maxContainerEvents {
+		c.events = c.events[len(c.events)-maxContainerEvents:]
+	}
+	c.status.Unlock()
+}
+
+// runHook runs the lifecycle hook, the failure is logged and recorded as an
+// event of the container.
+func (c *Container) runHook(name string, hook *apitypes.UserContainerHook, timeout time.Duration) error {
+	if hook == nil {
+		return nil
+	}
+
+	c.Log(DEBUG, "run %s hook", name)
+	var err error
+	if len(hook.Command) > 0 {
+		var code int
+		code, err = c.execSync(hook.Command, timeout)
+		if err == nil && code != 0 {
+			err = fmt.Errorf("command %v exited with code %d", hook.Command, code)
+		}
+	} else {
+		err = c.httpHook(hook.Http, timeout)
+	}
+
+	if err != nil {
+		c.Log(WARNING, "%s hook failed: %v", name, err)
+		c.addEvent(strings.Title(name)+"HookFailed", err.Error())
+		return err
+	}
+	c.Log(DEBUG, "%s hook done", name)
+	return nil
+}
+
+func (c *Container) httpHook(h *apitypes.UserContainerHTTPHook, timeout time.Duration) error {
+	if c.p.containerIP == "" {
+		return fmt.Errorf("pod has no IP address")
+	}
+	method := h.Method
+	if method == "" {
+		method = "GET"
+	}
+	path := h.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(c.p.containerIP, strconv.Itoa(int(h.Port))), path)
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("%s %s returned %s", method, url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package pod
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxInitContainerRetry is the times a failed init container is restarted
+// if the restart policy of the pod is "always" or "onFailure".
+const maxInitContainerRetry = 5
+
+func (p *XPod) isInitContainer(cid string) bool {
+	for _, id := range p.initContainers {
+		if id == cid {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *XPod) removeInitContainer(cid string) {
+	for i, id := range p.initContainers {
+		if id == cid {
+			p.initContainers = append(p.initContainers[:i], p.initContainers[i+1:]...)
+			return
+		}
+	}
+}
+
+func (p *XPod) restartOnFailure() bool {
+	return p.globalSpec.RestartPolicy == "always" || p.globalSpec.RestartPolicy == "onFailure"
+}
+
+// runInitContainers starts the init containers one by one in the order of
+// the spec, each of them should exit 0 before the next one starts.
+func (p *XPod) runInitContainers() error {
+	for _, cid := range p.initContainers {
+		c, ok := p.containers[cid]
+		if !ok {
+			continue
+		}
+		if err := p.runInitContainer(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *XPod) runInitContainer(c *Container) error {
+	backoff := time.Second
+	for retry := 0; ; retry++ {
+		c.Log(INFO, "run init container")
+		if err := c.start(); err != nil {
+			return err
+		}
+		code, _ := c.GetExitCode()
+		if code == 0 {
+			c.Log(INFO, "init container completed")
+			return nil
+		}
+
+		err := fmt.Errorf("init container %s exited with code %d", c.SpecName(), code)
+		if !p.IsAlive() || !p.restartOnFailure() || retry >= maxInitContainerRetry {
+			c.Log(ERROR, err)
+			return err
+		}
+		c.Log(WARNING, "%v, restart it in %v", err, backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
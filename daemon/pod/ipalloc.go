@@ -0,0 +1,212 @@
+package pod
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/distribution/registry/api/errcode"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/errors"
+	"github.com/hyperhq/hyperd/networking/networks"
+	"github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor/network"
+)
+
+// allocation returns the address allocated to the interface from the
+// default bridge or a named network, or nil if the address is configured
+// in the spec or set up by the CNI plugins.
+func (inf *Interface) allocation() *types.PersistIPAllocation {
+	if inf.cni != nil || inf.descript == nil || inf.descript.Ip == "" {
+		return nil
+	}
+	if inf.spec.Network == "" && inf.spec.Ip != "" {
+		return nil
+	}
+	ip, _, err := network.IpParser(inf.descript.Ip)
+	if err != nil || ip == nil {
+		return nil
+	}
+	return &types.PersistIPAllocation{
+		Ip:        ip.String(),
+		Network:   inf.spec.Network,
+		Pod:       inf.p.Id(),
+		Interface: inf.spec.Id,
+	}
+}
+
+// saveIPAllocation saves the allocated address, then the allocator could be
+// re-seeded with it even if the pod is not restored.
+func (inf *Interface) saveIPAllocation() error {
+	a := inf.allocation()
+	if a == nil {
+		return nil
+	}
+	return saveMessage(inf.p.factory.db, fmt.Sprintf(IPA_KEY_FMT, a.Ip), a, inf, "ip allocation")
+}
+
+func (inf *Interface) removeIPAllocation() error {
+	a := inf.allocation()
+	if a == nil {
+		return nil
+	}
+	return removeMessage(inf.p.factory.db, fmt.Sprintf(IPA_KEY_FMT, a.Ip), inf, "ip allocation")
+}
+
+// reserveAddress allocates the address again from the default bridge or
+// the named network, it fails if the address is allocated already, or with
+// ErrNetworkNotFound if the network is not restored.
+var reserveAddress = func(a *types.PersistIPAllocation) error {
+	if a.Network == "" {
+		_, err := network.AllocateAddr(a.Ip)
+		return err
+	}
+	n, err := networks.Get(a.Network)
+	if err != nil {
+		return err
+	}
+	_, err = n.Allocate(a.Pod, a.Interface, a.Ip)
+	return err
+}
+
+func isNetworkNotFound(err error) bool {
+	derr, ok := err.(errcode.Error)
+	return ok && derr.ErrorCode() == errors.ErrNetworkNotFound
+}
+
+func missingNetwork(a *types.PersistIPAllocation) *types.IPAllocation {
+	return &types.IPAllocation{
+		Ip:        a.Ip,
+		Network:   a.Network,
+		Pod:       a.Pod,
+		Interface: a.Interface,
+	}
+}
+
+// RestoreIPAllocations re-seeds the allocators with the addresses of the
+// interfaces of the pods restored as running. The saved allocations of the pods failed
+// to be restored are reserved too, as their sandboxes might be still using
+// them, and the other saved allocations are stale and removed. The
+// addresses found allocated to more than one interface, and the ones
+// allocated from the networks not restored, are logged and returned.
+func RestoreIPAllocations(db *daemondb.DaemonDB, pods []*XPod) ([]*types.IPConflict, []*types.IPAllocation) {
+	saved := make(map[string]*types.PersistIPAllocation)
+	list, err := db.PrefixList([]byte(IPA_KEY_PREFIX), nil)
+	if err != nil {
+		hlog.Log(ERROR, "failed to list ip allocations in db: %v", err)
+	}
+	for _, data := range list {
+		var a types.PersistIPAllocation
+		if err := proto.Unmarshal(data, &a); err != nil {
+			hlog.Log(ERROR, "failed to unpack ip allocation: %v", err)
+			continue
+		}
+		saved[a.Ip] = &a
+	}
+
+	layouts := make(map[string]bool)
+	keys, err := ListAllPods(db)
+	if err != nil {
+		hlog.Log(ERROR, "failed to list pods in db: %v", err)
+	}
+	for _, key := range keys {
+		layouts[strings.TrimPrefix(string(key), LAYOUT_KEY_PREFIX)] = true
+	}
+
+	var (
+		owners    = make(map[string]*types.PersistIPAllocation)
+		restored  = make(map[string]bool, len(pods))
+		conflicts = []*types.IPConflict{}
+		byIp      = make(map[string]*types.IPConflict)
+		missing   = []*types.IPAllocation{}
+	)
+	conflict := func(a *types.PersistIPAllocation, other string) {
+		c, ok := byIp[a.Ip]
+		if !ok {
+			c = &types.IPConflict{Ip: a.Ip, Network: a.Network}
+			if other != "" {
+				c.Pods = append(c.Pods, other)
+			}
+			byIp[a.Ip] = c
+			conflicts = append(conflicts, c)
+		}
+		c.Pods = append(c.Pods, a.Pod)
+	}
+
+	for _, p := range pods {
+		restored[p.Id()] = true
+		// the interfaces of the stopped pods keep the addresses they had,
+		// which are released already, and are allocated again on start
+		if !p.IsRunning() {
+			continue
+		}
+
+		p.resourceLock.Lock()
+		ids := make([]string, 0, len(p.interfaces))
+		for id := range p.interfaces {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			inf := p.interfaces[id]
+			a := inf.allocation()
+			if a == nil {
+				continue
+			}
+			if o, ok := owners[a.Ip]; ok {
+				inf.Log(ERROR, "ip %s is allocated to interface %s of pod %s too", a.Ip, o.Interface, o.Pod)
+				inf.ipConflict = true
+				conflict(a, o.Pod)
+				continue
+			}
+			if err := reserveAddress(a); isNetworkNotFound(err) {
+				inf.Log(ERROR, "network %s of ip %s is not restored", a.Network, a.Ip)
+				missing = append(missing, missingNetwork(a))
+				continue
+			} else if err != nil {
+				inf.Log(ERROR, "failed to reserve ip %s: %v", a.Ip, err)
+				inf.ipConflict = true
+				conflict(a, "")
+				continue
+			}
+			owners[a.Ip] = a
+			if s, ok := saved[a.Ip]; !ok || !proto.Equal(s, a) {
+				inf.saveIPAllocation()
+			}
+		}
+		p.resourceLock.Unlock()
+	}
+
+	ips := make([]string, 0, len(saved))
+	for ip := range saved {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	for _, ip := range ips {
+		a := saved[ip]
+		if _, ok := owners[ip]; ok {
+			continue
+		}
+		if restored[a.Pod] || !layouts[a.Pod] {
+			hlog.Log(INFO, "remove stale allocation of ip %s to pod %s", ip, a.Pod)
+			if err := db.Delete([]byte(fmt.Sprintf(IPA_KEY_FMT, ip))); err != nil {
+				hlog.Log(WARNING, "failed to remove stale ip allocation %s: %v", ip, err)
+			}
+			continue
+		}
+		if err := reserveAddress(a); isNetworkNotFound(err) {
+			hlog.Log(ERROR, "network %s of ip %s of pod %s is not restored", a.Network, ip, a.Pod)
+			missing = append(missing, missingNetwork(a))
+			continue
+		} else if err != nil {
+			hlog.Log(ERROR, "failed to reserve ip %s of pod %s: %v", ip, a.Pod, err)
+			conflict(a, "")
+			continue
+		}
+		owners[ip] = a
+	}
+
+	return conflicts, missing
+}
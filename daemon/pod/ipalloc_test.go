@@ -0,0 +1,231 @@
+package pod
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/errors"
+	apitypes "github.com/hyperhq/hyperd/types"
+	runv "github.com/hyperhq/runv/api"
+)
+
+// fakeAllocator replaces reserveAddress with the allocations kept in a map
+// keyed by the network and the address, only the networks in it exist.
+type fakeAllocator map[string]map[string]bool
+
+func (f fakeAllocator) install() func() {
+	saved := reserveAddress
+	reserveAddress = func(a *apitypes.PersistIPAllocation) error {
+		n, ok := f[a.Network]
+		if !ok {
+			return errors.ErrNetworkNotFound.WithArgs(a.Network)
+		}
+		if n[a.Ip] {
+			return fmt.Errorf("ip %s is allocated already", a.Ip)
+		}
+		n[a.Ip] = true
+		return nil
+	}
+	return func() { reserveAddress = saved }
+}
+
+func newTestDB(t *testing.T) (*daemondb.DaemonDB, func()) {
+	dir, err := ioutil.TempDir("", "ipalloc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := daemondb.NewDaemonDB(filepath.Join(dir, "hyper.db"))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func newTestPod(db *daemondb.DaemonDB, id string, infs ...*apitypes.UserInterface) *XPod {
+	p := &XPod{
+		name:         id,
+		logPrefix:    fmt.Sprintf("Pod[%s] ", id),
+		factory:      &PodFactory{db: db},
+		interfaces:   make(map[string]*Interface),
+		resourceLock: &sync.Mutex{},
+		status:       S_POD_RUNNING,
+		statusLock:   &sync.RWMutex{},
+	}
+	for _, spec := range infs {
+		inf := newInterface(p, spec)
+		inf.descript = &runv.InterfaceDescription{Id: spec.Id, Ip: spec.Ip}
+		p.interfaces[spec.Id] = inf
+	}
+	return p
+}
+
+func saveTestAllocation(t *testing.T, db *daemondb.DaemonDB, a *apitypes.PersistIPAllocation) {
+	data, err := proto.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Update([]byte(fmt.Sprintf(IPA_KEY_FMT, a.Ip)), data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func savedAllocations(t *testing.T, db *daemondb.DaemonDB) map[string]string {
+	list, err := db.PrefixList([]byte(IPA_KEY_PREFIX), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := make(map[string]string)
+	for _, data := range list {
+		var a apitypes.PersistIPAllocation
+		if err := proto.Unmarshal(data, &a); err != nil {
+			t.Fatal(err)
+		}
+		result[a.Ip] = a.Pod
+	}
+	return result
+}
+
+func TestIPAllocationSaveRemove(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	p := newTestPod(db, "pod1",
+		&apitypes.UserInterface{Id: "eth0", Network: "net1", Ip: "10.1.0.2/24"},
+		&apitypes.UserInterface{Id: "eth1", Ip: "10.2.0.2/24"},
+	)
+	for _, inf := range p.interfaces {
+		if err := inf.saveIPAllocation(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the address configured in the spec of the default bridge is not
+	// allocated
+	if saved := savedAllocations(t, db); !reflect.DeepEqual(saved, map[string]string{"10.1.0.2": "pod1"}) {
+		t.Fatalf("unexpected allocations saved: %v", saved)
+	}
+
+	if err := p.interfaces["eth0"].removeIPAllocation(); err != nil {
+		t.Fatal(err)
+	}
+	if saved := savedAllocations(t, db); len(saved) != 0 {
+		t.Fatalf("unexpected allocations left: %v", saved)
+	}
+}
+
+func TestRestoreIPAllocations(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	alloc := fakeAllocator{
+		"":     {"10.0.0.9": true},
+		"net1": {},
+	}
+	defer alloc.install()()
+
+	for _, id := range []string{"pod1", "pod2", "pod3", "pod5", "pod6", "pod7"} {
+		if err := db.Update([]byte(fmt.Sprintf(LAYOUT_KEY_FMT, id)), []byte{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, a := range []*apitypes.PersistIPAllocation{
+		// saved by a restored pod
+		{Ip: "10.0.0.2", Pod: "pod1", Interface: "eth0"},
+		// of the pod failed to be restored, reserved
+		{Ip: "10.1.0.3", Network: "net1", Pod: "pod5", Interface: "eth0"},
+		// of the removed pod, stale
+		{Ip: "10.0.0.4", Pod: "pod4", Interface: "eth0"},
+		// of the pod failed to be restored, in a network not restored
+		{Ip: "10.3.0.5", Network: "net3", Pod: "pod5", Interface: "eth1"},
+		// of the pod restored as stopped, stale
+		{Ip: "10.0.0.7", Pod: "pod6", Interface: "eth0"},
+	} {
+		saveTestAllocation(t, db, a)
+	}
+
+	pods := []*XPod{
+		newTestPod(db, "pod1",
+			&apitypes.UserInterface{Id: "eth0"},
+			&apitypes.UserInterface{Id: "eth1", Network: "net1"},
+		),
+		newTestPod(db, "pod2",
+			&apitypes.UserInterface{Id: "eth0"},
+			&apitypes.UserInterface{Id: "eth1", Network: "net2"},
+		),
+		newTestPod(db, "pod3", &apitypes.UserInterface{Id: "eth0"}),
+		// the stopped pods keep the addresses they had, the same one
+		newTestPod(db, "pod6", &apitypes.UserInterface{Id: "eth0"}),
+		newTestPod(db, "pod7", &apitypes.UserInterface{Id: "eth0"}),
+	}
+	pods[3].status = S_POD_STOPPED
+	pods[4].status = S_POD_STOPPED
+	// the specs of the default bridge have no address, which is allocated
+	for _, ip := range []struct{ pod, inf, ip string }{
+		{"pod1", "eth0", "10.0.0.2/24"},
+		{"pod1", "eth1", "10.1.0.2/24"},
+		{"pod2", "eth0", "10.0.0.2/24"},
+		{"pod2", "eth1", "10.2.0.2/24"},
+		{"pod3", "eth0", "10.0.0.9/24"},
+		{"pod6", "eth0", "10.0.0.7/24"},
+		{"pod7", "eth0", "10.0.0.7/24"},
+	} {
+		for _, p := range pods {
+			if p.Id() == ip.pod {
+				p.interfaces[ip.inf].descript.Ip = ip.ip
+			}
+		}
+	}
+
+	conflicts, missing := RestoreIPAllocations(db, pods)
+
+	expectedConflicts := []*apitypes.IPConflict{
+		{Ip: "10.0.0.2", Pods: []string{"pod1", "pod2"}},
+		{Ip: "10.0.0.9", Pods: []string{"pod3"}},
+	}
+	if !reflect.DeepEqual(conflicts, expectedConflicts) {
+		t.Fatalf("expected conflicts %v, got %v", expectedConflicts, conflicts)
+	}
+	expectedMissing := []*apitypes.IPAllocation{
+		{Ip: "10.2.0.2", Network: "net2", Pod: "pod2", Interface: "eth1"},
+		{Ip: "10.3.0.5", Network: "net3", Pod: "pod5", Interface: "eth1"},
+	}
+	if !reflect.DeepEqual(missing, expectedMissing) {
+		t.Fatalf("expected missing networks %v, got %v", expectedMissing, missing)
+	}
+
+	if !pods[1].interfaces["eth0"].ipConflict || !pods[2].interfaces["eth0"].ipConflict {
+		t.Fatalf("the conflicting interfaces are not marked")
+	}
+	if pods[0].interfaces["eth0"].ipConflict || pods[1].interfaces["eth1"].ipConflict ||
+		pods[3].interfaces["eth0"].ipConflict || pods[4].interfaces["eth0"].ipConflict {
+		t.Fatalf("the interfaces without conflicts are marked")
+	}
+
+	expectedAlloc := fakeAllocator{
+		"":     {"10.0.0.2": true, "10.0.0.9": true},
+		"net1": {"10.1.0.2": true, "10.1.0.3": true},
+	}
+	if !reflect.DeepEqual(alloc, expectedAlloc) {
+		t.Fatalf("expected the allocators re-seeded with %v, got %v", expectedAlloc, alloc)
+	}
+
+	expectedSaved := map[string]string{
+		"10.0.0.2": "pod1",
+		"10.1.0.2": "pod1",
+		"10.1.0.3": "pod5",
+		"10.3.0.5": "pod5",
+	}
+	if saved := savedAllocations(t, db); !reflect.DeepEqual(saved, expectedSaved) {
+		t.Fatalf("expected allocations saved %v, got %v", expectedSaved, saved)
+	}
+}
@@ -0,0 +1,79 @@
+package pod
+
+import (
+	"time"
+)
+
+// POD_TYPE_JOB is the type of the pods which run to completion, such pods
+// are stopped once all their containers have exited.
+const POD_TYPE_JOB = "job"
+
+// JobExitCodeRetention is how long an autoRemove job pod is kept after it
+// finished, if no client has got its exit code yet.
+var JobExitCodeRetention = time.Minute
+
+func (p *XPod) IsJob() bool {
+	return p.globalSpec.Type == POD_TYPE_JOB
+}
+
+// checkJobFinished stops the job pod if all its containers have exited, and
+// keeps the exit codes of the containers.
+func (p *XPod) checkJobFinished() {
+	if !p.IsJob() || !p.IsRunning() {
+		return
+	}
+
+	codes := make(map[string]int32, len(p.containers))
+	p.statusLock.RLock()
+	for cid, c := range p.containers {
+		if p.isInitContainer(cid) {
+			continue
+		}
+		c.status.RLock()
+		finished := c.status.State == S_CONTAINER_CREATED && c.status.FinishedAt != epocZero
+		code := int32(c.status.ExitCode)
+		if c.status.Killed {
+			code = 137
+		}
+		c.status.RUnlock()
+		if !finished {
+			p.statusLock.RUnlock()
+			return
+		}
+		codes[c.SpecName()] = code
+	}
+	p.statusLock.RUnlock()
+
+	p.Log(INFO, "all containers of the job have exited: %v, stop the pod", codes)
+	p.statusLock.Lock()
+	p.exitCodes = codes
+	p.finishedAt = time.Now()
+	p.statusLock.Unlock()
+	if err := p.savePodMeta(); err != nil {
+		p.Log(WARNING, "failed to save the exit codes of the job: %v", err)
+	}
+
+	if err := p.Stop(10); err != nil {
+		p.Log(ERROR, "failed to stop the finished job: %v", err)
+		p.ForceQuit()
+	}
+}
+
+// JobExpired returns whether the job pod has finished and should be removed
+// according to its autoRemove and ttlAfterFinished.
+func (p *XPod) JobExpired(now time.Time) bool {
+	if !p.IsJob() || !p.IsStopped() {
+		return false
+	}
+	p.statusLock.RLock()
+	finishedAt, read := p.finishedAt, p.exitCodeRead
+	p.statusLock.RUnlock()
+	if finishedAt.IsZero() {
+		return false
+	}
+	if p.globalSpec.TtlAfterFinished > 0 {
+		return now.Sub(finishedAt) >= time.Duration(p.globalSpec.TtlAfterFinished)*time.Second
+	}
+	// keep the exit code for the client waiting on the job, e.g. hyperctl run --rm
+	return p.globalSpec.AutoRemove && (read || now.Sub(finishedAt) >= JobExitCodeRetention)
+}
@@ -4,6 +4,10 @@ import (
 	"fmt"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/errors"
+	"github.com/hyperhq/hyperd/networking/bandwidth"
+	"github.com/hyperhq/hyperd/networking/cni"
+	"github.com/hyperhq/hyperd/networking/networks"
 	apitypes "github.com/hyperhq/hyperd/types"
 	runv "github.com/hyperhq/runv/api"
 	"github.com/hyperhq/runv/hypervisor/network"
@@ -14,6 +18,14 @@ type Interface struct {
 
 	spec     *apitypes.UserInterface
 	descript *runv.InterfaceDescription
+
+	// the endpoint set up by the CNI plugins, and the DNS they returned
+	cni        *cni.Endpoint
+	resolvConf string
+
+	// ipConflict is set if the allocated address is found allocated to
+	// another interface on restore, it is not released then.
+	ipConflict bool
 }
 
 const defaultInterfaceMtu = 1500
@@ -46,6 +58,14 @@ func (inf *Interface) prepare() error {
 		mtu = defaultInterfaceMtu
 	}
 
+	if inf.spec.Network != "" {
+		return inf.prepareInNetwork()
+	}
+
+	if inf.spec.Ip == "" && cni.Enabled() {
+		return inf.prepareWithCni()
+	}
+
 	if inf.spec.Ip == "" {
 		setting, err := network.AllocateAddr("")
 		if err != nil {
@@ -62,6 +82,7 @@ func (inf *Interface) prepare() error {
 			Mtu:     inf.spec.Mtu,
 			TapName: inf.spec.Ifname,
 		}
+		inf.saveIPAllocation()
 		return nil
 	}
 
@@ -79,6 +100,116 @@ func (inf *Interface) prepare() error {
 	return nil
 }
 
+// prepareInNetwork allocates the address, the static one if configured,
+// from the named network of the interface.
+func (inf *Interface) prepareInNetwork() error {
+	n, err := networks.Get(inf.spec.Network)
+	if err != nil {
+		inf.Log(ERROR, err)
+		return err
+	}
+	ip, err := n.Allocate(inf.p.Id(), inf.spec.Id, inf.spec.Ip)
+	if err != nil {
+		inf.Log(ERROR, "failed to allocate IP: %v", err)
+		return err
+	}
+	mtu := inf.spec.Mtu
+	if mtu == 0 {
+		mtu = n.Mtu()
+	}
+	inf.descript = &runv.InterfaceDescription{
+		Id:      inf.spec.Id,
+		Lo:      false,
+		Bridge:  n.Bridge(),
+		Ip:      ip,
+		Mac:     inf.spec.Mac,
+		Gw:      n.Gateway(),
+		Mtu:     mtu,
+		TapName: inf.spec.Ifname,
+	}
+	inf.saveIPAllocation()
+	return nil
+}
+
+// prepareWithCni sets up the interface with the CNI plugins, the result is
+// saved at once, then the plugins could be invoked to delete it even if
+// hyperd restarts.
+func (inf *Interface) prepareWithCni() error {
+	ifname := inf.spec.Ifname
+	if ifname == "" {
+		ifname = "eth" + inf.spec.Id
+		if len(ifname) > 15 {
+			ifname = ifname[:15]
+		}
+	}
+	ep, result, err := cni.Network().Setup(inf.p.Id(), ifname, inf.cniRuntimeConfig())
+	if err != nil {
+		inf.Log(ERROR, "failed to set up interface with CNI: %v", err)
+		return err
+	}
+	for _, r := range result.Routes {
+		if r.Dst != "0.0.0.0/0" {
+			inf.Log(WARNING, "route to %s via %s returned by CNI is ignored", r.Dst, r.GW)
+		}
+	}
+	inf.cni = ep
+	inf.resolvConf = result.ResolvConf()
+	inf.descript = &runv.InterfaceDescription{
+		Id:      inf.spec.Id,
+		Lo:      false,
+		Bridge:  ep.Bridge,
+		Ip:      result.IP,
+		Mac:     inf.spec.Mac,
+		Gw:      result.DefaultGateway(),
+		Mtu:     inf.spec.Mtu,
+		TapName: inf.spec.Ifname,
+	}
+	if err = inf.saveInterface(); err != nil {
+		inf.cleanupCni()
+		return err
+	}
+	return nil
+}
+
+// cniRuntimeConfig returns the port mappings of the pod for the plugins
+// having the portMappings capability, the ranges are expanded to the ports.
+func (inf *Interface) cniRuntimeConfig() *cni.RuntimeConfig {
+	pms, err := translatePortMapping(inf.p.portMappings)
+	if err != nil || len(pms) == 0 {
+		return nil
+	}
+	rc := &cni.RuntimeConfig{}
+	for _, pm := range pms {
+		from, to := pm.FromPorts, pm.ToPorts
+		for port := from.Begin; port <= from.End; port++ {
+			cport := to.Begin
+			if to.End-to.Begin == from.End-from.Begin {
+				cport += port - from.Begin
+			}
+			rc.PortMappings = append(rc.PortMappings, cni.PortMapping{
+				HostPort:      port,
+				ContainerPort: cport,
+				Protocol:      pm.Protocol,
+			})
+		}
+	}
+	return rc
+}
+
+// cleanupCni deletes the interface with the CNI plugins, with the result
+// of ADD. The saved result is kept, as DEL is idempotent, replaying it
+// after a restart is harmless.
+func (inf *Interface) cleanupCni() error {
+	inf.Log(DEBUG, "delete interface %s with CNI", inf.cni.IfName)
+	err := inf.cni.Teardown()
+	if err != nil {
+		inf.Log(ERROR, "failed to delete interface with CNI: %v", err)
+	}
+	inf.cni = nil
+	inf.resolvConf = ""
+	return err
+}
+
 func (inf *Interface) add() error {
 	if inf.descript == nil || inf.descript.Ip == "" {
 		err := fmt.Errorf("interfice has not ready %#v", inf.descript)
@@ -88,12 +219,74 @@ func (inf *Interface) add() error {
 	err := inf.p.sandbox.AddNic(inf.descript)
 	if err != nil {
 		inf.Log(ERROR, "failed to add NIC: %v", err)
+		return err
+	}
+	if bandwidth.IsZero(inf.spec.Bandwidth) {
+		return nil
+	}
+	if err = inf.shape(inf.spec.Bandwidth); err != nil {
+		inf.p.sandbox.DeleteNic(inf.spec.Id)
 	}
 	return err
 }
 
+// hostDevice returns the tap device of the interface on the host, which is
+// named by the hypervisor if not specified.
+func (inf *Interface) hostDevice() string {
+	for _, nic := range inf.p.sandbox.AllNics() {
+		if nic.Id == inf.spec.Id && nic.HostDevice != "" {
+			return nic.HostDevice
+		}
+	}
+	return inf.descript.TapName
+}
+
+// shape limits the traffic to and from the pod on the host device.
+func (inf *Interface) shape(bw *apitypes.InterfaceBandwidth) error {
+	dev := inf.hostDevice()
+	if dev == "" {
+		err := fmt.Errorf("tap device is unknown, could not limit the bandwidth")
+		inf.Log(ERROR, err)
+		return err
+	}
+	inf.Log(DEBUG, "limit the bandwidth on %s: %v", dev, bw)
+	if err := bandwidth.Set(dev, bw); err != nil {
+		inf.Log(ERROR, "failed to limit the bandwidth: %v", err)
+		return err
+	}
+	return nil
+}
+
 func (inf *Interface) cleanup() error {
-	if inf.spec.Ip != "" || inf.descript == nil || inf.descript.Ip == "" {
+	if inf.cni != nil {
+		return inf.cleanupCni()
+	}
+
+	if inf.descript == nil || inf.descript.Ip == "" {
+		return nil
+	}
+
+	if inf.ipConflict {
+		inf.Log(WARNING, "ip %s is allocated to another interface, not released", inf.descript.Ip)
+		return nil
+	}
+
+	if inf.spec.Network != "" {
+		n, err := networks.Get(inf.spec.Network)
+		if err != nil {
+			inf.Log(ERROR, "failed to release IP %s: %v", inf.descript.Ip, err)
+			return err
+		}
+		inf.Log(DEBUG, "release IP address %s to network %s", inf.descript.Ip, n.Name())
+		if err = n.Release(inf.descript.Ip); err != nil {
+			inf.Log(ERROR, "failed to release IP %s: %v", inf.descript.Ip, err)
+			return err
+		}
+		inf.removeIPAllocation()
+		return nil
+	}
+
+	if inf.spec.Ip != "" {
 		return nil
 	}
 
@@ -101,6 +294,34 @@ func (inf *Interface) cleanup() error {
 	err := network.ReleaseAddr(inf.descript.Ip)
 	if err != nil {
 		inf.Log(ERROR, "failed to release IP %s: %v", inf.descript.Ip, nil)
+		return err
 	}
-	return err
+	inf.removeIPAllocation()
+	return nil
+}
+
+// SetInterfaceBandwidth changes the rate limits of an interface of the
+// running pod, and saves them with the interface.
+func (p *XPod) SetInterfaceBandwidth(id string, bw *apitypes.InterfaceBandwidth) error {
+	if err := bw.Validate(); err != nil {
+		return err
+	}
+	if !p.IsRunning() {
+		return errors.ErrPodNotRunning.WithArgs(p.Id())
+	}
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	inf, ok := p.interfaces[id]
+	if !ok {
+		return errors.ErrInterfaceNotFound.WithArgs(id, p.Id())
+	}
+	if bandwidth.IsZero(bw) {
+		bw = nil
+	}
+	if err := inf.shape(bw); err != nil {
+		return err
+	}
+	inf.spec.Bandwidth = bw
+	return inf.saveInterface()
 }
@@ -3,10 +3,12 @@ package pod
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/networking/cni"
 	"github.com/hyperhq/hyperd/types"
 )
 
@@ -27,6 +29,8 @@ import (
 /// CX-{Container.Id()} Container Persistent Info
 /// VX-{Pod.ID()}-{Volume.Name()} Volume Persist Info
 /// IF-{Pod.ID()}-{Inf.Id()}
+/// IPA-{IP}: the address allocated to an interface, from the default bridge
+///         or a named network
 
 const (
 	LAYOUT_KEY_PREFIX = "PL-"
@@ -38,6 +42,8 @@ const (
 	CX_KEY_FMT        = "CX-%s"
 	VX_KEY_FMT        = "VX-%s-%s"
 	IF_KEY_FMT        = "IF-%s-%s"
+	IPA_KEY_PREFIX    = "IPA-"
+	IPA_KEY_FMT       = "IPA-%s"
 )
 
 func ListAllPods(db *daemondb.DaemonDB) ([][]byte, error) {
@@ -113,6 +119,7 @@ func LoadXPod(factory *PodFactory, layout *types.PersistPodLayout) (*XPod, error
 			return nil, err
 		}
 	}
+	p.initContainers = layout.InitContainers
 
 	err = p.loadSandbox()
 	if err != nil {
@@ -149,6 +156,12 @@ func LoadXPod(factory *PodFactory, layout *types.PersistPodLayout) (*XPod, error
 				return nil, err
 			}
 		}
+		// the chains of the pod are kept in iptables, re-register it
+		p.syncNetworkPolicy()
+		if err := p.restorePortMapping(); err != nil {
+			p.Log(WARNING, "host ports of the pod are not reserved, they might be mapped to other pods: %v", err)
+		}
+		p.services.checkHealth()
 	}
 
 	// don't need to reserve name again, because this is load
@@ -200,10 +213,11 @@ func (p *XPod) savePod() error {
 	}
 
 	pl := &types.PersistPodLayout{
-		Id:         p.Id(),
-		Containers: containers,
-		Volumes:    volumes,
-		Interfaces: interfaces,
+		Id:             p.Id(),
+		Containers:     containers,
+		Volumes:        volumes,
+		Interfaces:     interfaces,
+		InitContainers: p.initContainers,
 	}
 	return saveMessage(p.factory.db, fmt.Sprintf(LAYOUT_KEY_FMT, p.Id()), pl, p, "pod layout")
 }
@@ -271,10 +285,11 @@ func (p *XPod) saveLayout() error {
 	}
 
 	pl := &types.PersistPodLayout{
-		Id:         p.Id(),
-		Containers: containers,
-		Volumes:    volumes,
-		Interfaces: interfaces,
+		Id:             p.Id(),
+		Containers:     containers,
+		Volumes:        volumes,
+		Interfaces:     interfaces,
+		InitContainers: p.initContainers,
 	}
 	return saveMessage(p.factory.db, fmt.Sprintf(LAYOUT_KEY_FMT, p.Id()), pl, p, "pod layout")
 }
@@ -305,6 +320,12 @@ func (p *XPod) savePodMeta() error {
 	if p.info != nil {
 		meta.CreatedAt = p.info.CreatedAt
 	}
+	if !p.finishedAt.IsZero() {
+		meta.FinishedAt = p.finishedAt.Unix()
+		meta.ExitCodes = p.exitCodes
+	}
+	meta.StopReason = p.stopReason
+	meta.StopMessage = p.stopMessage
 	return saveMessage(p.factory.db, fmt.Sprintf(PMETA_KEY_FMT, p.Id()), meta, p, "pod meta")
 }
 
@@ -320,6 +341,12 @@ func (p *XPod) loadPodMeta() error {
 	}
 	p.labels = meta.Labels
 	p.services = newServices(p, meta.Services)
+	if meta.FinishedAt > 0 {
+		p.finishedAt = time.Unix(meta.FinishedAt, 0)
+		p.exitCodes = meta.ExitCodes
+	}
+	p.stopReason = meta.StopReason
+	p.stopMessage = meta.StopMessage
 	return nil
 }
 
@@ -423,6 +450,18 @@ func (inf *Interface) saveInterface() error {
 		Spec:     inf.spec,
 		Descript: inf.descript,
 	}
+	if inf.cni != nil {
+		ix.Cni = &types.PersistCni{
+			ContainerID:   inf.cni.ContainerID,
+			Ifname:        inf.cni.IfName,
+			Config:        inf.cni.Config,
+			Result:        inf.cni.Result,
+			RuntimeConfig: inf.cni.RuntimeConfig,
+			HostVeth:      inf.cni.HostVeth,
+			Bridge:        inf.cni.Bridge,
+			ResolvConf:    inf.resolvConf,
+		}
+	}
 	return saveMessage(inf.p.factory.db, fmt.Sprintf(IF_KEY_FMT, inf.p.Id(), inf.spec.Id), ix, inf, "interface info")
 }
 
@@ -434,6 +473,18 @@ func (p *XPod) loadInterface(id string) error {
 	}
 	inf := newInterface(p, ix.Spec)
 	inf.descript = ix.Descript
+	if ix.Cni != nil {
+		inf.cni = &cni.Endpoint{
+			ContainerID:   ix.Cni.ContainerID,
+			IfName:        ix.Cni.Ifname,
+			Config:        ix.Cni.Config,
+			Result:        ix.Cni.Result,
+			RuntimeConfig: ix.Cni.RuntimeConfig,
+			HostVeth:      ix.Cni.HostVeth,
+			Bridge:        ix.Cni.Bridge,
+		}
+		inf.resolvConf = ix.Cni.ResolvConf
+	}
 	p.interfaces[inf.spec.Id] = inf
 	return nil
 }
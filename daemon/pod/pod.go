@@ -3,6 +3,7 @@ package pod
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -63,6 +64,23 @@ type XPod struct {
 	labels       map[string]string
 	resourceLock *sync.Mutex
 
+	// exitCodes is the exit codes of the containers of a finished job pod,
+	// keyed by the container names
+	exitCodes  map[string]int32
+	finishedAt time.Time
+	// exitCodeRead is set once a client has got the exit code of a
+	// container of the job pod, the autoRemove waits for it.
+	exitCodeRead bool
+
+	// stopReason and stopMessage tell why the pod was stopped by hyperd
+	// rather than by the user, e.g. evicted under host pressure
+	stopReason  string
+	stopMessage string
+
+	// initContainers is the ids of the init containers in the order to run,
+	// the init containers are included in the containers too.
+	initContainers []string
+
 	prestartExecs [][]string
 
 	sandbox *hypervisor.Vm
@@ -237,7 +255,13 @@ func (p *XPod) GetExitCode(cid, execId string) (uint8, error) {
 		return p.GetExecExitCode(cid, execId)
 	}
 	if c, ok := p.containers[cid]; ok {
-		return c.GetExitCode()
+		code, err := c.GetExitCode()
+		if err == nil && p.IsJob() {
+			p.statusLock.Lock()
+			p.exitCodeRead = true
+			p.statusLock.Unlock()
+		}
+		return code, err
 	}
 	err := fmt.Errorf("cannot find container %s", cid)
 	p.Log(ERROR, "failed to get exit code: %v", err)
@@ -297,6 +321,12 @@ func (p *XPod) ContainerInfo(cid string) (*apitypes.ContainerInfo, error) {
 
 }
 
+// Resource returns the vcpu and memory of the sandbox of the pod, the zero
+// values are the defaults of the sandbox.
+func (p *XPod) Resource() *apitypes.UserResource {
+	return p.globalSpec.Resource
+}
+
 func (p *XPod) Stats() *runvtypes.PodStats {
 	//use channel, don't block in resourceLock
 	ch := make(chan *runvtypes.PodStats, 1)
@@ -344,6 +374,7 @@ func (p *XPod) updatePodInfo() error {
 
 	var (
 		containers      = make([]*apitypes.Container, 0, len(p.containers))
+		initContainers  = make([]*apitypes.Container, 0, len(p.initContainers))
 		volumes         = make([]*apitypes.PodVolume, 0, len(p.volumes))
 		containerStatus = make([]*apitypes.ContainerStatus, 0, len(p.containers))
 	)
@@ -356,7 +387,21 @@ func (p *XPod) updatePodInfo() error {
 	p.info.Spec.Volumes = volumes
 
 	succeeeded := "Succeeded"
-	for _, c := range p.containers {
+	// the status of the init containers comes first, in the order to run
+	for _, cid := range p.initContainers {
+		if c, ok := p.containers[cid]; ok {
+			cs := c.InfoStatus()
+			initContainers = append(initContainers, c.Info())
+			containerStatus = append(containerStatus, cs)
+			if cs.Phase == "failed" {
+				succeeeded = "Failed"
+			}
+		}
+	}
+	for cid, c := range p.containers {
+		if p.isInitContainer(cid) {
+			continue
+		}
 		ci := c.Info()
 		cs := c.InfoStatus()
 		containers = append(containers, ci)
@@ -366,6 +411,13 @@ func (p *XPod) updatePodInfo() error {
 		}
 	}
 	p.info.Spec.Containers = containers
+	p.info.Spec.InitContainers = initContainers
+	if !p.finishedAt.IsZero() {
+		p.info.Status.FinishTime = p.finishedAt.Format(time.RFC3339)
+		p.info.Status.ExitCodes = p.exitCodes
+	}
+	p.info.Status.Reason = p.stopReason
+	p.info.Status.Message = p.stopMessage
 	p.info.Status.ContainerStatus = containerStatus
 
 	switch p.status {
@@ -381,16 +433,49 @@ func (p *XPod) updatePodInfo() error {
 		p.info.Status.Phase = "Running"
 	case S_POD_STOPPED:
 		p.info.Status.Phase = succeeeded
+		if p.stopReason != "" {
+			p.info.Status.Phase = "Failed"
+		}
 	case S_POD_ERROR:
 		p.info.Status.Phase = "Failed"
 	}
 	if p.status == S_POD_RUNNING && p.sandbox != nil && len(p.info.Status.PodIP) == 0 {
 		p.info.Status.PodIP = p.sandbox.GetIPAddrs()
 	}
+	p.info.Status.Interfaces = p.interfacesStatus()
 
 	return nil
 }
 
+// interfacesStatus returns the addresses, the tap devices and the rate
+// limits of the interfaces, sorted by the ids. The statusLock should be
+// held.
+func (p *XPod) interfacesStatus() []*apitypes.PodInterfaceStatus {
+	ids := make([]string, 0, len(p.interfaces))
+	for id := range p.interfaces {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	result := make([]*apitypes.PodInterfaceStatus, 0, len(ids))
+	for _, id := range ids {
+		inf := p.interfaces[id]
+		if inf.descript == nil {
+			continue
+		}
+		s := &apitypes.PodInterfaceStatus{
+			Id:        id,
+			Ip:        inf.descript.Ip,
+			Bandwidth: inf.spec.Bandwidth,
+		}
+		if p.status == S_POD_RUNNING && p.sandbox != nil {
+			s.HostDevice = inf.hostDevice()
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
 func (p *XPod) ContainerLogger(id string) logger.Logger {
 	c, ok := p.containers[id]
 	if ok {
@@ -422,7 +507,22 @@ func (p *XPod) SetLabel(labels map[string]string, update bool) error {
 		p.labels[k] = v
 	}
 
-	return nil
+	if err := p.syncNetworkPolicy(); err != nil {
+		return err
+	}
+	return p.savePodMeta()
+}
+
+// Labels returns a copy of the labels of the pod.
+func (p *XPod) Labels() map[string]string {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	labels := make(map[string]string, len(p.labels))
+	for k, v := range p.labels {
+		labels[k] = v
+	}
+	return labels
 }
 
 func (p *XPod) ContainerIds() []string {
@@ -441,6 +541,20 @@ func (p *XPod) ContainerNames() []string {
 	return result
 }
 
+// ContainerImages returns the image ids of the containers, or the image
+// names of the containers which have not been created yet.
+func (p *XPod) ContainerImages() []string {
+	result := make([]string, 0, len(p.containers))
+	for _, c := range p.containers {
+		if c.descript != nil {
+			result = append(result, c.descript.Image)
+		} else {
+			result = append(result, c.spec.Image)
+		}
+	}
+	return result
+}
+
 func (p *XPod) ContainerName2Id(name string) (string, bool) {
 	if name == "" {
 		return "", false
@@ -0,0 +1,48 @@
+package pod
+
+import (
+	"strings"
+
+	"github.com/hyperhq/hyperd/networking/policy"
+)
+
+// networkEndpoints returns the tap devices and the addresses of the pod
+// interfaces, the resourceLock should be held.
+func (p *XPod) networkEndpoints() []policy.Endpoint {
+	endpoints := []policy.Endpoint{}
+	for _, inf := range p.interfaces {
+		if inf.descript == nil || inf.descript.Ip == "" {
+			continue
+		}
+		tap := inf.hostDevice()
+		if tap == "" {
+			inf.Log(WARNING, "tap device is unknown, skip it in network policies")
+			continue
+		}
+		endpoints = append(endpoints, policy.Endpoint{
+			Tap: tap,
+			Ip:  strings.SplitN(inf.descript.Ip, "/", 2)[0],
+		})
+	}
+	return endpoints
+}
+
+// syncNetworkPolicy enforces the network policy of the running pod, and
+// updates the policies of the other pods, to which the pod might be a
+// peer. The resourceLock should be held.
+func (p *XPod) syncNetworkPolicy() error {
+	if p.sandbox == nil {
+		return nil
+	}
+	err := policy.Update(p.Id(), p.labels, p.globalSpec.NetworkPolicy, p.networkEndpoints())
+	if err != nil {
+		p.Log(ERROR, "failed to enforce network policy: %v", err)
+	}
+	return err
+}
+
+// removeNetworkPolicy removes the chains of the pod, and the pod from the
+// peers of the other policies.
+func (p *XPod) removeNetworkPolicy() {
+	policy.Remove(p.Id())
+}
@@ -0,0 +1,158 @@
+package pod
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hyperhq/hyperd/errors"
+	"github.com/hyperhq/hyperd/utils"
+	"github.com/hyperhq/runv/api"
+	hyperstartapi "github.com/hyperhq/runv/hyperstart/api/json"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+const portForwardDialTimeout = 10 * time.Second
+
+// portForwardCmd is the command run in the sandbox to tunnel the connection
+// to the port listening on the localhost of the pod.
+var portForwardCmd = func(port int32) []string {
+	return []string{"nc", "127.0.0.1", strconv.Itoa(int(port))}
+}
+
+// DialPort connects to the TCP port of the running pod, without any port
+// mapping. The addresses of the interfaces are tried in order, and then the
+// localhost of the pod through a tunnel in the sandbox, which reaches the
+// ports listening on the loopback of the pod only.
+func (p *XPod) DialPort(port int32) (io.ReadWriteCloser, error) {
+	if port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("incorrect port %d", port)
+	}
+	if !p.IsRunning() {
+		return nil, errors.ErrPodNotRunning.WithArgs(p.Id())
+	}
+
+	for _, addr := range p.Addresses() {
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(addr, strconv.Itoa(int(port))), portForwardDialTimeout)
+		if err != nil {
+			p.Log(DEBUG, "failed to connect to port %d at %s: %v", port, addr, err)
+			continue
+		}
+		p.Log(DEBUG, "forward connection %s to port %d", conn.LocalAddr(), port)
+		return conn, nil
+	}
+
+	conn, err := p.dialSandboxPort(port)
+	if err != nil {
+		p.Log(ERROR, "failed to connect to port %d: %v", port, err)
+		return nil, err
+	}
+	p.Log(DEBUG, "forward connection to port %d in the sandbox", port)
+	return conn, nil
+}
+
+// dialSandboxPort checks the port accepts connections on the localhost of
+// the pod, and starts the tunnel to it. Both depend on nc in the sandbox.
+func (p *XPod) dialSandboxPort(port int32) (io.ReadWriteCloser, error) {
+	if p.sandbox == nil {
+		return nil, fmt.Errorf("pod %s has no sandbox to forward port %d", p.Id(), port)
+	}
+	timeout := int32(portForwardDialTimeout / time.Second)
+	probe, err := json.Marshal(tcpProbeCmd("127.0.0.1", port, timeout))
+	if err != nil {
+		return nil, err
+	}
+	code, err := p.sandbox.HyperstartExec(string(probe), &hypervisor.TtyIO{
+		Stdin:  ioutil.NopCloser(bytes.NewReader(nil)),
+		Stdout: ioutil.Discard,
+		Stderr: ioutil.Discard,
+	})
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("failed to probe port %d of pod %s: %v", port, p.Id(), err)
+	case code == tcpProbeFailure:
+		return nil, fmt.Errorf("port %d of pod %s does not accept connections", port, p.Id())
+	case code != 0:
+		return nil, fmt.Errorf("nc is not available in the sandbox of pod %s to forward port %d, the probe exited with %d", p.Id(), port, code)
+	}
+
+	execId := fmt.Sprintf("portforward-%s", utils.RandStr(10, "alpha"))
+	result := p.sandbox.WaitProcess(false, []string{execId}, -1)
+	if result == nil {
+		return nil, fmt.Errorf("can not wait the tunnel %s of port %d", execId, port)
+	}
+
+	ir, iw := io.Pipe()
+	or, ow := io.Pipe()
+	err = p.sandbox.AddProcess(&api.Process{
+		Container: hyperstartapi.HYPERSTART_EXEC_CONTAINER,
+		Id:        execId,
+		Args:      portForwardCmd(port),
+		Envs:      []string{},
+		Workdir:   "/",
+	}, &hypervisor.TtyIO{
+		Stdin:  ir,
+		Stdout: ow,
+		Stderr: ioutil.Discard,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c := &sandboxConn{
+		stdin:  iw,
+		stdout: or,
+		exited: make(chan struct{}),
+		kill: func() error {
+			return p.sandbox.SignalProcess(hyperstartapi.HYPERSTART_EXEC_CONTAINER, execId, syscall.SIGKILL)
+		},
+	}
+	go func() {
+		var err error
+		if _, ok := <-result; !ok {
+			err = fmt.Errorf("tunnel %s of port %d is interrupted", execId, port)
+		}
+		close(c.exited)
+		ow.CloseWithError(err)
+	}()
+	return c, nil
+}
+
+// sandboxConn is the connection tunneled by the process in the sandbox,
+// closing the stdin of the process is the half close of the connection,
+// and the process is killed on close if it has not exited.
+type sandboxConn struct {
+	stdin  *io.PipeWriter
+	stdout *io.PipeReader
+	exited chan struct{}
+	kill   func() error
+}
+
+func (c *sandboxConn) Read(b []byte) (int, error) {
+	return c.stdout.Read(b)
+}
+
+func (c *sandboxConn) Write(b []byte) (int, error) {
+	return c.stdin.Write(b)
+}
+
+func (c *sandboxConn) CloseWrite() error {
+	return c.stdin.Close()
+}
+
+func (c *sandboxConn) Close() error {
+	c.stdin.Close()
+	c.stdout.Close()
+	select {
+	case <-c.exited:
+		return nil
+	default:
+		return c.kill()
+	}
+}
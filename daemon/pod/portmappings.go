@@ -49,6 +49,22 @@ func (p *XPod) initPortMapping() error {
 	return nil
 }
 
+// restorePortMapping re-allocates the host ports of the running pod after
+// the restart of hyperd, the rules are kept in iptables.
+func (p *XPod) restorePortMapping() error {
+	if p.containerIP != "" && len(p.portMappings) > 0 {
+		pms, err := translatePortMapping(p.portMappings)
+		if err != nil {
+			hlog.Log(ERROR, err)
+			return err
+		}
+		if err = portmapping.RestorePortMaps(p.containerIP, pms); err != nil {
+			return fmt.Errorf("failed to restore port mappings: %v", err)
+		}
+	}
+	return nil
+}
+
 func (p *XPod) flushPortMapping() error {
 	if p.containerIP != "" && len(p.portMappings) > 0 {
 		pms, err := translatePortMapping(p.portMappings)
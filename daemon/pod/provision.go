@@ -3,6 +3,7 @@ package pod
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -25,13 +26,13 @@ func CreateXPod(factory *PodFactory, spec *apitypes.UserPod) (*XPod, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = p.reserveNames(spec.Containers)
+	err = p.reserveNames(spec.AllContainers())
 	if err != nil {
 		return nil, err
 	}
 	defer func() {
 		if err != nil {
-			p.releaseNames(spec.Containers)
+			p.releaseNames(spec.AllContainers())
 		}
 	}()
 	err = p.createSandbox(spec) //TODO: add defer for rollback
@@ -63,7 +64,7 @@ func CreateXPod(factory *PodFactory, spec *apitypes.UserPod) (*XPod, error) {
 	p.initPodInfo()
 
 	// reserve again in case container is created
-	err = p.reserveNames(spec.Containers)
+	err = p.reserveNames(spec.AllContainers())
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +85,7 @@ func newXPod(factory *PodFactory, spec *apitypes.UserPod) (*XPod, error) {
 		hlog.Log(ERROR, err)
 		return nil, err
 	}
-	factory.hosts = HostsCreator(spec.Id)
+	factory.hosts = HostsCreator(spec.Id, spec.HostAliases)
 	factory.logCreator = initLogCreator(factory, spec)
 	p := &XPod{
 		name:          spec.Id,
@@ -209,7 +210,13 @@ func (p *XPod) ContainerStart(cid string) error {
 		return err
 	}
 
-	if err = c.start(); err != nil {
+	cList := make(map[string]bool)
+	p.withDependencies(cid, cList)
+	ids := make([]string, 0, len(cList))
+	for id := range cList {
+		ids = append(ids, id)
+	}
+	if err = p.startContainers(ids); err != nil {
 		return err
 	}
 
@@ -220,6 +227,14 @@ func (p *XPod) ContainerStart(cid string) error {
 // Start() means start a STOPPED pod.
 func (p *XPod) Start() error {
 
+	// a restarted job runs to completion again
+	p.statusLock.Lock()
+	p.exitCodes = nil
+	p.finishedAt = time.Time{}
+	p.exitCodeRead = false
+	p.stopReason, p.stopMessage = "", ""
+	p.statusLock.Unlock()
+
 	if p.IsStopped() {
 		if err := p.createSandbox(p.globalSpec); err != nil {
 			p.Log(ERROR, "failed to create sandbox for the stopped pod: %v", err)
@@ -248,8 +263,12 @@ func (p *XPod) Start() error {
 }
 
 func (p *XPod) createSandbox(spec *apitypes.UserPod) error {
-	//in the future, here
-	sandbox, err := startSandbox(p.factory.vmFactory, int(spec.Resource.Vcpu), int(spec.Resource.Memory), "", "")
+	f, err := p.factory.vmFactory.VmFactory(spec.GetRuntime().GetProfile())
+	if err != nil {
+		p.Log(ERROR, err)
+		return err
+	}
+	sandbox, err := startSandbox(f, int(spec.Resource.Vcpu), int(spec.Resource.Memory), "", "")
 	if err != nil {
 		p.Log(ERROR, err)
 		return err
@@ -364,13 +383,16 @@ func (p *XPod) releaseNames(containers []*apitypes.UserContainer) {
 // This function will do resource op and update the spec. and won't
 // access sandbox.
 func (p *XPod) initResources(spec *apitypes.UserPod, allowCreate bool) error {
-	for _, cspec := range spec.Containers {
+	for idx, cspec := range spec.AllContainers() {
 		c, err := newContainer(p, cspec, allowCreate)
 		if err != nil {
 			return err
 		}
 		p.statusLock.Lock()
 		p.containers[c.Id()] = c
+		if idx < len(spec.InitContainers) {
+			p.initContainers = append(p.initContainers, c.Id())
+		}
 		p.statusLock.Unlock()
 
 		vols := c.volumes()
@@ -451,6 +473,7 @@ func (p *XPod) prepareResources() error {
 			p.containerIP = fields[0]
 		}
 	}
+	p.configCniDNS()
 
 	err = p.initPortMapping()
 	if err != nil {
@@ -463,6 +486,32 @@ func (p *XPod) prepareResources() error {
 	return nil
 }
 
+// configCniDNS sets the resolv.conf of the containers to the DNS returned
+// by the CNI plugins instead of the one of the host, unless the pod has
+// its own DNS.
+func (p *XPod) configCniDNS() {
+	if len(p.globalSpec.Dns) > 0 {
+		return
+	}
+	ids := make([]string, 0, len(p.interfaces))
+	for id := range p.interfaces {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		resolvConf := p.interfaces[id].resolvConf
+		if resolvConf == "" {
+			continue
+		}
+		for _, c := range p.containers {
+			if err := c.injectResolvConf(resolvConf); err != nil {
+				c.Log(WARNING, "failed to set the DNS returned by CNI: %v", err)
+			}
+		}
+		return
+	}
+}
+
 // addResourcesToSandbox() add resources to sandbox in parallel, it issues
 // runV API parallelly to send the NIC, Vols, and Containers to sandbox
 func (p *XPod) addResourcesToSandbox() error {
@@ -486,8 +535,9 @@ func (p *XPod) addResourcesToSandbox() error {
 		err := p.sandbox.AddRoute()
 		if err != nil {
 			p.Log(ERROR, "fail to add Route: %v", err)
+			return err
 		}
-		return err
+		return p.syncNetworkPolicy()
 	})
 
 	for iv, vol := range p.volumes {
@@ -506,12 +556,12 @@ func (p *XPod) addResourcesToSandbox() error {
 		p.Log(ERROR, "error during add resources to sandbox: %v", err)
 		return err
 	}
+	p.services.checkHealth()
 	return nil
 }
 
 func (p *XPod) startAll() error {
 	p.Log(INFO, "start all containers")
-	future := utils.NewFutureSet()
 
 	for _, pre := range p.prestartExecs {
 		p.Log(DEBUG, "run prestart exec %v", pre)
@@ -522,11 +572,19 @@ func (p *XPod) startAll() error {
 		}
 	}
 
-	for ic, c := range p.containers {
-		future.Add(ic, c.start)
+	if err := p.runInitContainers(); err != nil {
+		p.Log(ERROR, "failed to run init containers: %v", err)
+		return err
 	}
 
-	if err := future.Wait(ProvisionTimeout); err != nil {
+	cList := make([]string, 0, len(p.containers))
+	for ic := range p.containers {
+		if !p.isInitContainer(ic) {
+			cList = append(cList, ic)
+		}
+	}
+
+	if err := p.startContainers(cList); err != nil {
 		p.Log(ERROR, "error during start all containers: %v", err)
 		return err
 	}
@@ -0,0 +1,165 @@
+package pod
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// The cgroup resources of the containers are applied by hyperd in the
+// sandbox, as the hyperstart protocol carries no cgroup settings. Each
+// container with resources gets the cgroups hyperd/<container id> in the
+// guest, which are created with the limits before the container is
+// started, and its processes, which are marked with resourcesEnv, are moved
+// into them right after. The container fails to start if either step fails.
+const (
+	resourcesEnv    = "HYPERD_CONTAINER_ID"
+	guestCgroupRoot = "/sys/fs/cgroup"
+	guestCgroupDir  = "hyperd"
+)
+
+// OOM states of the exited containers
+const (
+	oomNone    = ""
+	oomKilled  = "killed"
+	oomUnknown = "unknown"
+)
+
+// cgroupSettings returns the cgroup files and their values of the
+// resources, keyed by the subsystems.
+func cgroupSettings(r *apitypes.UserContainerResources) map[string][][2]string {
+	settings := map[string][][2]string{}
+	if r.CpuShares > 0 {
+		settings["cpu"] = append(settings["cpu"], [2]string{"cpu.shares", strconv.FormatInt(r.CpuShares, 10)})
+	}
+	if r.CpuQuota > 0 {
+		// the period should be set before the quota
+		if r.CpuPeriod > 0 {
+			settings["cpu"] = append(settings["cpu"], [2]string{"cpu.cfs_period_us", strconv.FormatInt(r.CpuPeriod, 10)})
+		}
+		settings["cpu"] = append(settings["cpu"], [2]string{"cpu.cfs_quota_us", strconv.FormatInt(r.CpuQuota, 10)})
+	}
+	if r.Memory > 0 {
+		settings["memory"] = append(settings["memory"], [2]string{"memory.limit_in_bytes", strconv.FormatInt(int64(r.Memory)<<20, 10)})
+	}
+	if r.PidsLimit > 0 {
+		settings["pids"] = append(settings["pids"], [2]string{"pids.max", strconv.FormatInt(r.PidsLimit, 10)})
+	}
+	return settings
+}
+
+func cgroupSubsystems(settings map[string][][2]string) []string {
+	subsystems := []string{}
+	for _, ss := range []string{"cpu", "memory", "pids"} {
+		if len(settings[ss]) > 0 {
+			subsystems = append(subsystems, ss)
+		}
+	}
+	return subsystems
+}
+
+// prepareResourcesScript returns the shell script run in the sandbox to
+// create the cgroups of the container with the limits.
+func prepareResourcesScript(id string, settings map[string][][2]string) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "set -e\n")
+	fmt.Fprintf(&b, "mountpoint -q %s || mount -t tmpfs cgroup %s\n", guestCgroupRoot, guestCgroupRoot)
+	for _, ss := range cgroupSubsystems(settings) {
+		root := guestCgroupRoot + "/" + ss
+		dir := fmt.Sprintf("%s/%s/%s", root, guestCgroupDir, id)
+		fmt.Fprintf(&b, "mountpoint -q %s || { mkdir -p %s; mount -t cgroup -o %s cgroup %s; }\n", root, root, ss, root)
+		// a cgroup left by the last run of the container is recreated,
+		// which resets its counters
+		fmt.Fprintf(&b, "rmdir %s 2>/dev/null || true\n", dir)
+		fmt.Fprintf(&b, "mkdir -p %s\n", dir)
+		for _, kv := range settings[ss] {
+			fmt.Fprintf(&b, "echo %s > %s/%s\n", kv[1], dir, kv[0])
+		}
+	}
+	return b.String()
+}
+
+// attachResourcesScript returns the shell script run in the sandbox to
+// move the processes of the container into its cgroups, which fails if
+// no process is moved.
+func attachResourcesScript(id string, settings map[string][][2]string) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "moved=\n")
+	fmt.Fprintf(&b, "for e in /proc/[0-9]*/environ; do\n")
+	fmt.Fprintf(&b, "  tr '\\0' '\\n' 2>/dev/null < $e | grep -qx '%s=%s' || continue\n", resourcesEnv, id)
+	fmt.Fprintf(&b, "  pid=${e#/proc/}; pid=${pid%%/environ}\n")
+	// the process exited meanwhile is skipped
+	fmt.Fprintf(&b, "  for ss in %s; do echo $pid > %s/$ss/%s/%s/cgroup.procs || { [ ! -d /proc/$pid ] || exit 1; continue 2; }; done\n",
+		strings.Join(cgroupSubsystems(settings), " "), guestCgroupRoot, guestCgroupDir, id)
+	fmt.Fprintf(&b, "  moved=1\n")
+	fmt.Fprintf(&b, "done\n")
+	fmt.Fprintf(&b, "[ -n \"$moved\" ] || { echo 'no process of the container found' >&2; exit 1; }\n")
+	return b.String()
+}
+
+// prepareResources creates the cgroups of the container before it is
+// started, and attachResources moves the started container into them.
+func (c *Container) prepareResources() error {
+	return c.execResourcesScript(prepareResourcesScript)
+}
+
+func (c *Container) attachResources() error {
+	return c.execResourcesScript(attachResourcesScript)
+}
+
+func (c *Container) execResourcesScript(script func(string, map[string][][2]string) string) error {
+	if c.spec.Resources == nil {
+		return nil
+	}
+	settings := cgroupSettings(c.spec.Resources)
+	if len(settings) == 0 {
+		return nil
+	}
+	_, stderr, err := c.p.sandbox.HyperstartExecSync([]string{"sh", "-c", script(c.Id(), settings)}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to apply the resources in sandbox: %v: %s", err, strings.TrimSpace(string(stderr)))
+	}
+	c.Log(DEBUG, "resources applied in sandbox: %v", settings)
+	return nil
+}
+
+// checkOOM tells whether the container, which exited with code, was killed
+// by the OOM killer of the guest, according to the oom_kill counter of its
+// memory cgroup. The state is unknown if the counter could not be read.
+func (c *Container) checkOOM(code int) string {
+	r := c.spec.Resources
+	if r == nil || r.Memory <= 0 || code != 128+int(syscall.SIGKILL) || c.p.sandbox == nil {
+		return oomNone
+	}
+	file := fmt.Sprintf("%s/memory/%s/%s/memory.oom_control", guestCgroupRoot, guestCgroupDir, c.Id())
+	stdout, _, err := c.p.sandbox.HyperstartExecSync([]string{"cat", file}, nil)
+	if err != nil {
+		c.Log(WARNING, "failed to read the OOM state of the container: %v", err)
+		return oomUnknown
+	}
+	return parseOOMControl(stdout)
+}
+
+// parseOOMControl returns the OOM state from memory.oom_control, the
+// oom_kill counter is only reported by the kernels since 4.13.
+func parseOOMControl(data []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != "oom_kill" {
+			continue
+		}
+		if n, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			if n > 0 {
+				return oomKilled
+			}
+			return oomNone
+		}
+	}
+	return oomUnknown
+}
@@ -0,0 +1,50 @@
+package pod
+
+import (
+	"strings"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestResourcesScript(t *testing.T) {
+	settings := cgroupSettings(&apitypes.UserContainerResources{CpuQuota: 50000, CpuPeriod: 100000, Memory: 32})
+	prepare := prepareResourcesScript("c1", settings)
+	for _, expected := range []string{
+		"echo 100000 > /sys/fs/cgroup/cpu/hyperd/c1/cpu.cfs_period_us\necho 50000 > /sys/fs/cgroup/cpu/hyperd/c1/cpu.cfs_quota_us\n",
+		"echo 33554432 > /sys/fs/cgroup/memory/hyperd/c1/memory.limit_in_bytes\n",
+	} {
+		if !strings.Contains(prepare, expected) {
+			t.Fatalf("%q is not in the script:\n%s", expected, prepare)
+		}
+	}
+	if strings.Contains(prepare, "/pids") {
+		t.Fatalf("pids cgroup is created without limit:\n%s", prepare)
+	}
+
+	attach := attachResourcesScript("c1", settings)
+	for _, expected := range []string{
+		"grep -qx 'HYPERD_CONTAINER_ID=c1'",
+		"pid=${pid%/environ}",
+		"for ss in cpu memory; do echo $pid > /sys/fs/cgroup/$ss/hyperd/c1/cgroup.procs",
+		// the start fails if the container is not moved into the cgroups
+		"exit 1",
+	} {
+		if !strings.Contains(attach, expected) {
+			t.Fatalf("%q is not in the script:\n%s", expected, attach)
+		}
+	}
+}
+
+func TestParseOOMControl(t *testing.T) {
+	for data, expected := range map[string]string{
+		"oom_kill_disable 0\nunder_oom 0\noom_kill 1\n": oomKilled,
+		"oom_kill_disable 0\nunder_oom 0\noom_kill 0\n": oomNone,
+		// the kernels before 4.13 have no oom_kill counter
+		"oom_kill_disable 0\nunder_oom 0\n": oomUnknown,
+	} {
+		if oom := parseOOMControl([]byte(data)); oom != expected {
+			t.Fatalf("incorrect OOM state %q of %q", oom, data)
+		}
+	}
+}
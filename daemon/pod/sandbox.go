@@ -1,7 +1,10 @@
 package pod
 
 import (
+	"time"
+
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/lib/metrics"
 	"github.com/hyperhq/runv/factory"
 	"github.com/hyperhq/runv/hypervisor"
 )
@@ -10,6 +13,14 @@ const (
 	maxReleaseRetry = 3
 )
 
+var sandboxBootDuration = metrics.NewHistogramVec("hyperd_sandbox_boot_duration_seconds",
+	"Time to get a running sandbox, from the VM factory or booted with the custom kernel.",
+	[]float64{.1, .25, .5, 1, 2, 5, 10, 30}, "source")
+
+func init() {
+	metrics.MustRegister(sandboxBootDuration)
+}
+
 func startSandbox(f factory.Factory, cpu, mem int, kernel, initrd string) (vm *hypervisor.Vm, err error) {
 	var (
 		DEFAULT_CPU = 1
@@ -23,6 +34,8 @@ func startSandbox(f factory.Factory, cpu, mem int, kernel, initrd string) (vm *h
 		mem = DEFAULT_MEM
 	}
 
+	start := time.Now()
+	source := "factory"
 	if kernel == "" {
 		hlog.Log(DEBUG, "get sandbox from factory: CPU: %d, Memory %d", cpu, mem)
 		vm, err = f.GetVm(cpu, mem)
@@ -34,11 +47,14 @@ func startSandbox(f factory.Factory, cpu, mem int, kernel, initrd string) (vm *h
 			Kernel: kernel,
 			Initrd: initrd,
 		}
+		source = "custom"
 		vm, err = hypervisor.GetVm("", config, false)
 	}
 	if err != nil {
 		hlog.Log(ERROR, "failed to create a sandbox (cpu=%d, mem=%d kernel=%s initrd=%d): %v", cpu, mem, kernel, initrd, err)
+		return vm, err
 	}
+	sandboxBootDuration.Observe(time.Since(start).Seconds(), source)
 
 	return vm, err
 }
@@ -3,7 +3,9 @@ package pod
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	apitypes "github.com/hyperhq/hyperd/types"
 )
@@ -21,6 +23,16 @@ type Services struct {
 	p *XPod
 
 	spec []*apitypes.UserService
+
+	// health is the states of the backends of the health checked
+	// services, and checked is when the services were probed last time
+	health  map[backendKey]*backendHealth
+	checked map[serviceKey]time.Time
+	// stopCheck stops the health checking, nil if it is not running
+	stopCheck chan struct{}
+	// probeFailed is set once a probe could not run, which is only
+	// logged for the first time
+	probeFailed bool
 }
 
 func newServices(p *XPod, spec []*apitypes.UserService) *Services {
@@ -44,35 +56,73 @@ type serviceKey struct {
 	Protocol string
 }
 
-func generateIPVSCmd(service *apitypes.UserService, op string) ([]byte, error) {
-	if service == nil {
-		return nil, nil
-	}
-
-	var (
-		cmd       string
-		protoFlag string
-	)
-	cmds := []byte{}
-	DEFAULT_POSTFIX := fmt.Sprintf("-%s -w %d", DEFAULT_MODE, DEFAULT_WEITHT)
+var forwardFlags = map[string]string{
+	"masquerading": "m",
+	"gatewaying":   "g",
+	"tunneling":    "i",
+}
 
+// serviceConf returns the protocol flag and the address of the service in
+// the ipvsadm commands.
+func serviceConf(service *apitypes.UserService) (string, error) {
+	var protoFlag string
 	if strings.ToLower(service.Protocol) == "tcp" {
 		protoFlag = "-t"
 	} else if strings.ToLower(service.Protocol) == "udp" {
 		protoFlag = "-u"
 	} else {
-		return nil, fmt.Errorf("unsupported service protocol type: %s", service.Protocol)
+		return "", fmt.Errorf("unsupported service protocol type: %s", service.Protocol)
+	}
+	return fmt.Sprintf("%s %s:%d", protoFlag, service.ServiceIP, service.ServicePort), nil
+}
+
+// backendConf returns the address, the forward mode and the weight of the
+// backend in the ipvsadm commands.
+func backendConf(service *apitypes.UserService, b *apitypes.UserServiceBackend, weight int32) string {
+	mode := DEFAULT_MODE
+	if flag, ok := forwardFlags[service.ForwardMode]; ok {
+		mode = flag
+	}
+	return fmt.Sprintf("-r %s:%d -%s -w %d", b.HostIP, b.HostPort, mode, weight)
+}
+
+func backendWeight(b *apitypes.UserServiceBackend) int32 {
+	if b.Weight > 0 || b.WeightSet {
+		return b.Weight
+	}
+	return int32(DEFAULT_WEITHT)
+}
+
+// generateIPVSCmd returns the ipvsadm commands to add or delete the
+// service, the backends are added with the weights returned by weight.
+func generateIPVSCmd(service *apitypes.UserService, op string, weight func(*apitypes.UserService, *apitypes.UserServiceBackend) int32) ([]byte, error) {
+	if service == nil {
+		return nil, nil
+	}
+
+	var cmd string
+	cmds := []byte{}
+
+	sConf, err := serviceConf(service)
+	if err != nil {
+		return nil, err
 	}
-	sConf := fmt.Sprintf("%s %s:%d", protoFlag, service.ServiceIP, service.ServicePort)
 	switch op {
 	case "add":
 		if service.ServiceIP == "" || service.ServicePort == 0 {
 			return nil, fmt.Errorf("invlide service format, missing service IP or Port")
 		}
-		cmd = fmt.Sprintf("-A %s -s %s\n", sConf, DEFAULT_SCHEDULER)
-		cmds = append(cmds, cmd...)
+		scheduler := DEFAULT_SCHEDULER
+		if service.Scheduler != "" {
+			scheduler = service.Scheduler
+		}
+		cmd = fmt.Sprintf("-A %s -s %s", sConf, scheduler)
+		if service.PersistenceTimeout > 0 {
+			cmd += fmt.Sprintf(" -p %d", service.PersistenceTimeout)
+		}
+		cmds = append(cmds, cmd+"\n"...)
 		for _, b := range service.Hosts {
-			cmd = fmt.Sprintf("-a %s -r %s:%d %s\n", sConf, b.HostIP, b.HostPort, DEFAULT_POSTFIX)
+			cmd = fmt.Sprintf("-a %s %s\n", sConf, backendConf(service, b, weight(service, b)))
 			cmds = append(cmds, cmd...)
 		}
 	case "del":
@@ -93,6 +143,10 @@ func (s *Services) add(newServs []*apitypes.UserService) error {
 		exist[serviceKey{srv.ServiceIP, srv.ServicePort, srv.Protocol}] = true
 	}
 	for _, srv := range newServs {
+		if err = srv.Validate(); err != nil {
+			s.Log(ERROR, err)
+			return err
+		}
 		if exist[serviceKey{srv.ServiceIP, srv.ServicePort, srv.Protocol}] {
 			err = fmt.Errorf("service %v conflicts with existing ones", newServs)
 			s.Log(ERROR, err)
@@ -108,6 +162,7 @@ func (s *Services) add(newServs []*apitypes.UserService) error {
 		}
 	}
 	s.spec = append(s.spec, newServs...)
+	s.checkHealth()
 
 	return nil
 }
@@ -135,6 +190,7 @@ func (s *Services) del(srvs []*apitypes.UserService) error {
 		}
 	}
 	s.spec = remain
+	s.checkHealth()
 
 	return nil
 }
@@ -146,6 +202,10 @@ func (s *Services) update(srvs []*apitypes.UserService) error {
 	// check if update service list conflicts
 	tbd := make(map[serviceKey]bool, len(srvs))
 	for _, srv := range srvs {
+		if err = srv.Validate(); err != nil {
+			s.Log(ERROR, err)
+			return err
+		}
 		key := serviceKey{srv.ServiceIP, srv.ServicePort, srv.Protocol}
 		if tbd[key] {
 			err = fmt.Errorf("given service list conflict: %v", srv)
@@ -161,10 +221,28 @@ func (s *Services) update(srvs []*apitypes.UserService) error {
 		}
 	}
 	s.spec = srvs
+	s.checkHealth()
 
 	return nil
 }
 
+// merge updates the services with the same addresses as srvs, and adds the
+// others, the rest of the existing services are kept.
+func (s *Services) merge(srvs []*apitypes.UserService) error {
+	replaced := make(map[serviceKey]bool, len(srvs))
+	for _, srv := range srvs {
+		replaced[serviceKey{srv.ServiceIP, srv.ServicePort, srv.Protocol}] = true
+	}
+	merged := make([]*apitypes.UserService, 0, len(srvs)+s.size())
+	merged = append(merged, srvs...)
+	for _, srv := range s.spec {
+		if !replaced[serviceKey{srv.ServiceIP, srv.ServicePort, srv.Protocol}] {
+			merged = append(merged, srv)
+		}
+	}
+	return s.update(merged)
+}
+
 func (s *Services) apply() error {
 	return s.commit(s.spec, "add")
 }
@@ -181,7 +259,7 @@ func (s *Services) commit(srvs []*apitypes.UserService, operation string) error
 	}
 	// generate patch
 	for _, srv := range srvs {
-		cmd, err := generateIPVSCmd(srv, operation)
+		cmd, err := generateIPVSCmd(srv, operation, s.weight)
 		if err != nil {
 			s.Log(ERROR, "faild to generate IPVS command: %v", err)
 			return err
@@ -262,8 +340,18 @@ func (s *Services) size() int {
 	return len(s.spec)
 }
 
+// get returns the services, with the states of the backends if the
+// services are health checked.
 func (s *Services) get() []*apitypes.UserService {
-	return s.spec
+	srvs := make([]*apitypes.UserService, 0, s.size())
+	for _, srv := range s.spec {
+		c := proto.Clone(srv).(*apitypes.UserService)
+		for _, b := range c.Hosts {
+			b.State = s.state(srv, b)
+		}
+		srvs = append(srvs, c)
+	}
+	return srvs
 }
 
 func (p *XPod) GetServices() ([]*apitypes.UserService, error) {
@@ -285,6 +373,20 @@ func (p *XPod) UpdateService(srvs []*apitypes.UserService) error {
 	return p.savePodMeta()
 }
 
+// MergeService updates the services with the same addresses, and adds the
+// others, the rest of the services of the pod are kept.
+func (p *XPod) MergeService(srvs []*apitypes.UserService) error {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	if err := p.services.merge(srvs); err != nil {
+		p.Log(ERROR, "failed to merge services: %v", err)
+		return err
+	}
+
+	return p.savePodMeta()
+}
+
 func (p *XPod) AddService(srvs []*apitypes.UserService) error {
 	p.resourceLock.Lock()
 	defer p.resourceLock.Unlock()
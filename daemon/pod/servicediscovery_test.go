@@ -0,0 +1,69 @@
+package pod
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestBackendWeight(t *testing.T) {
+	srv := &apitypes.UserService{
+		ServiceIP:   "10.254.0.1",
+		ServicePort: 80,
+		Protocol:    "tcp",
+		Hosts: []*apitypes.UserServiceBackend{
+			{HostIP: "192.168.1.2", HostPort: 80},
+			{HostIP: "192.168.1.3", HostPort: 80, Weight: 9},
+			{HostIP: "192.168.1.4", HostPort: 80, WeightSet: true},
+		},
+	}
+	cmds, err := generateIPVSCmd(srv, "add", func(_ *apitypes.UserService, b *apitypes.UserServiceBackend) int32 {
+		return backendWeight(b)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"-r 192.168.1.2:80 -m -w 1\n",
+		"-r 192.168.1.3:80 -m -w 9\n",
+		// the explicit weight 0 drains the backend
+		"-r 192.168.1.4:80 -m -w 0\n",
+	} {
+		if !strings.Contains(string(cmds), expected) {
+			t.Fatalf("%q is not in the ipvs commands:\n%s", expected, cmds)
+		}
+	}
+}
+
+func TestServicesMerge(t *testing.T) {
+	p := &XPod{statusLock: &sync.RWMutex{}}
+	dns := &apitypes.UserService{ServiceIP: "10.254.0.10", ServicePort: 53, Protocol: "udp"}
+	web := &apitypes.UserService{ServiceIP: "10.254.0.1", ServicePort: 80, Protocol: "tcp"}
+	s := newServices(p, []*apitypes.UserService{dns, web})
+
+	newWeb := &apitypes.UserService{
+		ServiceIP:   "10.254.0.1",
+		ServicePort: 80,
+		Protocol:    "tcp",
+		Hosts:       []*apitypes.UserServiceBackend{{HostIP: "192.168.1.2", HostPort: 80}},
+	}
+	// the same address with another protocol is another service
+	webUdp := &apitypes.UserService{ServiceIP: "10.254.0.1", ServicePort: 80, Protocol: "udp"}
+	if err := s.merge([]*apitypes.UserService{newWeb, webUdp}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []*apitypes.UserService{newWeb, webUdp, dns}
+	if !reflect.DeepEqual(s.spec, expected) {
+		t.Fatalf("expected services %v, got %v", expected, s.spec)
+	}
+
+	if err := s.merge([]*apitypes.UserService{newWeb, newWeb}); err == nil {
+		t.Fatal("expected the conflicting services to be refused")
+	}
+	if !reflect.DeepEqual(s.spec, expected) {
+		t.Fatalf("the services are changed by the refused merge: %v", s.spec)
+	}
+}
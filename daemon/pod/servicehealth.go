@@ -0,0 +1,334 @@
+package pod
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"sync"
+	"time"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+const (
+	BACKEND_HEALTHY   = "healthy"
+	BACKEND_UNHEALTHY = "unhealthy"
+	BACKEND_UNKNOWN   = "unknown"
+
+	DEFAULT_CHECK_INTERVAL      = 10
+	DEFAULT_CHECK_TIMEOUT       = 1
+	DEFAULT_UNHEALTHY_THRESHOLD = 3
+	DEFAULT_HEALTHY_THRESHOLD   = 1
+
+	// how often the services are looked up for the due probes
+	healthCheckTick = time.Second
+)
+
+// tcpProbeCmd is the command run in the sandbox to probe the backend, which
+// exits with 0 if the port accepts the connection in the timeout, and with
+// tcpProbeFailure if not. The other exit codes are failures of the probe
+// itself, such as the command not found.
+var tcpProbeCmd = func(ip string, port, timeout int32) []string {
+	return []string{"nc", "-z", "-w", strconv.Itoa(int(timeout)), ip, strconv.Itoa(int(port))}
+}
+
+const tcpProbeFailure = 1
+
+// probeBackend runs the tcp probe of the backend in the sandbox, the error
+// is returned if the probe could not run, e.g. nc is not in the sandbox.
+func (s *Services) probeBackend(sb *hypervisor.Vm, pb *backendProbe) (bool, error) {
+	cmd, err := json.Marshal(tcpProbeCmd(pb.ip, pb.port, pb.timeout))
+	if err != nil {
+		return false, err
+	}
+	code, err := sb.HyperstartExec(string(cmd), &hypervisor.TtyIO{
+		Stdin:  ioutil.NopCloser(bytes.NewReader(nil)),
+		Stdout: ioutil.Discard,
+		Stderr: ioutil.Discard,
+	})
+	switch {
+	case err != nil:
+		return false, err
+	case code != 0 && code != tcpProbeFailure:
+		return false, fmt.Errorf("probe exited with %d", code)
+	}
+	return code == 0, nil
+}
+
+type backendKey struct {
+	serviceKey
+	Backend string
+}
+
+func newBackendKey(srv *apitypes.UserService, b *apitypes.UserServiceBackend) backendKey {
+	return backendKey{
+		serviceKey: serviceKey{srv.ServiceIP, srv.ServicePort, srv.Protocol},
+		Backend:    fmt.Sprintf("%s:%d", b.HostIP, b.HostPort),
+	}
+}
+
+type backendHealth struct {
+	probed    bool
+	healthy   bool
+	successes int32
+	failures  int32
+}
+
+type backendProbe struct {
+	key     backendKey
+	ip      string
+	port    int32
+	timeout int32
+	ok      bool
+	err     error
+}
+
+func checkParams(hc *apitypes.ServiceHealthCheck) (interval, timeout, unhealthy, healthy int32) {
+	interval, timeout = DEFAULT_CHECK_INTERVAL, DEFAULT_CHECK_TIMEOUT
+	unhealthy, healthy = DEFAULT_UNHEALTHY_THRESHOLD, DEFAULT_HEALTHY_THRESHOLD
+	if hc.Interval > 0 {
+		interval = hc.Interval
+	}
+	if hc.Timeout > 0 {
+		timeout = hc.Timeout
+	}
+	if hc.UnhealthyThreshold > 0 {
+		unhealthy = hc.UnhealthyThreshold
+	}
+	if hc.HealthyThreshold > 0 {
+		healthy = hc.HealthyThreshold
+	}
+	return
+}
+
+// weight returns the weight of the backend in IPVS, which is 0 if the
+// backend is unhealthy.
+func (s *Services) weight(srv *apitypes.UserService, b *apitypes.UserServiceBackend) int32 {
+	if srv.HealthCheck != nil {
+		if h, ok := s.health[newBackendKey(srv, b)]; ok && !h.healthy {
+			return 0
+		}
+	}
+	return backendWeight(b)
+}
+
+// state returns the health state of the backend, which is empty if the
+// service is not health checked.
+func (s *Services) state(srv *apitypes.UserService, b *apitypes.UserServiceBackend) string {
+	if srv.HealthCheck == nil {
+		return ""
+	}
+	h, ok := s.health[newBackendKey(srv, b)]
+	if !ok || !h.probed {
+		return BACKEND_UNKNOWN
+	}
+	if h.healthy {
+		return BACKEND_HEALTHY
+	}
+	return BACKEND_UNHEALTHY
+}
+
+func (s *Services) healthChecked() bool {
+	for _, srv := range s.spec {
+		if srv.HealthCheck != nil && len(srv.Hosts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// checkHealth drops the states of the backends no longer health checked,
+// and starts the health checking if any service is health checked in the
+// alive pod. The resourceLock should be held.
+func (s *Services) checkHealth() {
+	checked := make(map[backendKey]bool)
+	for _, srv := range s.spec {
+		if srv.HealthCheck == nil {
+			continue
+		}
+		for _, b := range srv.Hosts {
+			checked[newBackendKey(srv, b)] = true
+		}
+	}
+	for key := range s.health {
+		if !checked[key] {
+			delete(s.health, key)
+		}
+	}
+
+	if s.stopCheck != nil || len(checked) == 0 || !s.p.IsAlive() || s.p.sandbox == nil {
+		return
+	}
+	if s.health == nil {
+		s.health = make(map[backendKey]*backendHealth)
+	}
+	if s.checked == nil {
+		s.checked = make(map[serviceKey]time.Time)
+	}
+	stop := make(chan struct{})
+	s.stopCheck = stop
+	go s.healthLoop(stop)
+	s.Log(DEBUG, "health checking started")
+}
+
+// stopHealthCheck stops the health checking, and forgets the states of the
+// backends. The resourceLock should be held.
+func (s *Services) stopHealthCheck() {
+	if s.stopCheck != nil {
+		close(s.stopCheck)
+		s.stopCheck = nil
+		s.Log(DEBUG, "health checking stopped")
+	}
+	s.health = nil
+	s.checked = nil
+}
+
+func (s *Services) healthLoop(stop chan struct{}) {
+	ticker := time.NewTicker(healthCheckTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		sb, probes, done := s.dueProbes(stop)
+		if done {
+			return
+		}
+		if len(probes) == 0 {
+			continue
+		}
+
+		var wg sync.WaitGroup
+		for _, pb := range probes {
+			wg.Add(1)
+			go func(pb *backendProbe) {
+				defer wg.Done()
+				pb.ok, pb.err = s.probeBackend(sb, pb)
+			}(pb)
+		}
+		wg.Wait()
+
+		s.applyProbes(stop, probes)
+	}
+}
+
+// dueProbes returns the sandbox and the backends of the services due to be
+// probed, done is true if the health checking is stopped, or no service is
+// health checked any more.
+func (s *Services) dueProbes(stop chan struct{}) (sb *hypervisor.Vm, probes []*backendProbe, done bool) {
+	s.p.resourceLock.Lock()
+	defer s.p.resourceLock.Unlock()
+
+	if s.stopCheck != stop {
+		return nil, nil, true
+	}
+	if !s.healthChecked() {
+		s.stopCheck = nil
+		s.Log(DEBUG, "no service is health checked, health checking stopped")
+		return nil, nil, true
+	}
+	if !s.p.IsRunning() || s.p.sandbox == nil {
+		return nil, nil, false
+	}
+
+	now := time.Now()
+	for _, srv := range s.spec {
+		if srv.HealthCheck == nil {
+			continue
+		}
+		interval, timeout, _, _ := checkParams(srv.HealthCheck)
+		key := serviceKey{srv.ServiceIP, srv.ServicePort, srv.Protocol}
+		if now.Sub(s.checked[key]) < time.Duration(interval)*time.Second {
+			continue
+		}
+		s.checked[key] = now
+		for _, b := range srv.Hosts {
+			bk := newBackendKey(srv, b)
+			if _, ok := s.health[bk]; !ok {
+				s.health[bk] = &backendHealth{healthy: true}
+			}
+			probes = append(probes, &backendProbe{
+				key:     bk,
+				ip:      b.HostIP,
+				port:    b.HostPort,
+				timeout: timeout,
+			})
+		}
+	}
+	return s.p.sandbox, probes, false
+}
+
+// applyProbes counts the results of the probes, and updates the weights of
+// the backends whose states changed. The backends whose probes could not
+// run are in unknown state, and keep their weights, rather than draining
+// all the backends because of the sandbox.
+func (s *Services) applyProbes(stop chan struct{}, probes []*backendProbe) {
+	s.p.resourceLock.Lock()
+	defer s.p.resourceLock.Unlock()
+
+	if s.stopCheck != stop || !s.p.IsRunning() {
+		return
+	}
+
+	results := make(map[backendKey]*backendProbe, len(probes))
+	for _, pb := range probes {
+		results[pb.key] = pb
+	}
+
+	patch := []byte{}
+	for _, srv := range s.spec {
+		if srv.HealthCheck == nil {
+			continue
+		}
+		_, _, unhealthy, healthy := checkParams(srv.HealthCheck)
+		for _, b := range srv.Hosts {
+			bk := newBackendKey(srv, b)
+			pb, probed := results[bk]
+			h, exist := s.health[bk]
+			if !probed || !exist {
+				continue
+			}
+			if pb.err != nil {
+				h.probed = false
+				if !s.probeFailed {
+					s.probeFailed = true
+					s.Log(WARNING, "failed to probe backend %s, the backends keep their weights in unknown state: %v", bk.Backend, pb.err)
+				}
+				continue
+			}
+			h.probed = true
+			if pb.ok {
+				h.successes, h.failures = h.successes+1, 0
+			} else {
+				h.successes, h.failures = 0, h.failures+1
+			}
+			switch {
+			case h.healthy && h.failures >= unhealthy:
+				h.healthy = false
+				s.Log(INFO, "backend %s of service %s:%d is unhealthy, weighted to 0", bk.Backend, srv.ServiceIP, srv.ServicePort)
+			case !h.healthy && h.successes >= healthy:
+				h.healthy = true
+				s.Log(INFO, "backend %s of service %s:%d is healthy again", bk.Backend, srv.ServiceIP, srv.ServicePort)
+			default:
+				continue
+			}
+			sConf, err := serviceConf(srv)
+			if err != nil {
+				continue
+			}
+			patch = append(patch, fmt.Sprintf("-e %s %s\n", sConf, backendConf(srv, b, s.weight(srv, b)))...)
+		}
+	}
+	if len(patch) == 0 {
+		return
+	}
+	if err := s.commitToVm(patch); err != nil {
+		s.Log(ERROR, "failed to update the weights of the backends: %v", err)
+	}
+}
@@ -0,0 +1,651 @@
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/hyperhq/hyperd/errors"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+// the kinds and actions of the changes reported by Update
+const (
+	UPDATE_KIND_POD         = "pod"
+	UPDATE_KIND_CONTAINER   = "container"
+	UPDATE_KIND_INTERFACE   = "interface"
+	UPDATE_KIND_LABEL       = "label"
+	UPDATE_KIND_SERVICE     = "service"
+	UPDATE_KIND_PORTMAPPING = "portmapping"
+	UPDATE_KIND_POLICY      = "networkpolicy"
+
+	UPDATE_ADD     = "add"
+	UPDATE_REMOVE  = "remove"
+	UPDATE_REPLACE = "replace"
+	UPDATE_UPDATE  = "update"
+)
+
+// podUpdate is the difference between the running pod and a new spec.
+type podUpdate struct {
+	changes []*apitypes.PodUpdateChange
+
+	global            bool
+	labels            bool
+	policy            bool
+	services          bool
+	removeContainers  []string
+	createContainers  []*apitypes.UserContainer
+	removeInterfaces  []string
+	addInterfaces     []*apitypes.UserInterface
+	bandwidth         map[string]*apitypes.InterfaceBandwidth
+	removePortMapping []*apitypes.PortMapping
+	addPortMapping    []*apitypes.PortMapping
+}
+
+func (u *podUpdate) add(kind, name, action string) {
+	u.changes = append(u.changes, &apitypes.PodUpdateChange{Kind: kind, Name: name, Action: action})
+}
+
+// Update applies the spec to the running pod without a new sandbox: the
+// containers are created, removed, or replaced if their spec changed, the
+// interfaces are hot-plugged, and the labels, network policy, services and
+// port mappings are updated. The changes of the sandbox, such as the resource, hostname,
+// dns, or the init containers, are refused. With dryRun, the changes are
+// only reported.
+func (p *XPod) Update(spec *apitypes.UserPod, dryRun bool) ([]*apitypes.PodUpdateChange, error) {
+	if !p.IsRunning() {
+		return nil, errors.ErrPodNotRunning.WithArgs(p.Id())
+	}
+	if err := spec.MergePortmappings(); err != nil {
+		p.Log(ERROR, "fail to merge the portmappings: %v", err)
+		return nil, err
+	}
+	if err := spec.ReorganizeContainers(true); err != nil {
+		p.Log(ERROR, err)
+		return nil, err
+	}
+
+	u, err := p.diff(spec)
+	if err != nil {
+		p.Log(ERROR, err)
+		return nil, err
+	}
+	if dryRun || len(u.changes) == 0 {
+		return u.changes, nil
+	}
+
+	p.Log(INFO, "updating the pod: %d changes", len(u.changes))
+	if err := p.applyUpdate(spec, u); err != nil {
+		p.Log(ERROR, "failed to update the pod: %v", err)
+		return nil, err
+	}
+	return u.changes, nil
+}
+
+// diff compares the spec with the pod, and returns the error explaining
+// why the pod could not be updated in place.
+func (p *XPod) diff(spec *apitypes.UserPod) (*podUpdate, error) {
+	u := &podUpdate{
+		bandwidth: make(map[string]*apitypes.InterfaceBandwidth),
+	}
+	refused := []string{}
+
+	p.resourceLock.Lock()
+	g := proto.Clone(p.globalSpec).(*apitypes.UserPod)
+	labels := p.labels
+	services := p.services.get()
+	p.resourceLock.Unlock()
+
+	if spec.Hostname != g.Hostname {
+		refused = append(refused, "hostname")
+	}
+	if spec.Type != g.Type {
+		refused = append(refused, "type")
+	}
+	if spec.Resource.GetVcpu() != g.Resource.GetVcpu() || spec.Resource.GetMemory() != g.Resource.GetMemory() {
+		refused = append(refused, "resource")
+	}
+	if spec.GetRuntime().GetProfile() != g.GetRuntime().GetProfile() {
+		refused = append(refused, "runtime")
+	}
+	if spec.Log.GetType() != g.Log.GetType() || !equalStringMap(spec.Log.GetConfig(), g.Log.GetConfig()) {
+		refused = append(refused, "log")
+	}
+	if !equalStrings(spec.Dns, g.Dns) || !equalStrings(spec.DnsOptions, g.DnsOptions) || !equalStrings(spec.DnsSearch, g.DnsSearch) {
+		refused = append(refused, "dns")
+	}
+	if !sameHostAliases(spec.HostAliases, g.HostAliases) {
+		refused = append(refused, "hostAliases")
+	}
+	if !equalStrings(spec.PortmappingWhiteLists.GetInternalNetworks(), g.PortmappingWhiteLists.GetInternalNetworks()) ||
+		!equalStrings(spec.PortmappingWhiteLists.GetExternalNetworks(), g.PortmappingWhiteLists.GetExternalNetworks()) {
+		refused = append(refused, "portmappingWhiteLists")
+	}
+	if spec.Tty != g.Tty || spec.RestartPolicy != g.RestartPolicy || spec.Priority != g.Priority ||
+		spec.AutoRemove != g.AutoRemove || spec.TtlAfterFinished != g.TtlAfterFinished {
+		u.global = true
+		u.add(UPDATE_KIND_POD, p.Id(), UPDATE_UPDATE)
+	}
+
+	if !equalStringMap(spec.Labels, labels) {
+		u.labels = true
+		u.add(UPDATE_KIND_LABEL, p.Id(), UPDATE_UPDATE)
+	}
+
+	if !proto.Equal(spec.NetworkPolicy, g.NetworkPolicy) {
+		u.policy = true
+		u.add(UPDATE_KIND_POLICY, p.Id(), UPDATE_UPDATE)
+	}
+
+	p.statusLock.RLock()
+	if !p.sameInitContainers(spec.InitContainers) {
+		refused = append(refused, "initContainers")
+	}
+
+	// the volumes whose definition changed could only be plugged again
+	// after all the containers using them are removed, which could not be
+	// rolled back
+	changedVols := make(map[string]bool)
+	for _, vol := range spec.Volumes {
+		if v, ok := p.volumes[vol.Name]; ok && volumeChanged(v.spec, vol) {
+			changedVols[vol.Name] = true
+		}
+	}
+
+	existing := make(map[string]*Container)
+	for cid, c := range p.containers {
+		if !p.isInitContainer(cid) {
+			existing[c.SpecName()] = c
+		}
+	}
+	for _, cspec := range spec.Containers {
+		cspec.Id = ""
+		c, ok := existing[cspec.Name]
+		if !ok {
+			u.createContainers = append(u.createContainers, cspec)
+			u.add(UPDATE_KIND_CONTAINER, cspec.Name, UPDATE_ADD)
+			continue
+		}
+		delete(existing, cspec.Name)
+		if proto.Equal(userContainerSpec(c.spec, p.Id()), userContainerSpec(cspec, p.Id())) {
+			continue
+		}
+		u.removeContainers = append(u.removeContainers, c.Id())
+		u.createContainers = append(u.createContainers, cspec)
+		u.add(UPDATE_KIND_CONTAINER, cspec.Name, UPDATE_REPLACE)
+	}
+	for name, c := range existing {
+		u.removeContainers = append(u.removeContainers, c.Id())
+		u.add(UPDATE_KIND_CONTAINER, name, UPDATE_REMOVE)
+	}
+	for _, c := range p.containers {
+		for _, ref := range c.spec.Volumes {
+			if changedVols[ref.Volume] {
+				refused = append(refused, fmt.Sprintf("volume %s used by container %s", ref.Volume, c.SpecName()))
+			}
+		}
+	}
+
+	interfaces := interfacesWithId(spec.Interfaces)
+	for id, inf := range p.interfaces {
+		nspec, ok := interfaces[id]
+		if ok && sameInterface(inf.spec, nspec) {
+			// the rate limits are changed on the tap device in place
+			if !sameBandwidth(inf.spec.Bandwidth, nspec.Bandwidth) {
+				u.bandwidth[id] = nspec.Bandwidth
+				u.add(UPDATE_KIND_INTERFACE, id, UPDATE_UPDATE)
+			}
+			continue
+		}
+		if inf.descript != nil && strings.SplitN(inf.descript.Ip, "/", 2)[0] == p.containerIP {
+			refused = append(refused, fmt.Sprintf("interface %s carrying the port mappings", id))
+			continue
+		}
+		u.removeInterfaces = append(u.removeInterfaces, id)
+		if ok {
+			u.addInterfaces = append(u.addInterfaces, nspec)
+			u.add(UPDATE_KIND_INTERFACE, id, UPDATE_REPLACE)
+		} else {
+			u.add(UPDATE_KIND_INTERFACE, id, UPDATE_REMOVE)
+		}
+	}
+	for id, nspec := range interfaces {
+		if _, ok := p.interfaces[id]; !ok {
+			u.addInterfaces = append(u.addInterfaces, nspec)
+			u.add(UPDATE_KIND_INTERFACE, id, UPDATE_ADD)
+		}
+	}
+	p.statusLock.RUnlock()
+
+	if !sameServices(spec.Services, services) {
+		u.services = true
+		u.add(UPDATE_KIND_SERVICE, p.Id(), UPDATE_UPDATE)
+	}
+
+	current := p.ListPortMappings()
+	for _, pm := range current {
+		if !containsPortMapping(spec.Portmappings, pm) {
+			u.removePortMapping = append(u.removePortMapping, pm)
+			u.add(UPDATE_KIND_PORTMAPPING, pm.String(), UPDATE_REMOVE)
+		}
+	}
+	for _, pm := range spec.Portmappings {
+		if !containsPortMapping(current, pm) {
+			u.addPortMapping = append(u.addPortMapping, pm)
+			u.add(UPDATE_KIND_PORTMAPPING, pm.String(), UPDATE_ADD)
+		}
+	}
+
+	if len(refused) > 0 {
+		return nil, errors.ErrPodUpdateRefused.WithArgs(p.Id(),
+			fmt.Sprintf("changing %s requires a new sandbox", strings.Join(refused, ", ")))
+	}
+	return u, nil
+}
+
+// applyUpdate applies the changes to the pod. The replaced containers are
+// stopped but kept until the new ones started, if any of the new containers
+// fails to start, they are removed and the replaced ones are started again.
+// The pod-wide settings and the labels are committed at last, so that a
+// failed update leaves the pod running with its old spec, and could be
+// applied again.
+func (p *XPod) applyUpdate(spec *apitypes.UserPod, u *podUpdate) (err error) {
+	renamed, err := p.createContainers(u)
+	if err != nil {
+		return err
+	}
+
+	stopped := []string{}
+	defer func() {
+		if err != nil {
+			p.rollbackContainers(u, renamed, stopped)
+		}
+	}()
+
+	for _, cid := range u.removeContainers {
+		p.statusLock.RLock()
+		c, ok := p.containers[cid]
+		p.statusLock.RUnlock()
+		if !ok || !c.IsRunning() {
+			continue
+		}
+		if err = p.StopContainer(cid, replacedStopGrace); err != nil {
+			return err
+		}
+		stopped = append(stopped, cid)
+	}
+
+	if len(u.removeInterfaces) > 0 || len(u.addInterfaces) > 0 {
+		if err = p.updateInterfaces(u.removeInterfaces, u.addInterfaces); err != nil {
+			return err
+		}
+	}
+
+	for id, bw := range u.bandwidth {
+		if err = p.SetInterfaceBandwidth(id, bw); err != nil {
+			return err
+		}
+	}
+
+	for _, cspec := range u.createContainers {
+		if err = p.ContainerStart(cspec.Id); err != nil {
+			return err
+		}
+	}
+
+	if u.services {
+		if err = p.UpdateService(spec.Services); err != nil {
+			return err
+		}
+	}
+
+	if len(u.removePortMapping) > 0 {
+		if err = p.RemovePortMappingStricted(u.removePortMapping); err != nil {
+			return err
+		}
+	}
+	if len(u.addPortMapping) > 0 {
+		if err = p.AddPortMapping(u.addPortMapping); err != nil {
+			return err
+		}
+	}
+
+	if err = p.commitUpdate(spec, u); err != nil {
+		return err
+	}
+
+	// the update is applied, the replaced containers left are only logged
+	for _, cid := range u.removeContainers {
+		if e := p.RemoveContainer(cid); e != nil {
+			p.Log(ERROR, "failed to remove the replaced container %s: %v", cid, e)
+		}
+	}
+	return nil
+}
+
+// replacedStopGrace is the seconds the replaced containers are given to stop.
+const replacedStopGrace = 5
+
+// createContainers creates the new containers of the update. The replaced
+// containers, which keep running until they are stopped, are renamed out
+// of the way of their replacements, the original names of them are
+// returned. If any container fails to be created, the created ones are
+// removed and the replaced ones get their names back.
+func (p *XPod) createContainers(u *podUpdate) (renamed map[string]string, err error) {
+	var (
+		names   = make(map[string]bool, len(u.createContainers))
+		created = []string{}
+	)
+	renamed = make(map[string]string)
+	defer func() {
+		if err == nil {
+			return
+		}
+		p.removeCreated(created)
+		p.renameBack(renamed)
+	}()
+
+	for _, cspec := range u.createContainers {
+		names[cspec.Name] = true
+	}
+	for _, cid := range u.removeContainers {
+		p.statusLock.RLock()
+		c, ok := p.containers[cid]
+		p.statusLock.RUnlock()
+		if !ok || !names[c.SpecName()] {
+			continue
+		}
+		name := c.SpecName()
+		if err = p.RenameContainer(cid, fmt.Sprintf("%s-replaced-%s", name, utils.RandStr(8, "alpha"))); err != nil {
+			return nil, err
+		}
+		renamed[cid] = name
+	}
+
+	for _, cspec := range u.createContainers {
+		var id string
+		id, err = p.ContainerCreate(cspec)
+		if cspec.Id != "" {
+			created = append(created, cspec.Id)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if id == "" {
+			err = fmt.Errorf("container name %s is in use", cspec.Name)
+			return nil, err
+		}
+	}
+	return renamed, nil
+}
+
+// rollbackContainers removes the containers created by a failed update,
+// and restores the replaced ones with their names, starting the stopped.
+func (p *XPod) rollbackContainers(u *podUpdate, renamed map[string]string, stopped []string) {
+	created := make([]string, 0, len(u.createContainers))
+	for _, cspec := range u.createContainers {
+		created = append(created, cspec.Id)
+	}
+	p.removeCreated(created)
+	p.renameBack(renamed)
+	for _, cid := range stopped {
+		if err := p.ContainerStart(cid); err != nil {
+			p.Log(ERROR, "failed to start the replaced container %s again: %v", cid, err)
+		}
+	}
+}
+
+func (p *XPod) removeCreated(created []string) {
+	for _, cid := range created {
+		if err := p.RemoveContainer(cid); err != nil {
+			p.Log(ERROR, "failed to remove container %s of the failed update: %v", cid, err)
+		}
+	}
+}
+
+func (p *XPod) renameBack(renamed map[string]string) {
+	for cid, name := range renamed {
+		if err := p.RenameContainer(cid, name); err != nil {
+			p.Log(ERROR, "failed to rename container %s back to %s: %v", cid, name, err)
+		}
+	}
+}
+
+// commitUpdate applies and saves the pod-wide settings, the network policy
+// and the labels of the spec.
+func (p *XPod) commitUpdate(spec *apitypes.UserPod, u *podUpdate) error {
+	if !u.global && !u.policy && !u.labels {
+		return nil
+	}
+
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	if u.global {
+		p.globalSpec.Tty = spec.Tty
+		p.globalSpec.RestartPolicy = spec.RestartPolicy
+		p.globalSpec.Priority = spec.Priority
+		p.globalSpec.AutoRemove = spec.AutoRemove
+		p.globalSpec.TtlAfterFinished = spec.TtlAfterFinished
+	}
+	if u.policy {
+		p.globalSpec.NetworkPolicy = spec.NetworkPolicy
+	}
+	if u.labels {
+		p.labels = spec.Labels
+	}
+	if u.policy || u.labels {
+		if err := p.syncNetworkPolicy(); err != nil {
+			return err
+		}
+	}
+
+	if u.global || u.policy {
+		if err := p.saveGlobalSpec(); err != nil {
+			return err
+		}
+	}
+	if u.labels {
+		if err := p.savePodMeta(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateInterfaces hot-unplugs and hot-plugs the interfaces of the sandbox.
+func (p *XPod) updateInterfaces(remove []string, add []*apitypes.UserInterface) error {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	for _, id := range remove {
+		inf := p.interfaces[id]
+		if err := p.sandbox.DeleteNic(id); err != nil {
+			inf.Log(ERROR, "failed to remove NIC: %v", err)
+			return err
+		}
+		inf.cleanup()
+		if err := inf.removeFromDB(); err != nil {
+			return err
+		}
+		p.statusLock.Lock()
+		delete(p.interfaces, id)
+		p.statusLock.Unlock()
+	}
+
+	for _, nspec := range add {
+		inf := newInterface(p, nspec)
+		if err := inf.prepare(); err != nil {
+			return err
+		}
+		if err := inf.add(); err != nil {
+			inf.cleanup()
+			return err
+		}
+		p.statusLock.Lock()
+		p.interfaces[nspec.Id] = inf
+		p.statusLock.Unlock()
+		if err := inf.saveInterface(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.syncNetworkPolicy(); err != nil {
+		return err
+	}
+	if err := p.saveLayout(); err != nil {
+		return err
+	}
+	return p.saveSandbox()
+}
+
+func (p *XPod) sameInitContainers(specs []*apitypes.UserContainer) bool {
+	if len(specs) != len(p.initContainers) {
+		return false
+	}
+	for i, cid := range p.initContainers {
+		c, ok := p.containers[cid]
+		if !ok || !proto.Equal(userContainerSpec(c.spec, p.Id()), userContainerSpec(specs[i], p.Id())) {
+			return false
+		}
+	}
+	return true
+}
+
+// userContainerSpec returns the spec of the container without the id, and
+// without the volumes and files added by hyperd on creating the container:
+// the /etc/hosts, the resolv.conf and the volumes of the image. The details
+// of the volumes are compared with volumeChanged.
+func userContainerSpec(spec *apitypes.UserContainer, podId string) *apitypes.UserContainer {
+	c := proto.Clone(spec).(*apitypes.UserContainer)
+	c.Id = ""
+
+	vols := []*apitypes.UserVolumeReference{}
+	for _, v := range c.Volumes {
+		if v.Volume == etcHostsVolumeName || (spec.Id != "" && strings.HasPrefix(v.Volume, spec.Id)) {
+			continue
+		}
+		v.Detail = nil
+		vols = append(vols, v)
+	}
+	c.Volumes = vols
+
+	files := []*apitypes.UserFileReference{}
+	for _, f := range c.Files {
+		if f.Filename == podId+"-resolvconf" {
+			continue
+		}
+		files = append(files, f)
+	}
+	c.Files = files
+	return c
+}
+
+// volumeChanged compares the volume of the pod with the new definition, a
+// volume without source is created by hyperd, and keeps its storage.
+func volumeChanged(old, spec *apitypes.UserVolume) bool {
+	if spec.Source == "" {
+		return false
+	}
+	return !proto.Equal(old, spec)
+}
+
+// interfacesWithId assigns the ids to the interfaces the same way as
+// initResources, the pod has a default interface if none is specified.
+func interfacesWithId(specs []*apitypes.UserInterface) map[string]*apitypes.UserInterface {
+	if len(specs) == 0 {
+		specs = []*apitypes.UserInterface{{}}
+	}
+	result := make(map[string]*apitypes.UserInterface, len(specs))
+	noId := []*apitypes.UserInterface{}
+	for _, nspec := range specs {
+		if nspec.Id != "" {
+			result[nspec.Id] = nspec
+		} else {
+			noId = append(noId, nspec)
+		}
+	}
+	idx := 0
+	for _, nspec := range noId {
+		var id string
+		for {
+			idx++
+			id = fmt.Sprintf("%d", idx)
+			if _, ok := result[id]; !ok {
+				break
+			}
+		}
+		nspec.Id = id
+		result[id] = nspec
+	}
+	return result
+}
+
+func sameInterface(a, b *apitypes.UserInterface) bool {
+	return a.Bridge == b.Bridge && a.Ip == b.Ip && a.Ifname == b.Ifname &&
+		a.Mac == b.Mac && a.Gateway == b.Gateway && a.Mtu == b.Mtu
+}
+
+func sameServices(a, b []*apitypes.UserService) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsPortMapping(pms []*apitypes.PortMapping, pm *apitypes.PortMapping) bool {
+	for _, p := range pms {
+		if p.EqualTo(pm) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalStringMap(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func sameBandwidth(a, b *apitypes.InterfaceBandwidth) bool {
+	return a.GetIngressRate() == b.GetIngressRate() && a.GetIngressBurst() == b.GetIngressBurst() &&
+		a.GetEgressRate() == b.GetEgressRate() && a.GetEgressBurst() == b.GetEgressBurst()
+}
+
+func sameHostAliases(a, b []*apitypes.HostAlias) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
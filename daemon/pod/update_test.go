@@ -0,0 +1,116 @@
+package pod
+
+import (
+	"sync"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func newUpdateTestPod(t *testing.T, spec *apitypes.UserPod) *XPod {
+	if err := spec.MergePortmappings(); err != nil {
+		t.Fatal(err)
+	}
+	if err := spec.ReorganizeContainers(true); err != nil {
+		t.Fatal(err)
+	}
+	p := &XPod{
+		name:         spec.Id,
+		globalSpec:   spec,
+		containers:   make(map[string]*Container),
+		volumes:      make(map[string]*Volume),
+		interfaces:   make(map[string]*Interface),
+		portMappings: spec.Portmappings,
+		labels:       spec.Labels,
+		resourceLock: &sync.Mutex{},
+		statusLock:   &sync.RWMutex{},
+		status:       S_POD_RUNNING,
+	}
+	p.services = &Services{p: p}
+	for id, nspec := range interfacesWithId(spec.Interfaces) {
+		p.interfaces[id] = &Interface{p: p, spec: nspec}
+	}
+	return p
+}
+
+func TestUpdatePortMappingsUnchanged(t *testing.T) {
+	spec := func() *apitypes.UserPod {
+		return &apitypes.UserPod{
+			Id: "test-update",
+			Portmappings: []*apitypes.PortMapping{
+				{HostPort: "8080", ContainerPort: "80", Protocol: "tcp"},
+				{HostPort: "5353", ContainerPort: "53", Protocol: "udp"},
+			},
+		}
+	}
+	p := newUpdateTestPod(t, spec())
+
+	changes, err := p.Update(spec(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("update with the same port mappings should be a no-op: %v", changes)
+	}
+	if n := len(p.ListPortMappings()); n != 2 {
+		t.Fatalf("port mappings should not be duplicated: %d", n)
+	}
+
+	// only the changed mapping is replaced
+	nspec := spec()
+	nspec.Portmappings[0].HostPort = "8081"
+	changes, err = p.Update(nspec, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected the port mapping removed and added: %v", changes)
+	}
+	for _, c := range changes {
+		if c.Kind != UPDATE_KIND_PORTMAPPING {
+			t.Fatalf("unexpected change %v", c)
+		}
+	}
+}
+
+func TestUpdateChangedVolumeRefused(t *testing.T) {
+	spec := func() *apitypes.UserPod {
+		return &apitypes.UserPod{
+			Id: "test-update",
+			Containers: []*apitypes.UserContainer{{
+				Name:    "web",
+				Image:   "nginx:1.13",
+				Volumes: []*apitypes.UserVolumeReference{{Path: "/data", Volume: "data"}},
+			}},
+			Volumes: []*apitypes.UserVolume{{Name: "data", Source: "/tmp/data1", Format: "vfs"}},
+		}
+	}
+	old := spec()
+	p := newUpdateTestPod(t, old)
+	for _, vol := range old.Volumes {
+		p.volumes[vol.Name] = newVolume(p, vol)
+	}
+	for _, cspec := range old.Containers {
+		cspec.Id = "c-" + cspec.Name
+		p.containers[cspec.Id] = &Container{p: p, spec: cspec}
+	}
+
+	// the replaced container could not be restarted after its volume
+	// is plugged again with the new source
+	nspec := spec()
+	nspec.Containers[0].Image = "nginx:1.14"
+	nspec.Volumes[0].Source = "/tmp/data2"
+	if _, err := p.Update(nspec, true); err == nil {
+		t.Fatal("expected changing the volume of the replaced container refused")
+	}
+
+	nspec = spec()
+	nspec.Containers[0].Image = "nginx:1.14"
+	changes, err := p.Update(nspec, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Action != UPDATE_REPLACE {
+		t.Fatalf("expected the container replaced: %v", changes)
+	}
+}
@@ -0,0 +1,52 @@
+package daemon
+
+import (
+	"io"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/errors"
+)
+
+// DialPort connects to the TCP port of the pod.
+func (daemon *Daemon) DialPort(podId string, port int32) (io.ReadWriteCloser, error) {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return nil, errors.ErrPodNotFound.WithArgs(podId)
+	}
+	return p.DialPort(port)
+}
+
+// PortForward tunnels the streams to the TCP port of the pod. The end of
+// stdin is passed to the port as a half close, and it returns once the
+// port closes the connection, or the client goes away.
+func (daemon *Daemon) PortForward(stdin io.ReadCloser, stdout io.WriteCloser, podId string, port int32) error {
+	conn, err := daemon.DialPort(podId, port)
+	if err != nil {
+		return err
+	}
+	return daemon.TunnelPort(stdin, stdout, conn, podId, port)
+}
+
+// TunnelPort copies the streams from and to the connection dialed by
+// DialPort, the connection is closed on return.
+func (daemon *Daemon) TunnelPort(stdin io.ReadCloser, stdout io.WriteCloser, conn io.ReadWriteCloser, podId string, port int32) error {
+	defer conn.Close()
+	defer stdin.Close()
+
+	go func() {
+		if _, err := io.Copy(conn, stdin); err != nil {
+			glog.V(1).Infof("%s: port forward to %d is interrupted: %v", podId, port, err)
+			conn.Close()
+			return
+		}
+		if hc, ok := conn.(interface {
+			CloseWrite() error
+		}); ok {
+			hc.CloseWrite()
+		}
+	}()
+
+	_, err := io.Copy(stdout, conn)
+	stdout.Close()
+	return err
+}
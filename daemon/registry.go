@@ -0,0 +1,187 @@
+package daemon
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/docker/docker/opts"
+	"github.com/docker/docker/reference"
+	"github.com/docker/docker/registry"
+	"github.com/docker/engine-api/types"
+	"github.com/golang/glog"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// the certs directory of docker, the registries without CA configured in
+// hyperd config still use the certs there.
+var dockerCertsDir = registry.CertsDir
+
+func newRegistryService(mirrors []string, insecureRegistries []string) *registry.Service {
+	registryOpts := &registry.Options{
+		Mirrors:            opts.NewListOpts(nil),
+		InsecureRegistries: opts.NewListOpts(nil),
+	}
+
+	for _, m := range mirrors {
+		if m != "" {
+			registryOpts.Mirrors.Set(m)
+		}
+	}
+
+	for _, ir := range insecureRegistries {
+		if ir != "" {
+			registryOpts.InsecureRegistries.Set(ir)
+		}
+	}
+
+	return registry.NewService(registryOpts)
+}
+
+func (daemon *Daemon) initRegistry(c *apitypes.HyperConfig) error {
+	if err := setupRegistryCerts(filepath.Join(c.Root, "certs.d"), c.Registries); err != nil {
+		return err
+	}
+
+	daemon.registryLock.Lock()
+	daemon.registries = c.Registries
+	daemon.registryLock.Unlock()
+	return nil
+}
+
+// ReloadRegistry rebuilds the registry service with the mirrors, insecure
+// registries, CAs and credentials in the config, and swaps it in. The pulls
+// and pushes in progress keep using the previous service.
+func (daemon *Daemon) ReloadRegistry(c *apitypes.HyperConfig) error {
+	if err := daemon.initRegistry(c); err != nil {
+		return err
+	}
+
+	s := newRegistryService(c.RegistryMirrors, c.InsecureRegistries)
+	atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&daemon.Daemon.RegistryService)), unsafe.Pointer(s))
+	glog.Infof("registry service reloaded, mirrors: %v, insecure registries: %v", c.RegistryMirrors, c.InsecureRegistries)
+	return nil
+}
+
+// registryAuth returns the credentials in the config for the registry of
+// ref, if the request does not carry any.
+func (daemon *Daemon) registryAuth(ref reference.Named, authConfig *types.AuthConfig) *types.AuthConfig {
+	if authConfig != nil && (authConfig.Username != "" || authConfig.Auth != "" || authConfig.RegistryToken != "") {
+		return authConfig
+	}
+
+	repoInfo, err := registry.ParseRepositoryInfo(ref)
+	if err != nil {
+		return authConfig
+	}
+
+	daemon.registryLock.RLock()
+	r, ok := daemon.registries[repoInfo.Index.Name]
+	daemon.registryLock.RUnlock()
+	if !ok || (r.Username == "" && r.Auth == "") {
+		return authConfig
+	}
+
+	return &types.AuthConfig{
+		Username:      r.Username,
+		Password:      r.Password,
+		Auth:          r.Auth,
+		Email:         r.Email,
+		ServerAddress: repoInfo.Index.Name,
+	}
+}
+
+// setupRegistryCerts populates a new certs directory with the CA of the
+// registries and the links to the docker certs directory for the others,
+// then swaps the link dir to it, which the registry package points to. The
+// directory replaced is kept for the pulls in progress until the next swap.
+func setupRegistryCerts(dir string, registries map[string]*apitypes.RegistryConfig) error {
+	gen := fmt.Sprintf("%s.%d", dir, time.Now().UnixNano())
+	if err := os.MkdirAll(gen, 0755); err != nil {
+		return err
+	}
+	if err := populateRegistryCerts(gen, registries); err != nil {
+		os.RemoveAll(gen)
+		return err
+	}
+
+	// the directory left by the former versions could not be swapped
+	prev, err := os.Readlink(dir)
+	if err != nil && !os.IsNotExist(err) {
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	link := dir + ".link"
+	os.Remove(link)
+	if err := os.Symlink(gen, link); err != nil {
+		return err
+	}
+	if err := os.Rename(link, dir); err != nil {
+		return err
+	}
+	if registry.CertsDir != dir {
+		registry.CertsDir = dir
+	}
+
+	if old, err := filepath.Glob(dir + ".*"); err == nil {
+		for _, d := range old {
+			if d != gen && d != prev {
+				os.RemoveAll(d)
+			}
+		}
+	}
+	return nil
+}
+
+func populateRegistryCerts(dir string, registries map[string]*apitypes.RegistryConfig) error {
+	for host, r := range registries {
+		if r.CA == "" {
+			continue
+		}
+		ca, err := ioutil.ReadFile(r.CA)
+		if err != nil {
+			return fmt.Errorf("failed to read CA of registry %s: %v", host, err)
+		}
+		hostDir := filepath.Join(dir, host)
+		if err := os.MkdirAll(hostDir, 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(hostDir, "ca.crt"), ca, 0644); err != nil {
+			return err
+		}
+	}
+	return linkDockerCerts(dir)
+}
+
+// linkDockerCerts links the entries of the docker certs directory, which
+// have no CA configured in hyperd, into dir.
+func linkDockerCerts(dir string) error {
+	entries, err := ioutil.ReadDir(dockerCertsDir)
+	if err != nil {
+		return nil
+	}
+	for _, e := range entries {
+		err := os.Symlink(filepath.Join(dockerCertsDir, e.Name()), filepath.Join(dir, e.Name()))
+		if err != nil && !os.IsExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshRegistryCerts falls back to the docker certs directory for the
+// registries added there since the certs of hyperd were set up, it is
+// called before the registries are accessed.
+func (daemon *Daemon) RefreshRegistryCerts() {
+	if registry.CertsDir == dockerCertsDir {
+		return
+	}
+	if err := linkDockerCerts(registry.CertsDir); err != nil {
+		glog.Warningf("failed to link the docker certs of the registries: %v", err)
+	}
+}
@@ -11,10 +11,6 @@ import (
 )
 
 func (daemon *Daemon) CreatePod(podId string, podSpec *apitypes.UserPod) (*pod.XPod, error) {
-	//FIXME: why restrict to 1024
-	if daemon.PodList.CountRunning() >= 1024 {
-		return nil, fmt.Errorf("There have already been %d running Pods", 1024)
-	}
 	if podId == "" {
 		podId = fmt.Sprintf("pod-%s", utils.RandStr(10, "alpha"))
 	}
@@ -27,7 +23,18 @@ func (daemon *Daemon) CreatePod(podId string, podSpec *apitypes.UserPod) (*pod.X
 		return nil, err
 	}
 
-	factory := pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
+	if _, err := daemon.VmFactory(podSpec.GetRuntime().GetProfile()); err != nil {
+		return nil, err
+	}
+
+	release, err := daemon.Admission.Admit(podSpec.Id, podSpec.Resource)
+	if err != nil {
+		glog.Errorf("%s: %v", podSpec.Id, err)
+		return nil, err
+	}
+	defer release()
+
+	factory := pod.NewPodFactory(daemon, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
 
 	p, err := pod.CreateXPod(factory, podSpec)
 	if err != nil {
@@ -46,6 +53,16 @@ func (daemon *Daemon) StartPod(podId string) error {
 
 	glog.Infof("Starting pod %q in vm: %q", podId, p.SandboxName())
 
+	// a stopped pod boots a new sandbox
+	if p.IsStopped() {
+		release, err := daemon.Admission.Admit(p.Id(), p.Resource())
+		if err != nil {
+			glog.Errorf("%s: %v", p.Id(), err)
+			return err
+		}
+		defer release()
+	}
+
 	err := p.Start()
 	if err != nil {
 		glog.Infof("failed to  start pod %s: %v", p.Id(), err)
@@ -55,6 +72,31 @@ func (daemon *Daemon) StartPod(podId string) error {
 	return err
 }
 
+// UpdatePod applies the spec to the running pod, see pod.XPod.Update.
+func (daemon *Daemon) UpdatePod(podId string, podSpec *apitypes.UserPod, dryRun bool) ([]*apitypes.PodUpdateChange, error) {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return nil, fmt.Errorf("The pod(%s) can not be found", podId)
+	}
+
+	if podSpec.Id == "" {
+		podSpec.Id = p.Id()
+	}
+	if podSpec.Id != p.Id() {
+		return nil, fmt.Errorf("the spec of pod %s could not update pod %s", podSpec.Id, p.Id())
+	}
+	if err := podSpec.Validate(); err != nil {
+		return nil, err
+	}
+
+	changes, err := p.Update(podSpec, dryRun)
+	if err != nil {
+		glog.Errorf("%s: failed to update pod: %v", p.Id(), err)
+		return nil, err
+	}
+	return changes, nil
+}
+
 func (daemon *Daemon) WaitContainer(cid string, second int) (int, error) {
 	p, id, ok := daemon.PodList.GetByContainerIdOrName(cid)
 	if !ok {
@@ -72,21 +114,5 @@ func (daemon *Daemon) SetPodLabels(pn string, override bool, labels map[string]s
 		return fmt.Errorf("Can not get Pod %s info", pn)
 	}
 
-	err := p.SetLabel(labels, override)
-	if err != nil {
-		return err
-	}
-
-	// TODO update the persisit info
-
-	//spec, err := json.Marshal(p.spec)
-	//if err != nil {
-	//	return err
-	//}
-	//
-	//if err := daemon.db.UpdatePod(p.name, spec); err != nil {
-	//	return err
-	//}
-
-	return nil
+	return p.SetLabel(labels, override)
 }
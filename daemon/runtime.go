@@ -0,0 +1,67 @@
+package daemon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/factory"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+// initRuntimes creates the VM factories of the runtime profiles in the
+// config file, after the default runtime is initialized by initRunV. The
+// profiles select the kernel, initrd and vsock of their VMs. The VMs are
+// booted by the hypervisor driver of the daemon, so a profile could only
+// name the same hypervisor.
+func (daemon *Daemon) initRuntimes(c *apitypes.HyperConfig) error {
+	daemon.Runtimes = make(map[string]*VmFactory)
+	for name, r := range c.Runtimes {
+		if r.Driver != "" && !strings.EqualFold(r.Driver, c.Driver) && !strings.EqualFold(r.Driver, hypervisor.HDriver.Name()) {
+			err := fmt.Errorf("hypervisor %q of runtime %s differs from the hypervisor %q of the daemon", r.Driver, name, hypervisor.HDriver.Name())
+			glog.Error(err)
+			return err
+		}
+
+		glog.Infof("runtime %s: kernel %s, initrd %s", name, r.Kernel, r.Initrd)
+		boot := hypervisor.BootConfig{
+			Kernel:      r.Kernel,
+			Initrd:      r.Initrd,
+			EnableVsock: r.EnableVsock,
+			GDBTCPPort:  c.GDBTCPPort,
+		}
+		daemon.Runtimes[name] = NewVmFactory(boot, r.VmFactoryPolicy)
+	}
+	return nil
+}
+
+// VmFactory returns the VM factory of the runtime profile, the empty
+// profile is the default runtime.
+func (daemon *Daemon) VmFactory(profile string) (factory.Factory, error) {
+	f, err := daemon.RuntimeFactory(profile)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// RuntimeFactory returns the VmFactory of the runtime profile, to manage
+// the cached VMs of the profile.
+func (daemon *Daemon) RuntimeFactory(profile string) (*VmFactory, error) {
+	if profile == "" {
+		return daemon.Factory, nil
+	}
+	f, ok := daemon.Runtimes[profile]
+	if !ok {
+		return nil, fmt.Errorf("runtime profile %q is not found in the config file", profile)
+	}
+	return f, nil
+}
+
+func (daemon *Daemon) closeRuntimes() {
+	daemon.Factory.CloseFactory()
+	for _, f := range daemon.Runtimes {
+		f.CloseFactory()
+	}
+}
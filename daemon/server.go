@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/docker/distribution/digest"
 	"github.com/docker/docker/reference"
@@ -51,6 +52,7 @@ func (daemon *Daemon) CmdImages(args, filter string, all bool) (*engine.Env, err
 }
 
 func (daemon *Daemon) CmdAuthenticateToRegistry(config *types.AuthConfig) (string, error) {
+	daemon.RefreshRegistryCerts()
 	return daemon.Daemon.AuthenticateToRegistry(config)
 }
 
@@ -58,6 +60,14 @@ func (daemon *Daemon) CmdAttach(stdin io.ReadCloser, stdout io.WriteCloser, cont
 	return daemon.Attach(stdin, stdout, container)
 }
 
+func (daemon *Daemon) CmdDialPort(podId string, port int32) (io.ReadWriteCloser, error) {
+	return daemon.DialPort(podId, port)
+}
+
+func (daemon *Daemon) CmdTunnelPort(stdin io.ReadCloser, stdout io.WriteCloser, conn io.ReadWriteCloser, podId string, port int32) error {
+	return daemon.TunnelPort(stdin, stdout, conn, podId, port)
+}
+
 func (daemon *Daemon) CmdCommitImage(name string, cfg *types.ContainerCommitConfig) (*engine.Env, error) {
 	imgId, err := daemon.Daemon.Commit(name, cfg)
 	if err != nil {
@@ -179,6 +189,9 @@ func (daemon *Daemon) CmdSystemInfo() (*apitypes.InfoResponse, error) {
 	info.MemTotal = int64(meminfo.MemTotal)
 	info.Pods = daemon.GetPodNum()
 	info.OperatingSystem = osinfo.PrettyName
+	info.Capacity = daemon.Admission.Capacity()
+	info.IpConflicts = daemon.ipConflicts
+	info.MissingNetworks = daemon.missingNetworks
 	if hostname, err := os.Hostname(); err == nil {
 		info.Name = hostname
 	}
@@ -271,6 +284,47 @@ func (daemon *Daemon) CmdCreatePod(podArgs string) (*engine.Env, error) {
 	return v, nil
 }
 
+func (daemon *Daemon) CmdUpdatePod(podId, podArgs string, dryRun bool) (*engine.Env, error) {
+	var podSpec apitypes.UserPod
+	err := json.Unmarshal([]byte(podArgs), &podSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := daemon.UpdatePod(podId, &podSpec, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.Set("ID", podId)
+	v.SetJson("Changes", changes)
+	v.SetInt("Code", 0)
+	v.Set("Cause", "")
+
+	return v, nil
+}
+
+func (daemon *Daemon) CmdApplyPods(podArgs, managedBy string, prune, recreate, dryRun bool) (*engine.Env, error) {
+	var specs []*apitypes.UserPod
+	err := json.Unmarshal([]byte(podArgs), &specs)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := daemon.ApplyPods(specs, managedBy, prune, recreate, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.SetJson("Results", results)
+	v.SetInt("Code", 0)
+	v.Set("Cause", "")
+
+	return v, nil
+}
+
 func (daemon *Daemon) CmdContainerRename(oldname, newname string) (*engine.Env, error) {
 	if err := daemon.ContainerRename(oldname, newname); err != nil {
 		return nil, err
@@ -352,6 +406,26 @@ func (daemon *Daemon) CmdDeletePortMappings(podId string, req []byte) (*engine.E
 	return &engine.Env{}, nil
 }
 
+func (daemon *Daemon) CmdSetPodBandwidth(podId, ifId string, req []byte) (*engine.Env, error) {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return nil, errors.ErrPodNotFound.WithArgs(podId)
+	}
+
+	var bw apitypes.InterfaceBandwidth
+	err := json.Unmarshal(req, &bw)
+	if err != nil {
+		return nil, errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	err = p.SetInterfaceBandwidth(ifId, &bw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &engine.Env{}, nil
+}
+
 func (daemon *Daemon) CmdImageDelete(name string, force, prune bool) ([]*apitypes.ImageDelete, error) {
 	list, err := daemon.Daemon.ImageDelete(name, force, prune)
 	if err != nil {
@@ -370,6 +444,7 @@ func (daemon *Daemon) CmdImageDelete(name string, force, prune bool) ([]*apitype
 }
 
 func (daemon *Daemon) CmdImagePull(image, tag string, authConfig *types.AuthConfig, metaHeaders map[string][]string, output io.Writer) error {
+	daemon.RefreshRegistryCerts()
 	// Special case: "pull -a" may send an image name with a
 	// trailing :. This is ugly, but let's not break API
 	// compatibility.
@@ -397,13 +472,19 @@ func (daemon *Daemon) CmdImagePull(image, tag string, authConfig *types.AuthConf
 		}
 	}
 
+	authConfig = daemon.registryAuth(ref, authConfig)
+	start := time.Now()
 	err = daemon.Daemon.PullImage(ref, metaHeaders, authConfig, output)
+	observeImagePull(start, err)
 	if err != nil {
 		glog.Errorf("failed to pull image %s", ref.String())
 		return err
 	}
 
 	glog.Infof("got image: %s", ref.String())
+	if img, err := daemon.Daemon.LookupImage(ref.String()); err == nil {
+		imagePullBytes.Add(float64(img.Size))
+	}
 
 	// pull digest again for tagged reference. This is because of an issue of
 	// docker 1.10.3. And will remove this work around once we update the docker
@@ -449,7 +530,8 @@ func (daemon *Daemon) CmdImagePush(repo, tag string, authConfig *types.AuthConfi
 		}
 	}
 
-	return daemon.Daemon.PushImage(ref, metaHeaders, authConfig, output)
+	daemon.RefreshRegistryCerts()
+	return daemon.Daemon.PushImage(ref, metaHeaders, daemon.registryAuth(ref, authConfig), output)
 }
 
 func (daemon *Daemon) CmdStopPod(podId, stopVm string) (*engine.Env, error) {
@@ -499,14 +581,20 @@ func (daemon *Daemon) CmdAddService(podId, data string) (*engine.Env, error) {
 	return v, nil
 }
 
-func (daemon *Daemon) CmdUpdateService(podId, data string) (*engine.Env, error) {
+// CmdUpdateService replaces the services of the pod, or with merge, only
+// the ones with the same addresses.
+func (daemon *Daemon) CmdUpdateService(podId, data string, merge bool) (*engine.Env, error) {
 	var srvs []*apitypes.UserService
 	err := json.Unmarshal([]byte(data), &srvs)
 	if err != nil {
 		return nil, err
 	}
 
-	err = daemon.UpdateService(podId, srvs)
+	if merge {
+		err = daemon.MergeService(podId, srvs)
+	} else {
+		err = daemon.UpdateService(podId, srvs)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -546,3 +634,23 @@ func (daemon *Daemon) CmdUnpausePod(podId string) error {
 	glog.V(1).Infof("Unpause pod %s", podId)
 	return daemon.UnpausePod(podId)
 }
+
+func (daemon *Daemon) CmdCreateNetwork(data []byte) (*apitypes.Network, error) {
+	var spec apitypes.Network
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	return daemon.CreateNetwork(&spec)
+}
+
+func (daemon *Daemon) CmdListNetworks() ([]*apitypes.Network, error) {
+	return daemon.ListNetworks(), nil
+}
+
+func (daemon *Daemon) CmdInspectNetwork(name string) (*apitypes.NetworkInfo, error) {
+	return daemon.InspectNetwork(name)
+}
+
+func (daemon *Daemon) CmdRemoveNetwork(name string) error {
+	return daemon.RemoveNetwork(name)
+}
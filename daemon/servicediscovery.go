@@ -24,6 +24,15 @@ func (daemon *Daemon) UpdateService(podId string, srvs []*apitypes.UserService)
 	return p.UpdateService(srvs)
 }
 
+func (daemon *Daemon) MergeService(podId string, srvs []*apitypes.UserService) error {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return fmt.Errorf("The pod(%s) can not be found, please create it first", podId)
+	}
+
+	return p.MergeService(srvs)
+}
+
 func (daemon *Daemon) DeleteService(podId string, srvs []*apitypes.UserService) error {
 	p, ok := daemon.PodList.Get(podId)
 	if !ok {
@@ -0,0 +1,401 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/factory"
+	"github.com/hyperhq/runv/factory/base"
+	"github.com/hyperhq/runv/factory/direct"
+	"github.com/hyperhq/runv/factory/single"
+	"github.com/hyperhq/runv/factory/template"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+const (
+	VM_FACTORY_ADD    = "add"
+	VM_FACTORY_RESIZE = "resize"
+	VM_FACTORY_DROP   = "drop"
+	VM_FACTORY_WARMUP = "warmup"
+)
+
+// VmFactory is the factory.Factory of the daemon. It keeps a pool of cached
+// VMs for each cpu/memory profile of the VmFactoryPolicy, and the profiles
+// could be added, resized or dropped while pods keep using the factory.
+type VmFactory struct {
+	lock      sync.RWMutex
+	boot      hypervisor.BootConfig
+	profiles  []*vmProfile
+	fallbacks int64
+}
+
+// vmProfile is a base.Factory caching the VMs booted by the lower factory.
+type vmProfile struct {
+	config factory.FactoryConfig
+	b      base.Factory
+
+	lock    sync.Mutex
+	vms     []*hypervisor.Vm
+	booting int
+	hits    int64
+	misses  int64
+	errors  int64
+	closed  bool
+}
+
+func NewVmFactory(boot hypervisor.BootConfig, policy string) *VmFactory {
+	f := &VmFactory{boot: boot}
+	configs, err := parseVmFactoryPolicy(policy)
+	if err != nil {
+		glog.Error(err)
+	}
+	for _, c := range configs {
+		f.profiles = append(f.profiles, f.newProfile(c))
+	}
+	f.sortProfiles()
+	return f
+}
+
+func parseVmFactoryPolicy(policy string) ([]factory.FactoryConfig, error) {
+	var configs []factory.FactoryConfig
+	if policy == "" || policy == "none" {
+		return configs, nil
+	}
+	if err := json.Unmarshal([]byte("["+policy+"]"), &configs); err != nil {
+		return nil, fmt.Errorf("incorrect vm factory policy %q: %v", policy, err)
+	}
+	return configs, nil
+}
+
+func (f *VmFactory) newProfile(c factory.FactoryConfig) *vmProfile {
+	boot := f.boot
+	boot.CPU = c.Cpu
+	boot.Memory = c.Memory
+
+	p := &vmProfile{config: c}
+	if c.Template {
+		p.b = template.New(filepath.Join(hypervisor.BaseDir, "template"), boot)
+	} else {
+		p.b = direct.New(boot)
+	}
+	p.lock.Lock()
+	p.refill()
+	p.lock.Unlock()
+	return p
+}
+
+type sortingProfiles []*vmProfile
+
+func (s sortingProfiles) Len() int      { return len(s) }
+func (s sortingProfiles) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s sortingProfiles) Less(i, j int) bool {
+	ci, cj := s[i].config, s[j].config
+	return ci.Cpu < cj.Cpu || (ci.Cpu == cj.Cpu && ci.Memory < cj.Memory)
+}
+
+// the larger profiles first, as the multi factory of runv
+func (f *VmFactory) sortProfiles() {
+	sort.Sort(sort.Reverse(sortingProfiles(f.profiles)))
+}
+
+func (f *VmFactory) GetVm(cpu, mem int) (*hypervisor.Vm, error) {
+	f.lock.Lock()
+	var matched *vmProfile
+	for _, p := range f.profiles {
+		if p.config.Cpu <= cpu && p.config.Memory <= mem {
+			matched = p
+			break
+		}
+	}
+	if matched == nil {
+		f.fallbacks++
+	}
+	f.lock.Unlock()
+
+	if matched == nil {
+		return single.Dummy(f.boot).GetVm(cpu, mem)
+	}
+	return single.New(matched).GetVm(cpu, mem)
+}
+
+func (f *VmFactory) CloseFactory() {
+	f.lock.Lock()
+	profiles := f.profiles
+	f.profiles = nil
+	f.lock.Unlock()
+
+	for _, p := range profiles {
+		p.CloseFactory()
+	}
+}
+
+// Policy returns the VmFactoryPolicy of the current profiles.
+func (f *VmFactory) Policy() string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.policyLocked()
+}
+
+func (f *VmFactory) policyLocked() string {
+	if len(f.profiles) == 0 {
+		return ""
+	}
+	configs := make([]factory.FactoryConfig, 0, len(f.profiles))
+	for _, p := range f.profiles {
+		configs = append(configs, p.config)
+	}
+	data, _ := json.Marshal(configs)
+	return strings.TrimSuffix(strings.TrimPrefix(string(data), "["), "]")
+}
+
+// UpdatePolicy replaces all the profiles with the ones of the policy, the
+// cached VMs of the previous profiles are destroyed.
+func (f *VmFactory) UpdatePolicy(policy string) error {
+	configs, err := parseVmFactoryPolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	profiles := make([]*vmProfile, 0, len(configs))
+	for _, c := range configs {
+		profiles = append(profiles, f.newProfile(c))
+	}
+
+	f.lock.Lock()
+	old := f.profiles
+	f.profiles = profiles
+	f.sortProfiles()
+	f.lock.Unlock()
+
+	glog.Infof("vm factory policy updated to %q", policy)
+	for _, p := range old {
+		p.CloseFactory()
+	}
+	return nil
+}
+
+// Status returns the status of the profiles.
+func (f *VmFactory) Status() *apitypes.VMFactoryStatusResponse {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	resp := &apitypes.VMFactoryStatusResponse{
+		Policy:    f.policyLocked(),
+		Profiles:  make([]*apitypes.VMFactoryProfileStatus, 0, len(f.profiles)),
+		Fallbacks: f.fallbacks,
+	}
+	for _, p := range f.profiles {
+		resp.Profiles = append(resp.Profiles, p.status())
+	}
+	return resp
+}
+
+// Update adds, resizes, drops or warms up the profile of the cpu/memory.
+func (f *VmFactory) Update(action string, profile *apitypes.VMFactoryProfile, count int) error {
+	if profile == nil {
+		return fmt.Errorf("no vm factory profile specified")
+	}
+	if profile.Cpu <= 0 || profile.Memory <= 0 {
+		return fmt.Errorf("invalid vm factory profile cpu %d, memory %d", profile.Cpu, profile.Memory)
+	}
+	if profile.Cache < 0 || count < 0 {
+		return fmt.Errorf("the cache size and the warm up count should not be negative")
+	}
+
+	if action == VM_FACTORY_ADD {
+		return f.addProfile(profile)
+	}
+
+	f.lock.Lock()
+	idx := f.findProfile(profile)
+	if idx < 0 {
+		f.lock.Unlock()
+		return fmt.Errorf("vm factory profile cpu %d, memory %d not found", profile.Cpu, profile.Memory)
+	}
+	p := f.profiles[idx]
+
+	switch action {
+	case VM_FACTORY_RESIZE:
+		defer f.lock.Unlock()
+		p.resize(int(profile.Cache))
+		glog.Infof("vm factory profile cpu %d, memory %d resized to %d", profile.Cpu, profile.Memory, profile.Cache)
+	case VM_FACTORY_DROP:
+		f.profiles = append(f.profiles[:idx], f.profiles[idx+1:]...)
+		f.lock.Unlock()
+		glog.Infof("vm factory profile cpu %d, memory %d dropped", profile.Cpu, profile.Memory)
+		p.CloseFactory()
+	case VM_FACTORY_WARMUP:
+		f.lock.Unlock()
+		p.warmUp(count)
+	default:
+		f.lock.Unlock()
+		return fmt.Errorf("unknown vm factory action %q", action)
+	}
+	return nil
+}
+
+// findProfile returns the index of the profile of the cpu/memory, the lock
+// should be held.
+func (f *VmFactory) findProfile(profile *apitypes.VMFactoryProfile) int {
+	for i, p := range f.profiles {
+		if p.config.Cpu == int(profile.Cpu) && p.config.Memory == int(profile.Memory) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *VmFactory) addProfile(profile *apitypes.VMFactoryProfile) error {
+	f.lock.RLock()
+	idx := f.findProfile(profile)
+	f.lock.RUnlock()
+	if idx >= 0 {
+		return fmt.Errorf("vm factory profile cpu %d, memory %d already exists", profile.Cpu, profile.Memory)
+	}
+
+	// creating a template profile boots the template VM, do it unlocked
+	p := f.newProfile(factory.FactoryConfig{
+		Cache:    int(profile.Cache),
+		Template: profile.Template,
+		Cpu:      int(profile.Cpu),
+		Memory:   int(profile.Memory),
+	})
+
+	f.lock.Lock()
+	if f.findProfile(profile) >= 0 {
+		f.lock.Unlock()
+		p.CloseFactory()
+		return fmt.Errorf("vm factory profile cpu %d, memory %d already exists", profile.Cpu, profile.Memory)
+	}
+	f.profiles = append(f.profiles, p)
+	f.sortProfiles()
+	f.lock.Unlock()
+
+	glog.Infof("vm factory profile cpu %d, memory %d added, cache %d", profile.Cpu, profile.Memory, profile.Cache)
+	return nil
+}
+
+func (p *vmProfile) Config() *hypervisor.BootConfig {
+	return p.b.Config()
+}
+
+func (p *vmProfile) GetBaseVm() (*hypervisor.Vm, error) {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return nil, fmt.Errorf("vm factory profile is closed")
+	}
+	if len(p.vms) > 0 {
+		vm := p.vms[0]
+		p.vms = p.vms[1:]
+		p.hits++
+		p.refill()
+		p.lock.Unlock()
+		glog.V(3).Infof("vm factory get vm from cache: %s", vm.Id)
+		return vm, nil
+	}
+	p.misses++
+	p.refill()
+	p.lock.Unlock()
+
+	return p.b.GetBaseVm()
+}
+
+func (p *vmProfile) CloseFactory() {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return
+	}
+	p.closed = true
+	vms := p.vms
+	p.vms = nil
+	p.lock.Unlock()
+
+	for _, vm := range vms {
+		vm.Kill()
+	}
+	p.b.CloseFactory()
+}
+
+func (p *vmProfile) status() *apitypes.VMFactoryProfileStatus {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return &apitypes.VMFactoryProfileStatus{
+		Profile: &apitypes.VMFactoryProfile{
+			Cpu:      int32(p.config.Cpu),
+			Memory:   int32(p.config.Memory),
+			Cache:    int32(p.config.Cache),
+			Template: p.config.Template,
+		},
+		Cached:  int32(len(p.vms)),
+		Booting: int32(p.booting),
+		Hits:    p.hits,
+		Misses:  p.misses,
+		Errors:  p.errors,
+	}
+}
+
+func (p *vmProfile) resize(size int) {
+	p.lock.Lock()
+	p.config.Cache = size
+	var drop []*hypervisor.Vm
+	if len(p.vms) > size {
+		drop = p.vms[size:]
+		p.vms = p.vms[:size]
+	}
+	p.refill()
+	p.lock.Unlock()
+
+	for _, vm := range drop {
+		vm.Kill()
+	}
+}
+
+// warmUp boots count VMs into the cache at once, they are kept even if the
+// cache exceeds its size.
+func (p *vmProfile) warmUp(count int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for i := 0; i < count; i++ {
+		p.boot()
+	}
+}
+
+// refill boots VMs until the cached and booting ones fill the cache, the
+// lock should be held.
+func (p *vmProfile) refill() {
+	for !p.closed && len(p.vms)+p.booting < p.config.Cache {
+		p.boot()
+	}
+}
+
+// boot starts booting a VM in background, the lock should be held.
+func (p *vmProfile) boot() {
+	p.booting++
+	go func() {
+		vm, err := p.b.GetBaseVm()
+
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		p.booting--
+		if err != nil {
+			// do not refill here, or a broken profile keeps booting VMs
+			p.errors++
+			glog.Errorf("vm factory failed to boot vm for cpu %d, memory %d: %v", p.config.Cpu, p.config.Memory, err)
+			return
+		}
+		if p.closed {
+			vm.Kill()
+			return
+		}
+		glog.V(3).Infof("vm factory cached vm: %s", vm.Id)
+		p.vms = append(p.vms, vm)
+	}()
+}
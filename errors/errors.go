@@ -50,4 +50,34 @@ var (
 		Message:        "container %s is in running state",
 		HTTPStatusCode: http.StatusPreconditionFailed,
 	})
+
+	ErrInsufficientCapacity = errcode.Register(errGroup, errcode.ErrorDescriptor{
+		Value:          "HYPER_INSUFFICIENT_CAPACITY",
+		Message:        "pod %s does not fit the host: %s",
+		HTTPStatusCode: http.StatusForbidden,
+	})
+
+	ErrPodUpdateRefused = errcode.Register(errGroup, errcode.ErrorDescriptor{
+		Value:          "HYPER_POD_UPDATE_REFUSED",
+		Message:        "pod %s could not be updated in place: %s",
+		HTTPStatusCode: http.StatusConflict,
+	})
+
+	ErrInterfaceNotFound = errcode.Register(errGroup, errcode.ErrorDescriptor{
+		Value:          "HYPER_INTERFACE_NOT_FOUND",
+		Message:        "interface %s of pod %s not found",
+		HTTPStatusCode: http.StatusNotFound,
+	})
+
+	ErrNetworkNotFound = errcode.Register(errGroup, errcode.ErrorDescriptor{
+		Value:          "HYPER_NETWORK_NOT_FOUND",
+		Message:        "network %s not found",
+		HTTPStatusCode: http.StatusNotFound,
+	})
+
+	ErrNetworkInUse = errcode.Register(errGroup, errcode.ErrorDescriptor{
+		Value:          "HYPER_NETWORK_IN_USE",
+		Message:        "network %s is in use by %d endpoints",
+		HTTPStatusCode: http.StatusConflict,
+	})
 )
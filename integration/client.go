@@ -238,6 +238,38 @@ func (s *StdcopyExtractor) Extract(orig []byte) ([]byte, []byte, error) {
 
 }
 
+// PortForward sends the request to the port of the pod through a tunnel,
+// and returns the response once the port closes the connection
+func (c *HyperClient) PortForward(podID string, port int32, request []byte) ([]byte, error) {
+	stream, err := c.client.PortForward(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	req := types.PortForwardMessage{
+		PodID: podID,
+		Port:  port,
+		Data:  request,
+	}
+	if err := stream.Send(&req); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	response := []byte{}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return response, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, res.Data...)
+	}
+}
+
 // PostAttach attach to a container or pod by id
 func (c *HyperClient) PostAttach(id string, tty bool) error {
 	stream, err := c.client.Attach(c.ctx)
@@ -582,6 +614,14 @@ func (c *HyperClient) RemoveImage(image string) error {
 	return err
 }
 
+// ImageGC removes unused images to reclaim disk space
+func (c *HyperClient) ImageGC(dryRun, force bool) (*types.ImageGCResponse, error) {
+	return c.client.ImageGC(c.ctx, &types.ImageGCRequest{
+		DryRun: dryRun,
+		Force:  force,
+	})
+}
+
 func (c *HyperClient) PushImage(repo, tag string, out io.Writer) error {
 	request := types.ImagePushRequest{
 		Repo: repo,
@@ -698,6 +738,68 @@ func (c *HyperClient) SetPodLabels(podID string, override bool, labels map[strin
 	return nil
 }
 
+// UpdatePod applies a new spec to a running pod
+func (c *HyperClient) UpdatePod(podID string, spec *types.UserPod, dryRun bool) ([]*types.PodUpdateChange, error) {
+	resp, err := c.client.PodUpdate(c.ctx, &types.PodUpdateRequest{
+		PodID:   podID,
+		PodSpec: spec,
+		DryRun:  dryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Changes, nil
+}
+
+// ApplyPods creates, updates or removes the pods to match the specs
+func (c *HyperClient) ApplyPods(specs []*types.UserPod, managedBy string, prune, recreate, dryRun bool) ([]*types.PodApplyResult, error) {
+	resp, err := c.client.PodApply(c.ctx, &types.PodApplyRequest{
+		Pods:      specs,
+		ManagedBy: managedBy,
+		Prune:     prune,
+		Recreate:  recreate,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// CreateNetwork creates a named network
+func (c *HyperClient) CreateNetwork(spec *types.Network) (*types.Network, error) {
+	resp, err := c.client.NetworkCreate(c.ctx, &types.NetworkCreateRequest{Network: spec})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Network, nil
+}
+
+// InspectNetwork gets a named network and its endpoints
+func (c *HyperClient) InspectNetwork(name string) (*types.NetworkInfo, error) {
+	resp, err := c.client.NetworkInspect(c.ctx, &types.NetworkInspectRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Network, nil
+}
+
+// RemoveNetwork removes a named network
+func (c *HyperClient) RemoveNetwork(name string) error {
+	_, err := c.client.NetworkRemove(c.ctx, &types.NetworkRemoveRequest{Name: name})
+	return err
+}
+
+// SetPodBandwidth changes the rate limits of an interface of the pod
+func (c *HyperClient) SetPodBandwidth(podID, interfaceID string, bw *types.InterfaceBandwidth) error {
+	_, err := c.client.PodSetBandwidth(c.ctx, &types.PodSetBandwidthRequest{
+		PodID:       podID,
+		InterfaceID: interfaceID,
+		Bandwidth:   bw,
+	})
+	return err
+}
+
 // Info gets system info of hyperd
 func (c *HyperClient) Info() (*types.InfoResponse, error) {
 	info, err := c.client.Info(
@@ -726,6 +828,32 @@ func (c *HyperClient) Ping() (*types.PingResponse, error) {
 	return resp, nil
 }
 
+// VMFactoryStatus gets the status of the VM factory of the runtime
+func (c *HyperClient) VMFactoryStatus(runtime string) (*types.VMFactoryStatusResponse, error) {
+	return c.client.VMFactoryStatus(c.ctx, &types.VMFactoryStatusRequest{Runtime: runtime})
+}
+
+// VMFactoryUpdate adds, resizes, drops or warms up a VM factory profile of
+// the runtime
+func (c *HyperClient) VMFactoryUpdate(runtime, action string, profile *types.VMFactoryProfile, count int32) ([]*types.VMFactoryProfileStatus, error) {
+	resp, err := c.client.VMFactoryUpdate(c.ctx, &types.VMFactoryUpdateRequest{
+		Action:  action,
+		Profile: profile,
+		Count:   count,
+		Runtime: runtime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Profiles, nil
+}
+
+// ReloadConfig reloads the config file of hyperd
+func (c *HyperClient) ReloadConfig() (*types.ReloadConfigResponse, error) {
+	return c.client.ReloadConfig(c.ctx, &types.ReloadConfigRequest{})
+}
+
 // ContainerSignal sends a signal to specified container of specified pod
 func (c *HyperClient) ContainerSignal(podID, containerID string, signal int64) error {
 	_, err := c.client.ContainerSignal(c.ctx, &types.ContainerSignalRequest{
@@ -1,8 +1,11 @@
 package integration
 
 import (
+	"bytes"
 	"io"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hyperhq/hyperd/lib/promise"
 	"github.com/hyperhq/hyperd/types"
@@ -167,6 +170,153 @@ func (s *TestSuite) TestCreateAndStartPod(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *TestSuite) TestInitContainers(c *C) {
+	spec := types.UserPod{
+		Id: "busybox-init",
+		InitContainers: []*types.UserContainer{
+			{
+				Name:    "init",
+				Image:   "hyperhq/busybox",
+				Command: []string{"true"},
+			},
+		},
+		Containers: []*types.UserContainer{
+			{
+				Image: "hyperhq/busybox",
+			},
+		},
+	}
+
+	pod, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+
+	err = s.client.StartPod(pod)
+	c.Assert(err, IsNil)
+
+	podInfo, err := s.client.GetPodInfo(pod)
+	c.Assert(err, IsNil)
+	c.Assert(podInfo.Status.Phase, Equals, "Running")
+	c.Assert(podInfo.Spec.InitContainers, HasLen, 1)
+	c.Assert(podInfo.Status.ContainerStatus, HasLen, 2)
+	c.Assert(podInfo.Status.ContainerStatus[0].Name, Equals, "init")
+	c.Assert(podInfo.Status.ContainerStatus[0].Phase, Equals, "succeeded")
+
+	err = s.client.RemovePod(pod)
+	c.Assert(err, IsNil)
+}
+
+func (s *TestSuite) TestContainerDependsOn(c *C) {
+	spec := types.UserPod{
+		Id: "busybox-depends",
+		Containers: []*types.UserContainer{
+			{
+				Name:  "app",
+				Image: "hyperhq/busybox",
+				DependsOn: []*types.UserContainerDependency{
+					{Container: "proxy", Condition: "healthy"},
+				},
+			},
+			{
+				Name:  "proxy",
+				Image: "hyperhq/busybox",
+				HealthCheck: &types.UserContainerHealthCheck{
+					Command: []string{"true"},
+				},
+			},
+		},
+	}
+
+	pod, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+
+	err = s.client.StartPod(pod)
+	c.Assert(err, IsNil)
+
+	podInfo, err := s.client.GetPodInfo(pod)
+	c.Assert(err, IsNil)
+	c.Assert(podInfo.Status.Phase, Equals, "Running")
+
+	err = s.client.RemovePod(pod)
+	c.Assert(err, IsNil)
+
+	spec.Containers[1].DependsOn = []*types.UserContainerDependency{{Container: "app"}}
+	_, err = s.client.CreatePod(&spec)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestJobPod(c *C) {
+	spec := types.UserPod{
+		Id:         "busybox-job",
+		Type:       "job",
+		AutoRemove: true,
+		Containers: []*types.UserContainer{
+			{
+				Name:    "job",
+				Image:   "hyperhq/busybox",
+				Command: []string{"sh", "-c", "exit 3"},
+			},
+		},
+	}
+
+	pod, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+
+	err = s.client.StartPod(pod)
+	c.Assert(err, IsNil)
+
+	// the finished job is kept until its exit code is read
+	code, err := s.client.Wait("job", "", false)
+	c.Assert(err, IsNil)
+	c.Assert(code, Equals, int32(3))
+
+	// and then removed by the job reaper
+	for i := 0; ; i++ {
+		if _, err = s.client.GetPodInfo(pod); err != nil {
+			break
+		}
+		if i == 60 {
+			c.Fatalf("job pod %s is not removed", pod)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func (s *TestSuite) TestContainerOOMKilled(c *C) {
+	spec := types.UserPod{
+		Id:       "busybox-oom",
+		Resource: &types.UserResource{Vcpu: 1, Memory: 256},
+		Containers: []*types.UserContainer{
+			{
+				Name:      "oom",
+				Image:     "hyperhq/busybox",
+				Command:   []string{"tail", "/dev/zero"},
+				Resources: &types.UserContainerResources{Memory: 32, PidsLimit: 16},
+			},
+		},
+	}
+
+	pod, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+	defer s.client.RemovePod(pod)
+
+	err = s.client.StartPod(pod)
+	c.Assert(err, IsNil)
+
+	for i := 0; ; i++ {
+		podInfo, err := s.client.GetPodInfo(pod)
+		c.Assert(err, IsNil)
+		cs := podInfo.Status.ContainerStatus[0]
+		if cs.Phase == "failed" {
+			c.Assert(cs.Terminated.Reason, Equals, "OOMKilled")
+			break
+		}
+		if i == 60 {
+			c.Fatalf("container of pod %s is not killed for exceeding memory", pod)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
 func (s *TestSuite) TestCreateContainer(c *C) {
 	err := s.client.PullImage("hyperhq/busybox", "latest", nil)
 	c.Assert(err, IsNil)
@@ -255,6 +405,41 @@ func (s *TestSuite) TestPullImage(c *C) {
 	c.Assert(found, Equals, false)
 }
 
+func (s *TestSuite) TestImageGCDryRun(c *C) {
+	err := s.client.PullImage("alpine", "latest", nil)
+	c.Assert(err, IsNil)
+
+	resp, err := s.client.ImageGC(true, true)
+	c.Assert(err, IsNil)
+	found := false
+	for _, img := range resp.Images {
+		for _, repo := range img.RepoTags {
+			if repo == "alpine:latest" {
+				found = true
+				break
+			}
+		}
+	}
+	c.Assert(found, Equals, true)
+
+	// dry run should not remove anything
+	list, err := s.client.GetImageList()
+	c.Assert(err, IsNil)
+	found = false
+	for _, img := range list {
+		for _, repo := range img.RepoTags {
+			if repo == "alpine:latest" {
+				found = true
+				break
+			}
+		}
+	}
+	c.Assert(found, Equals, true)
+
+	err = s.client.RemoveImage("alpine")
+	c.Assert(err, IsNil)
+}
+
 func (s *TestSuite) TestAddListDeleteService(c *C) {
 	spec := types.UserPod{
 		Containers: []*types.UserContainer{
@@ -353,6 +538,80 @@ func (s *TestSuite) TestAddListDeleteService(c *C) {
 	c.Log("last  ===> done")
 }
 
+func (s *TestSuite) TestServiceHealthCheck(c *C) {
+	spec := types.UserPod{
+		Containers: []*types.UserContainer{
+			{
+				Image:   "hyperhq/busybox",
+				Command: []string{"sleep", "10000"},
+			},
+		},
+		Services: []*types.UserService{
+			{
+				ServiceIP:          "10.10.0.24",
+				ServicePort:        80,
+				Protocol:           "TCP",
+				Scheduler:          "wrr",
+				PersistenceTimeout: 60,
+				Hosts: []*types.UserServiceBackend{
+					{
+						HostIP:   "192.168.23.2",
+						HostPort: 8080,
+						Weight:   9,
+					},
+					{
+						HostIP:   "192.168.23.3",
+						HostPort: 8080,
+						Weight:   1,
+					},
+				},
+				HealthCheck: &types.ServiceHealthCheck{
+					Interval:           1,
+					UnhealthyThreshold: 1,
+				},
+			},
+		},
+	}
+
+	pod, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+	defer func() {
+		err = s.client.RemovePod(pod)
+		c.Assert(err, IsNil)
+	}()
+
+	err = s.client.StartPod(pod)
+	c.Assert(err, IsNil)
+
+	// the backends do not exist, they are unhealthy after the first probe
+	var svcList []*types.UserService
+	for i := 0; i < 10; i++ {
+		time.Sleep(time.Second)
+		svcList, err = s.client.ListService(pod)
+		c.Assert(err, IsNil)
+		c.Assert(len(svcList), Equals, 1)
+		if svcList[0].Hosts[0].State == "unhealthy" && svcList[0].Hosts[1].State == "unhealthy" {
+			break
+		}
+	}
+	c.Assert(svcList[0].Hosts[0].State, Equals, "unhealthy")
+	c.Assert(svcList[0].Hosts[1].State, Equals, "unhealthy")
+	c.Assert(svcList[0].Hosts[0].Weight, Equals, int32(9))
+	c.Assert(svcList[0].Scheduler, Equals, "wrr")
+
+	// the services not health checked have no state
+	svcList[0].HealthCheck = nil
+	err = s.client.UpdateService(pod, svcList)
+	c.Assert(err, IsNil)
+	svcList, err = s.client.ListService(pod)
+	c.Assert(err, IsNil)
+	c.Assert(svcList[0].Hosts[0].State, Equals, "")
+
+	svcList[0].Scheduler = "random"
+	err = s.client.UpdateService(pod, svcList)
+	c.Assert(err, NotNil)
+}
+
 func (s *TestSuite) TestStartAndStopPod(c *C) {
 	spec := types.UserPod{
 		Id: "busybox",
@@ -414,6 +673,336 @@ func (s *TestSuite) TestSetPodLabels(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *TestSuite) TestUpdatePod(c *C) {
+	spec := types.UserPod{
+		Id: "busybox-update",
+		Containers: []*types.UserContainer{
+			{
+				Name:    "web",
+				Image:   "hyperhq/busybox",
+				Command: []string{"sleep", "1000"},
+			},
+		},
+	}
+
+	podID, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+	defer func() {
+		err = s.client.RemovePod(podID)
+		c.Assert(err, IsNil)
+	}()
+	err = s.client.StartPod(podID)
+	c.Assert(err, IsNil)
+
+	update := types.UserPod{
+		Id:     "busybox-update",
+		Labels: map[string]string{"version": "2"},
+		Containers: []*types.UserContainer{
+			{
+				Name:    "web",
+				Image:   "hyperhq/busybox",
+				Command: []string{"sleep", "2000"},
+			},
+			{
+				Name:    "sidecar",
+				Image:   "hyperhq/busybox",
+				Command: []string{"sleep", "1000"},
+			},
+		},
+	}
+	changes, err := s.client.UpdatePod(podID, &update, true)
+	c.Assert(err, IsNil)
+	c.Assert(changes, HasLen, 3)
+
+	changes, err = s.client.UpdatePod(podID, &update, false)
+	c.Assert(err, IsNil)
+	c.Assert(changes, HasLen, 3)
+
+	info, err := s.client.GetPodInfo(podID)
+	c.Assert(err, IsNil)
+	c.Assert(info.Spec.Containers, HasLen, 2)
+	c.Assert(info.Spec.Labels["version"], Equals, "2")
+
+	// the same spec changes nothing
+	changes, err = s.client.UpdatePod(podID, &update, false)
+	c.Assert(err, IsNil)
+	c.Assert(changes, HasLen, 0)
+
+	update.Resource = &types.UserResource{Vcpu: 2, Memory: 256}
+	_, err = s.client.UpdatePod(podID, &update, false)
+	c.Assert(err, ErrorMatches, ".*requires a new sandbox.*")
+
+	// a container failed to be created leaves the pod as it was
+	update.Resource = nil
+	update.Labels = map[string]string{"version": "3"}
+	update.Containers[0].Image = "hyperhq/no-such-image"
+	_, err = s.client.UpdatePod(podID, &update, false)
+	c.Assert(err, NotNil)
+
+	info, err = s.client.GetPodInfo(podID)
+	c.Assert(err, IsNil)
+	c.Assert(info.Spec.Containers, HasLen, 2)
+	c.Assert(info.Spec.Labels["version"], Equals, "2")
+	for _, cs := range info.Status.ContainerStatus {
+		c.Assert(cs.Phase, Equals, "running")
+	}
+}
+
+func (s *TestSuite) TestApplyPods(c *C) {
+	newSpec := func(id, version string) *types.UserPod {
+		return &types.UserPod{
+			Id:     id,
+			Labels: map[string]string{"version": version},
+			Containers: []*types.UserContainer{
+				{
+					Name:    id + "-web",
+					Image:   "hyperhq/busybox",
+					Command: []string{"sleep", "1000"},
+				},
+			},
+		}
+	}
+	actions := func(results []*types.PodApplyResult) map[string]string {
+		m := map[string]string{}
+		for _, r := range results {
+			c.Assert(r.Error, Equals, "")
+			m[r.PodID] = r.Action
+		}
+		return m
+	}
+	defer func() {
+		s.client.RemovePod("busybox-apply1")
+		s.client.RemovePod("busybox-apply2")
+	}()
+
+	results, err := s.client.ApplyPods([]*types.UserPod{newSpec("busybox-apply1", "1"), newSpec("busybox-apply2", "1")}, "integration", false, false, true)
+	c.Assert(err, IsNil)
+	c.Assert(actions(results), DeepEquals, map[string]string{"busybox-apply1": "create", "busybox-apply2": "create"})
+	_, err = s.client.GetPodInfo("busybox-apply1")
+	c.Assert(err, NotNil)
+
+	results, err = s.client.ApplyPods([]*types.UserPod{newSpec("busybox-apply1", "1"), newSpec("busybox-apply2", "1")}, "integration", false, false, false)
+	c.Assert(err, IsNil)
+	c.Assert(actions(results), DeepEquals, map[string]string{"busybox-apply1": "create", "busybox-apply2": "create"})
+
+	info, err := s.client.GetPodInfo("busybox-apply1")
+	c.Assert(err, IsNil)
+	c.Assert(info.Status.Phase, Equals, "Running")
+	c.Assert(info.Spec.Labels["sh.hyper.apply.managed-by"], Equals, "integration")
+
+	results, err = s.client.ApplyPods([]*types.UserPod{newSpec("busybox-apply1", "2"), newSpec("busybox-apply2", "1")}, "integration", false, false, false)
+	c.Assert(err, IsNil)
+	c.Assert(actions(results), DeepEquals, map[string]string{"busybox-apply1": "update", "busybox-apply2": "unchanged"})
+
+	info, err = s.client.GetPodInfo("busybox-apply1")
+	c.Assert(err, IsNil)
+	c.Assert(info.Spec.Labels["version"], Equals, "2")
+	hash := info.Spec.Labels["sh.hyper.apply.spec-hash"]
+	c.Assert(hash, Not(Equals), "")
+
+	// a failed update keeps the hash, and is applied again next time
+	broken := newSpec("busybox-apply1", "3")
+	broken.Containers[0].Image = "hyperhq/no-such-image"
+	results, err = s.client.ApplyPods([]*types.UserPod{broken}, "integration", false, false, false)
+	c.Assert(err, IsNil)
+	c.Assert(results, HasLen, 1)
+	c.Assert(results[0].Error, Not(Equals), "")
+	info, err = s.client.GetPodInfo("busybox-apply1")
+	c.Assert(err, IsNil)
+	c.Assert(info.Spec.Labels["sh.hyper.apply.spec-hash"], Equals, hash)
+	results, err = s.client.ApplyPods([]*types.UserPod{broken}, "integration", false, false, true)
+	c.Assert(err, IsNil)
+	c.Assert(results[0].Action, Equals, "update")
+
+	// the pods managed by the others are not pruned
+	results, err = s.client.ApplyPods([]*types.UserPod{}, "others", true, false, false)
+	c.Assert(err, IsNil)
+	c.Assert(results, HasLen, 0)
+
+	results, err = s.client.ApplyPods([]*types.UserPod{newSpec("busybox-apply1", "2")}, "integration", true, false, false)
+	c.Assert(err, IsNil)
+	c.Assert(actions(results), DeepEquals, map[string]string{"busybox-apply1": "unchanged", "busybox-apply2": "remove"})
+	_, err = s.client.GetPodInfo("busybox-apply2")
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestNamedNetwork(c *C) {
+	n, err := s.client.CreateNetwork(&types.Network{Name: "tenant-a", Subnet: "10.233.0.0/24"})
+	c.Assert(err, IsNil)
+	c.Assert(n.Gateway, Equals, "10.233.0.1")
+	c.Assert(n.Bridge, Equals, "hy-tenant-a")
+	defer func() {
+		err = s.client.RemoveNetwork("tenant-a")
+		c.Assert(err, IsNil)
+	}()
+
+	_, err = s.client.CreateNetwork(&types.Network{Name: "tenant-b", Subnet: "10.233.0.128/25"})
+	c.Assert(err, ErrorMatches, ".*overlaps.*")
+
+	spec := types.UserPod{
+		Id: "busybox-network",
+		Containers: []*types.UserContainer{
+			{
+				Image:   "hyperhq/busybox",
+				Command: []string{"sleep", "1000"},
+			},
+		},
+		Interfaces: []*types.UserInterface{
+			{Ifname: "eth0", Network: "tenant-a", Ip: "10.233.0.10"},
+		},
+	}
+	podID, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+	err = s.client.StartPod(podID)
+	c.Assert(err, IsNil)
+
+	info, err := s.client.InspectNetwork("tenant-a")
+	c.Assert(err, IsNil)
+	c.Assert(info.Endpoints, HasLen, 1)
+	c.Assert(info.Endpoints[0].PodID, Equals, podID)
+	c.Assert(info.Endpoints[0].Ip, Equals, "10.233.0.10")
+
+	err = s.client.RemoveNetwork("tenant-a")
+	c.Assert(err, ErrorMatches, ".*in use.*")
+
+	err = s.client.RemovePod(podID)
+	c.Assert(err, IsNil)
+	info, err = s.client.InspectNetwork("tenant-a")
+	c.Assert(err, IsNil)
+	c.Assert(info.Endpoints, HasLen, 0)
+}
+
+func (s *TestSuite) TestPodBandwidth(c *C) {
+	spec := types.UserPod{
+		Id: "busybox-bandwidth",
+		Containers: []*types.UserContainer{
+			{
+				Image:   "hyperhq/busybox",
+				Command: []string{"sleep", "1000"},
+			},
+		},
+		Interfaces: []*types.UserInterface{
+			{Ifname: "eth0", Bandwidth: &types.InterfaceBandwidth{IngressRate: 10000000}},
+		},
+	}
+	podID, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+	defer func() {
+		err = s.client.RemovePod(podID)
+		c.Assert(err, IsNil)
+	}()
+	err = s.client.StartPod(podID)
+	c.Assert(err, IsNil)
+
+	info, err := s.client.GetPodInfo(podID)
+	c.Assert(err, IsNil)
+	c.Assert(info.Status.Interfaces, HasLen, 1)
+	c.Assert(info.Status.Interfaces[0].HostDevice, Not(Equals), "")
+	c.Assert(info.Status.Interfaces[0].Bandwidth.GetIngressRate(), Equals, uint64(10000000))
+
+	id := info.Status.Interfaces[0].Id
+	err = s.client.SetPodBandwidth(podID, id, &types.InterfaceBandwidth{EgressRate: 1000000, EgressBurst: 65536})
+	c.Assert(err, IsNil)
+	info, err = s.client.GetPodInfo(podID)
+	c.Assert(err, IsNil)
+	c.Assert(info.Status.Interfaces[0].Bandwidth.GetIngressRate(), Equals, uint64(0))
+	c.Assert(info.Status.Interfaces[0].Bandwidth.GetEgressRate(), Equals, uint64(1000000))
+
+	err = s.client.SetPodBandwidth(podID, "none", &types.InterfaceBandwidth{EgressRate: 1000000})
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestEmbeddedDns(c *C) {
+	newSpec := func(id, name string) *types.UserPod {
+		return &types.UserPod{
+			Id: id,
+			Containers: []*types.UserContainer{
+				{
+					Name:    name,
+					Image:   "hyperhq/busybox",
+					Command: []string{"sleep", "1000"},
+				},
+			},
+			HostAliases: []*types.HostAlias{
+				{Ip: "10.10.0.1", Hostnames: []string{"db.example.com"}},
+			},
+		}
+	}
+	for _, spec := range []*types.UserPod{newSpec("dns-server", "web"), newSpec("dns-client", "dns-client")} {
+		podID, err := s.client.CreatePod(spec)
+		c.Assert(err, IsNil)
+		defer func() {
+			err = s.client.RemovePod(podID)
+			c.Assert(err, IsNil)
+		}()
+		err = s.client.StartPod(podID)
+		c.Assert(err, IsNil)
+	}
+
+	info, err := s.client.GetPodInfo("dns-server")
+	c.Assert(err, IsNil)
+	c.Assert(info.Status.PodIP, Not(HasLen), 0)
+	ip := info.Status.PodIP[0]
+
+	exec := func(command ...string) string {
+		execId, err := s.client.ContainerExecCreate("dns-client", command, false)
+		c.Assert(err, IsNil)
+		var out bytes.Buffer
+		err = s.client.ContainerExecStart("dns-client", execId, nil, &out, nil, false)
+		c.Assert(err, IsNil)
+		return out.String()
+	}
+	c.Assert(exec("nslookup", "dns-server"), Matches, "(?s).*"+regexp.QuoteMeta(ip)+".*")
+	c.Assert(exec("nslookup", "web.dns-server"), Matches, "(?s).*"+regexp.QuoteMeta(ip)+".*")
+	c.Assert(exec("cat", "/etc/hosts"), Matches, "(?s).*10.10.0.1\tdb.example.com.*")
+}
+
+func (s *TestSuite) TestPortForward(c *C) {
+	spec := types.UserPod{
+		Id: "busybox-portforward",
+		Containers: []*types.UserContainer{
+			{
+				Image:   "hyperhq/busybox",
+				Command: []string{"httpd", "-f", "-p", "8080"},
+			},
+			{
+				// listens on the localhost of the pod only
+				Image:   "hyperhq/busybox",
+				Command: []string{"httpd", "-f", "-p", "127.0.0.1:8081"},
+			},
+		},
+	}
+	podID, err := s.client.CreatePod(&spec)
+	c.Assert(err, IsNil)
+	defer func() {
+		err = s.client.RemovePod(podID)
+		c.Assert(err, IsNil)
+	}()
+	err = s.client.StartPod(podID)
+	c.Assert(err, IsNil)
+
+	var res []byte
+	for i := 0; i < 10; i++ {
+		if res, err = s.client.PortForward(podID, 8080, []byte("GET / HTTP/1.0\r\n\r\n")); err == nil {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	c.Assert(err, IsNil)
+	c.Assert(string(res), Matches, "(?s)HTTP/1.[01] .*")
+
+	res, err = s.client.PortForward(podID, 8081, []byte("GET / HTTP/1.0\r\n\r\n"))
+	c.Assert(err, IsNil)
+	c.Assert(string(res), Matches, "(?s)HTTP/1.[01] .*")
+
+	_, err = s.client.PortForward(podID, 8082, nil)
+	c.Assert(err, NotNil)
+	_, err = s.client.PortForward(podID, 0, nil)
+	c.Assert(err, NotNil)
+	_, err = s.client.PortForward("none", 8080, nil)
+	c.Assert(err, NotNil)
+}
+
 func (s *TestSuite) TestPauseAndUnpausePod(c *C) {
 	spec := types.UserPod{
 		Id: "busybox",
@@ -453,6 +1042,33 @@ func (s *TestSuite) TestPauseAndUnpausePod(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *TestSuite) TestPodAdmission(c *C) {
+	info, err := s.client.Info()
+	c.Assert(err, IsNil)
+	c.Assert(info.Capacity, NotNil)
+	if info.Capacity.Memory < 0 {
+		c.Skip("Pod admission test is skipped because the memory is unlimited")
+	}
+
+	spec := types.UserPod{
+		Id:       "busybox-oversized",
+		Resource: &types.UserResource{Vcpu: 1, Memory: int32(info.Capacity.Memory + 1)},
+		Containers: []*types.UserContainer{
+			{
+				Image: "hyperhq/busybox",
+			},
+		},
+	}
+
+	_, err = s.client.CreatePod(&spec)
+	c.Assert(err, NotNil)
+	c.Assert(err, ErrorMatches, ".*does not fit the host.*")
+
+	after, err := s.client.Info()
+	c.Assert(err, IsNil)
+	c.Assert(after.Capacity.MemoryCommitted, Equals, info.Capacity.MemoryCommitted)
+}
+
 func (s *TestSuite) TestGetPodStats(c *C) {
 	info, err := s.client.Info()
 	c.Assert(err, IsNil)
@@ -494,6 +1110,50 @@ func (s *TestSuite) TestPing(c *C) {
 	c.Logf("Got HyperdStats %v", resp)
 }
 
+func (s *TestSuite) TestVMFactoryProfile(c *C) {
+	profile := &types.VMFactoryProfile{Cpu: 1, Memory: 96}
+	profiles, err := s.client.VMFactoryUpdate("", "add", profile, 0)
+	c.Assert(err, IsNil)
+
+	found := false
+	for _, p := range profiles {
+		if p.Profile.Cpu == 1 && p.Profile.Memory == 96 {
+			found = true
+		}
+	}
+	c.Assert(found, Equals, true)
+
+	_, err = s.client.VMFactoryUpdate("", "add", profile, 0)
+	c.Assert(err, NotNil)
+
+	_, err = s.client.VMFactoryUpdate("", "drop", profile, 0)
+	c.Assert(err, IsNil)
+
+	status, err := s.client.VMFactoryStatus("")
+	c.Assert(err, IsNil)
+	for _, p := range status.Profiles {
+		c.Assert(p.Profile.Cpu == 1 && p.Profile.Memory == 96, Equals, false)
+	}
+
+	// the runtime profiles not in the config file have no factory
+	_, err = s.client.VMFactoryStatus("no-such-runtime")
+	c.Assert(err, NotNil)
+	_, err = s.client.VMFactoryUpdate("no-such-runtime", "add", profile, 0)
+	c.Assert(err, NotNil)
+}
+
+func (s *TestSuite) TestReloadConfig(c *C) {
+	resp, err := s.client.ReloadConfig()
+	c.Assert(err, IsNil)
+	// the config file is not changed
+	c.Assert(resp.Applied, HasLen, 0)
+	c.Assert(resp.Rejected, HasLen, 0)
+
+	// the daemon keeps serving after reload
+	_, err = s.client.Ping()
+	c.Assert(err, IsNil)
+}
+
 func (s *TestSuite) TestSendContainerSignal(c *C) {
 	sigKill := int64(9)
 	spec := types.UserPod{
@@ -0,0 +1,327 @@
+// Package metrics implements the counters, gauges and histograms exported
+// in the Prometheus text exposition format.
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefBuckets are the default histogram buckets, in seconds.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Collector writes its samples in the text exposition format.
+type Collector interface {
+	Collect(w io.Writer)
+}
+
+// Registry is a set of collectors, and a http.Handler serving them.
+type Registry struct {
+	lock       sync.Mutex
+	collectors []Collector
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) MustRegister(cs ...Collector) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.collectors = append(r.collectors, cs...)
+}
+
+func (r *Registry) Write(w io.Writer) {
+	r.lock.Lock()
+	collectors := make([]Collector, len(r.collectors))
+	copy(collectors, r.collectors)
+	r.lock.Unlock()
+
+	for _, c := range collectors {
+		c.Collect(w)
+	}
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var buf bytes.Buffer
+	r.Write(&buf)
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	w.Write(buf.Bytes())
+}
+
+// DefaultRegistry is the registry of the metrics of hyperd.
+var DefaultRegistry = NewRegistry()
+
+func MustRegister(cs ...Collector) {
+	DefaultRegistry.MustRegister(cs...)
+}
+
+func Handler() http.Handler {
+	return DefaultRegistry
+}
+
+type desc struct {
+	name   string
+	help   string
+	typ    string
+	labels []string
+}
+
+func (d *desc) header(w io.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n", d.name, escapeHelp(d.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", d.name, d.typ)
+}
+
+func (d *desc) check(labelValues []string) {
+	if len(labelValues) != len(d.labels) {
+		panic(fmt.Sprintf("metric %s expects %d label values, got %d", d.name, len(d.labels), len(labelValues)))
+	}
+}
+
+type sample struct {
+	labelValues []string
+	value       float64
+}
+
+// vec is the samples of a counter or a gauge, keyed by label values.
+type vec struct {
+	desc
+	lock    sync.Mutex
+	samples map[string]*sample
+}
+
+func (v *vec) add(delta float64, set bool, labelValues []string) {
+	v.check(labelValues)
+	key := strings.Join(labelValues, "\xff")
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	s, ok := v.samples[key]
+	if !ok {
+		s = &sample{labelValues: append([]string{}, labelValues...)}
+		v.samples[key] = s
+	}
+	if set {
+		s.value = delta
+	} else {
+		s.value += delta
+	}
+}
+
+func (v *vec) Collect(w io.Writer) {
+	v.lock.Lock()
+	samples := make([]sample, 0, len(v.samples))
+	for _, s := range v.samples {
+		samples = append(samples, *s)
+	}
+	v.lock.Unlock()
+
+	v.header(w)
+	sortSamples(samples)
+	for _, s := range samples {
+		writeSample(w, v.name, v.labels, s.labelValues, "", "", s.value)
+	}
+}
+
+// CounterVec is a set of monotonic counters partitioned by labels.
+type CounterVec struct{ vec }
+
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{vec{
+		desc:    desc{name: name, help: help, typ: "counter", labels: labels},
+		samples: make(map[string]*sample),
+	}}
+}
+
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		panic(fmt.Sprintf("counter %s could not decrease", c.name))
+	}
+	c.add(delta, false, labelValues)
+}
+
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.add(1, false, labelValues)
+}
+
+// GaugeVec is a set of gauges partitioned by labels.
+type GaugeVec struct{ vec }
+
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{vec{
+		desc:    desc{name: name, help: help, typ: "gauge", labels: labels},
+		samples: make(map[string]*sample),
+	}}
+}
+
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.add(value, true, labelValues)
+}
+
+func (g *GaugeVec) Add(delta float64, labelValues ...string) {
+	g.add(delta, false, labelValues)
+}
+
+// GaugeFunc is a set of gauges whose values are retrieved on collection,
+// the fn emits a value for each combination of label values.
+type GaugeFunc struct {
+	desc
+	fn func(emit func(value float64, labelValues ...string))
+}
+
+func NewGaugeFunc(name, help string, labels []string, fn func(emit func(value float64, labelValues ...string))) *GaugeFunc {
+	return &GaugeFunc{
+		desc: desc{name: name, help: help, typ: "gauge", labels: labels},
+		fn:   fn,
+	}
+}
+
+// NewCounterFunc is NewGaugeFunc for the values which only increase.
+func NewCounterFunc(name, help string, labels []string, fn func(emit func(value float64, labelValues ...string))) *GaugeFunc {
+	g := NewGaugeFunc(name, help, labels, fn)
+	g.typ = "counter"
+	return g
+}
+
+func (g *GaugeFunc) Collect(w io.Writer) {
+	samples := []sample{}
+	g.fn(func(value float64, labelValues ...string) {
+		g.check(labelValues)
+		samples = append(samples, sample{labelValues: labelValues, value: value})
+	})
+
+	g.header(w)
+	sortSamples(samples)
+	for _, s := range samples {
+		writeSample(w, g.name, g.labels, s.labelValues, "", "", s.value)
+	}
+}
+
+type histogramSample struct {
+	labelValues []string
+	counts      []uint64
+	sum         float64
+	count       uint64
+}
+
+// HistogramVec is a set of histograms partitioned by labels.
+type HistogramVec struct {
+	desc
+	buckets []float64
+	lock    sync.Mutex
+	samples map[string]*histogramSample
+}
+
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	b := append([]float64{}, buckets...)
+	sort.Float64s(b)
+	return &HistogramVec{
+		desc:    desc{name: name, help: help, typ: "histogram", labels: labels},
+		buckets: b,
+		samples: make(map[string]*histogramSample),
+	}
+}
+
+func (h *HistogramVec) Observe(value float64, labelValues ...string) {
+	h.check(labelValues)
+	key := strings.Join(labelValues, "\xff")
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	s, ok := h.samples[key]
+	if !ok {
+		s = &histogramSample{
+			labelValues: append([]string{}, labelValues...),
+			counts:      make([]uint64, len(h.buckets)),
+		}
+		h.samples[key] = s
+	}
+	for i, b := range h.buckets {
+		if value <= b {
+			s.counts[i]++
+		}
+	}
+	s.sum += value
+	s.count++
+}
+
+func (h *HistogramVec) Collect(w io.Writer) {
+	h.lock.Lock()
+	samples := make([]histogramSample, 0, len(h.samples))
+	for _, s := range h.samples {
+		c := *s
+		c.counts = append([]uint64{}, s.counts...)
+		samples = append(samples, c)
+	}
+	h.lock.Unlock()
+
+	sort.Slice(samples, func(i, j int) bool {
+		return strings.Join(samples[i].labelValues, "\xff") < strings.Join(samples[j].labelValues, "\xff")
+	})
+
+	h.header(w)
+	for _, s := range samples {
+		for i, b := range h.buckets {
+			writeSample(w, h.name+"_bucket", h.labels, s.labelValues, "le", formatFloat(b), float64(s.counts[i]))
+		}
+		writeSample(w, h.name+"_bucket", h.labels, s.labelValues, "le", "+Inf", float64(s.count))
+		writeSample(w, h.name+"_sum", h.labels, s.labelValues, "", "", s.sum)
+		writeSample(w, h.name+"_count", h.labels, s.labelValues, "", "", float64(s.count))
+	}
+}
+
+func sortSamples(samples []sample) {
+	sort.Slice(samples, func(i, j int) bool {
+		return strings.Join(samples[i].labelValues, "\xff") < strings.Join(samples[j].labelValues, "\xff")
+	})
+}
+
+func writeSample(w io.Writer, name string, labels, labelValues []string, extraLabel, extraValue string, value float64) {
+	pairs := make([]string, 0, len(labels)+1)
+	for i, l := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", l, escapeLabelValue(labelValues[i])))
+	}
+	if extraLabel != "" {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", extraLabel, extraValue))
+	}
+	if len(pairs) > 0 {
+		fmt.Fprintf(w, "%s{%s} %s\n", name, strings.Join(pairs, ","), formatFloat(value))
+	} else {
+		fmt.Fprintf(w, "%s %s\n", name, formatFloat(value))
+	}
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpReplacer  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpReplacer.Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return labelReplacer.Replace(s)
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func collect(c Collector) string {
+	var buf bytes.Buffer
+	c.Collect(&buf)
+	return buf.String()
+}
+
+func TestCounterVec(t *testing.T) {
+	c := NewCounterVec("requests_total", "Total requests.", "method", "code")
+	c.Inc("GET", "200")
+	c.Inc("GET", "200")
+	c.Add(3, "POST", "500")
+
+	expected := `# HELP requests_total Total requests.
+# TYPE requests_total counter
+requests_total{method="GET",code="200"} 2
+requests_total{method="POST",code="500"} 3
+`
+	if out := collect(c); out != expected {
+		t.Fatalf("unexpected counter output:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+func TestGaugeFunc(t *testing.T) {
+	g := NewGaugeFunc("pods", "Pods by status.", []string{"status"}, func(emit func(float64, ...string)) {
+		emit(2, "running")
+		emit(1, "a \"quoted\"\nvalue")
+	})
+
+	expected := `# HELP pods Pods by status.
+# TYPE pods gauge
+pods{status="a \"quoted\"\nvalue"} 1
+pods{status="running"} 2
+`
+	if out := collect(g); out != expected {
+		t.Fatalf("unexpected gauge output:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+func TestHistogramVec(t *testing.T) {
+	h := NewHistogramVec("latency_seconds", "Latency.", []float64{1, 0.1})
+	h.Observe(0.05)
+	h.Observe(0.5)
+	h.Observe(2)
+
+	expected := `# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 1
+latency_seconds_bucket{le="1"} 2
+latency_seconds_bucket{le="+Inf"} 3
+latency_seconds_sum 2.55
+latency_seconds_count 3
+`
+	if out := collect(h); out != expected {
+		t.Fatalf("unexpected histogram output:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+func TestLabelCountMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic on label count mismatch")
+		}
+	}()
+	NewGaugeVec("g", "help", "a").Set(1)
+}
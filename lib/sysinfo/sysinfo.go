@@ -1,5 +1,7 @@
 package sysinfo
 
+import "runtime"
+
 type CpuInfo struct {
 	Processor       uint64
 	Vender_id       string
@@ -87,6 +89,11 @@ type OSInfo struct {
 	BugURL     string
 }
 
+// GetCpuNum returns the number of logical cpus of the host.
+func GetCpuNum() int {
+	return runtime.NumCPU()
+}
+
 func GetCpuInfo() (*CpuInfo, error) {
 	return getCpuInfo()
 }
@@ -0,0 +1,121 @@
+package bandwidth
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	// the burst is the traffic of 10ms at the rate, if not configured,
+	// but no less than minBurst
+	burstPerRate = 800
+	minBurst     = 32768
+	// the latency of the packets queued in tbf
+	latency = "25ms"
+)
+
+var (
+	tcPath        string
+	ErrTcNotFound = errors.New("tc not found")
+
+	// run executes tc with the args, and returns the output
+	run = func(args ...string) ([]byte, error) {
+		if tcPath == "" {
+			path, err := exec.LookPath("tc")
+			if err != nil {
+				return nil, ErrTcNotFound
+			}
+			tcPath = path
+		}
+		hlog.Log(hlog.DEBUG, "%s, %v", tcPath, args)
+		output, err := exec.Command(tcPath, args...).CombinedOutput()
+		if err != nil {
+			return output, fmt.Errorf("tc %s failed: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(string(output)))
+		}
+		return output, nil
+	}
+)
+
+// Set shapes the traffic of the host device of a pod interface: the
+// traffic to the pod, transmitted by the device, is shaped by a tbf root
+// qdisc, and the traffic from the pod, received by the device, is policed
+// on the ingress qdisc. The limits of the directions with a rate of 0 are
+// removed.
+func Set(dev string, bw *apitypes.InterfaceBandwidth) error {
+	qdiscs, err := run("qdisc", "show", "dev", dev)
+	if err != nil {
+		return err
+	}
+	hasRoot, hasIngress := parseQdiscs(string(qdiscs))
+
+	if rate := bw.GetIngressRate(); rate > 0 {
+		if _, err = run("qdisc", "replace", "dev", dev, "root", "tbf",
+			"rate", rateArg(rate), "burst", burstArg(rate, bw.GetIngressBurst()), "latency", latency); err != nil {
+			return err
+		}
+	} else if hasRoot {
+		if _, err = run("qdisc", "del", "dev", dev, "root"); err != nil {
+			return err
+		}
+	}
+
+	// the filter is replaced with the ingress qdisc
+	if hasIngress {
+		if _, err = run("qdisc", "del", "dev", dev, "ingress"); err != nil {
+			return err
+		}
+	}
+	if rate := bw.GetEgressRate(); rate > 0 {
+		if _, err = run("qdisc", "add", "dev", dev, "handle", "ffff:", "ingress"); err != nil {
+			return err
+		}
+		if _, err = run("filter", "add", "dev", dev, "parent", "ffff:", "protocol", "all", "prio", "1",
+			"u32", "match", "u32", "0", "0",
+			"police", "rate", rateArg(rate), "burst", burstArg(rate, bw.GetEgressBurst()), "drop", "flowid", ":1"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsZero returns whether the traffic is not limited at all.
+func IsZero(bw *apitypes.InterfaceBandwidth) bool {
+	return bw.GetIngressRate() == 0 && bw.GetEgressRate() == 0
+}
+
+// parseQdiscs returns whether the device has a root qdisc other than the
+// default one, whose handle is 0:, and an ingress qdisc.
+func parseQdiscs(output string) (root, ingress bool) {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[0] != "qdisc" {
+			continue
+		}
+		switch {
+		case fields[1] == "ingress":
+			ingress = true
+		case strings.Contains(line, " root") && fields[2] != "0:":
+			root = true
+		}
+	}
+	return
+}
+
+func rateArg(rate uint64) string {
+	return fmt.Sprintf("%dbit", rate)
+}
+
+func burstArg(rate, burst uint64) string {
+	if burst == 0 {
+		burst = rate / burstPerRate
+		if burst < minBurst {
+			burst = minBurst
+		}
+	}
+	return fmt.Sprintf("%d", burst)
+}
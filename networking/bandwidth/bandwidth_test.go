@@ -0,0 +1,51 @@
+package bandwidth
+
+import (
+	"strings"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestSet(t *testing.T) {
+	var (
+		qdiscs   string
+		commands []string
+	)
+	orig := run
+	run = func(args ...string) ([]byte, error) {
+		if args[0] == "qdisc" && args[1] == "show" {
+			return []byte(qdiscs), nil
+		}
+		commands = append(commands, strings.Join(args, " "))
+		return nil, nil
+	}
+	defer func() { run = orig }()
+
+	qdiscs = "qdisc fq_codel 0: root refcnt 2 limit 10240p flows 1024\n"
+	err := Set("tap0", &apitypes.InterfaceBandwidth{IngressRate: 10000000, EgressRate: 80000000, EgressBurst: 65536})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"qdisc replace dev tap0 root tbf rate 10000000bit burst 32768 latency 25ms",
+		"qdisc add dev tap0 handle ffff: ingress",
+		"filter add dev tap0 parent ffff: protocol all prio 1 u32 match u32 0 0 police rate 80000000bit burst 65536 drop flowid :1",
+	}
+	if strings.Join(commands, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("incorrect commands:\n%s", strings.Join(commands, "\n"))
+	}
+
+	qdiscs = "qdisc tbf 8001: root refcnt 2 rate 10Mbit burst 32Kb lat 25.0ms\nqdisc ingress ffff: parent ffff:fff1 ----------------\n"
+	commands = nil
+	if err = Set("tap0", nil); err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{
+		"qdisc del dev tap0 root",
+		"qdisc del dev tap0 ingress",
+	}
+	if strings.Join(commands, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("incorrect commands on removing the limits:\n%s", strings.Join(commands, "\n"))
+	}
+}
@@ -0,0 +1,308 @@
+package cni
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+)
+
+const (
+	DefaultConfDir = "/etc/cni/net.d"
+	DefaultBinDir  = "/opt/cni/bin"
+
+	// the CNI version of the plugin configurations without one
+	defaultCniVersion = "0.3.1"
+)
+
+var (
+	lock     sync.RWMutex
+	enabled  bool
+	confDir  string
+	binDirs  = []string{DefaultBinDir}
+	confList *ConfList
+)
+
+// ConfList is a network configuration list, a single network
+// configuration is wrapped into a list of one plugin.
+type ConfList struct {
+	Name       string
+	CniVersion string
+	Plugins    []map[string]interface{}
+	// the list in JSON, persisted with the interfaces to replay DEL
+	Bytes []byte
+}
+
+// Setup enables the CNI mode, the first network configuration in confDir,
+// in the lexicographical order of the file names, is used for the pod
+// interfaces, and the plugins are searched in binDir, which could be a
+// colon separated list of directories.
+func Setup(conf, bin string) error {
+	if conf == "" {
+		conf = DefaultConfDir
+	}
+	if bin == "" {
+		bin = DefaultBinDir
+	}
+
+	list, err := loadConfList(conf)
+	if err != nil {
+		hlog.Log(hlog.ERROR, "failed to load the CNI network configuration in %s: %v", conf, err)
+		return err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	enabled = true
+	confDir = conf
+	binDirs = filepath.SplitList(bin)
+	confList = list
+	hlog.Log(hlog.INFO, "CNI network %s is loaded from %s, %d plugins", list.Name, conf, len(list.Plugins))
+	return nil
+}
+
+// Enabled tells whether the interfaces are set up with the CNI plugins.
+func Enabled() bool {
+	lock.RLock()
+	defer lock.RUnlock()
+	return enabled
+}
+
+// Network returns the network configuration list of the pod interfaces.
+func Network() *ConfList {
+	lock.RLock()
+	defer lock.RUnlock()
+	return confList
+}
+
+func loadConfList(dir string) (*ConfList, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, f := range files {
+		switch filepath.Ext(f.Name()) {
+		case ".conflist", ".conf", ".json":
+			if !f.IsDir() {
+				names = append(names, f.Name())
+			}
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		list, err := ParseConfList(data)
+		if err != nil {
+			hlog.Log(hlog.WARNING, "skip invalid CNI configuration %s: %v", name, err)
+			continue
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("no CNI network configuration found in %s", dir)
+}
+
+// ParseConfList parses a network configuration list, or a single network
+// configuration.
+func ParseConfList(data []byte) (*ConfList, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	list := &ConfList{}
+	list.Name, _ = raw["name"].(string)
+	list.CniVersion, _ = raw["cniVersion"].(string)
+	if list.Name == "" {
+		return nil, fmt.Errorf("the network name is missing")
+	}
+	if list.CniVersion == "" {
+		list.CniVersion = defaultCniVersion
+	}
+
+	if plugins, ok := raw["plugins"]; ok {
+		items, ok := plugins.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the plugins of network %s should be a list", list.Name)
+		}
+		for _, item := range items {
+			plugin, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("incorrect plugin %v of network %s", item, list.Name)
+			}
+			list.Plugins = append(list.Plugins, plugin)
+		}
+	} else {
+		list.Plugins = []map[string]interface{}{raw}
+	}
+	if len(list.Plugins) == 0 {
+		return nil, fmt.Errorf("network %s has no plugin", list.Name)
+	}
+	for _, plugin := range list.Plugins {
+		if t, _ := plugin["type"].(string); t == "" {
+			return nil, fmt.Errorf("a plugin of network %s has no type", list.Name)
+		}
+	}
+
+	var err error
+	list.Bytes, err = json.Marshal(map[string]interface{}{
+		"name":       list.Name,
+		"cniVersion": list.CniVersion,
+		"plugins":    list.Plugins,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Args are the runtime arguments of a plugin invocation.
+type Args struct {
+	ContainerID string
+	NetNS       string
+	IfName      string
+	// the runtime config passed to the plugins declaring the capabilities,
+	// could be nil
+	RuntimeConfig *RuntimeConfig
+}
+
+// RuntimeConfig is the dynamic configuration of the interface, each key is
+// passed in the runtimeConfig of the plugins having it in capabilities.
+type RuntimeConfig struct {
+	PortMappings []PortMapping `json:"portMappings,omitempty"`
+}
+
+// PortMapping is a host port forwarded to the port of the pod.
+type PortMapping struct {
+	HostPort      int    `json:"hostPort"`
+	ContainerPort int    `json:"containerPort"`
+	Protocol      string `json:"protocol"`
+	HostIP        string `json:"hostIP,omitempty"`
+}
+
+// pluginRuntimeConfig returns the keys of rc enabled in the capabilities
+// of the plugin, or nil if there is none.
+func pluginRuntimeConfig(plugin map[string]interface{}, rc *RuntimeConfig) map[string]interface{} {
+	caps, _ := plugin["capabilities"].(map[string]interface{})
+	if len(caps) == 0 || rc == nil {
+		return nil
+	}
+	result := map[string]interface{}{}
+	if enabled, _ := caps["portMappings"].(bool); enabled && len(rc.PortMappings) > 0 {
+		result["portMappings"] = rc.PortMappings
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// Add invokes ADD of the plugins in order, and returns the result of the
+// last plugin in JSON.
+func (l *ConfList) Add(args *Args) ([]byte, error) {
+	var result []byte
+	for _, plugin := range l.Plugins {
+		out, err := l.invoke("ADD", plugin, args, result)
+		if err != nil {
+			return nil, err
+		}
+		result = out
+	}
+	return result, nil
+}
+
+// Del invokes DEL of the plugins in the reverse order, result is the
+// result of ADD, and could be nil. All the plugins are invoked, and the
+// first error is returned.
+func (l *ConfList) Del(args *Args, result []byte) error {
+	var first error
+	for i := len(l.Plugins) - 1; i >= 0; i-- {
+		if _, err := l.invoke("DEL", l.Plugins[i], args, result); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// pluginError is the error reported by a plugin on its stdout.
+type pluginError struct {
+	Code    int    `json:"code"`
+	Msg     string `json:"msg"`
+	Details string `json:"details,omitempty"`
+}
+
+func (l *ConfList) invoke(command string, plugin map[string]interface{}, args *Args, prevResult []byte) ([]byte, error) {
+	conf := make(map[string]interface{}, len(plugin)+3)
+	for k, v := range plugin {
+		conf[k] = v
+	}
+	conf["name"] = l.Name
+	conf["cniVersion"] = l.CniVersion
+	if prevResult != nil {
+		conf["prevResult"] = json.RawMessage(prevResult)
+	}
+	if rc := pluginRuntimeConfig(plugin, args.RuntimeConfig); rc != nil {
+		conf["runtimeConfig"] = rc
+	}
+	stdin, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	pluginType, _ := plugin["type"].(string)
+	path, err := findPlugin(pluginType)
+	if err != nil {
+		return nil, err
+	}
+
+	lock.RLock()
+	cniPath := strings.Join(binDirs, string(os.PathListSeparator))
+	lock.RUnlock()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(path)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(),
+		"CNI_COMMAND="+command,
+		"CNI_CONTAINERID="+args.ContainerID,
+		"CNI_NETNS="+args.NetNS,
+		"CNI_IFNAME="+args.IfName,
+		"CNI_ARGS=",
+		"CNI_PATH="+cniPath,
+	)
+	hlog.Log(hlog.DEBUG, "CNI %s of %s for %s/%s: %s", command, pluginType, args.ContainerID, args.IfName, stdin)
+	if err := cmd.Run(); err != nil {
+		var perr pluginError
+		if json.Unmarshal(stdout.Bytes(), &perr) == nil && perr.Msg != "" {
+			return nil, fmt.Errorf("CNI plugin %s %s failed: %s %s", pluginType, command, perr.Msg, perr.Details)
+		}
+		return nil, fmt.Errorf("CNI plugin %s %s failed: %v: %s", pluginType, command, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+func findPlugin(name string) (string, error) {
+	lock.RLock()
+	dirs := binDirs
+	lock.RUnlock()
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		if fi, err := os.Stat(path); err == nil && !fi.IsDir() {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("CNI plugin %s is not found in %v", name, dirs)
+}
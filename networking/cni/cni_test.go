@@ -0,0 +1,152 @@
+package cni
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseConfList(t *testing.T) {
+	list, err := ParseConfList([]byte(`{"cniVersion":"0.3.1","name":"net","type":"bridge","bridge":"cni0"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Name != "net" || len(list.Plugins) != 1 || list.Plugins[0]["type"] != "bridge" {
+		t.Fatalf("incorrect conf list: %#v", list)
+	}
+
+	list, err = ParseConfList([]byte(`{"name":"net","plugins":[{"type":"bridge"},{"type":"portmap"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.CniVersion != defaultCniVersion || len(list.Plugins) != 2 {
+		t.Fatalf("incorrect conf list: %#v", list)
+	}
+	if _, err = ParseConfList(list.Bytes); err != nil {
+		t.Fatalf("failed to parse the saved conf list: %v", err)
+	}
+
+	for _, data := range []string{
+		`{"type":"bridge"}`,
+		`{"name":"net","plugins":[]}`,
+		`{"name":"net","plugins":[{"bridge":"cni0"}]}`,
+	} {
+		if _, err := ParseConfList([]byte(data)); err == nil {
+			t.Errorf("%s should be refused", data)
+		}
+	}
+}
+
+func TestParseResult(t *testing.T) {
+	r, err := ParseResult([]byte(`{
+		"cniVersion": "0.3.1",
+		"interfaces": [{"name": "cni0"}, {"name": "veth1"}, {"name": "eth1", "sandbox": "/proc/1/fd/5"}],
+		"ips": [{"version": "4", "interface": 2, "address": "10.22.0.5/16", "gateway": "10.22.0.1"}],
+		"routes": [{"dst": "0.0.0.0/0"}, {"dst": "fd00::/8"}],
+		"dns": {"nameservers": ["10.22.0.10"], "search": ["svc.local"]}
+	}`), "eth1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.IP != "10.22.0.5/16" || r.DefaultGateway() != "10.22.0.1" || len(r.Routes) != 1 {
+		t.Fatalf("incorrect result: %#v", r)
+	}
+	if r.ResolvConf() != "nameserver 10.22.0.10\nsearch svc.local\n" {
+		t.Fatalf("incorrect resolv.conf: %q", r.ResolvConf())
+	}
+
+	r, err = ParseResult([]byte(`{"ip4": {"ip": "10.1.0.2/24", "gateway": "10.1.0.1", "routes": [{"dst": "0.0.0.0/0", "gw": "10.1.0.254"}]}}`), "eth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.IP != "10.1.0.2/24" || r.DefaultGateway() != "10.1.0.254" || r.ResolvConf() != "" {
+		t.Fatalf("incorrect legacy result: %#v", r)
+	}
+
+	if _, err = ParseResult([]byte(`{"cniVersion": "0.3.1", "ips": [{"version": "6", "address": "fd00::2/64"}]}`), "eth0"); err == nil {
+		t.Fatal("result without IPv4 address should be refused")
+	}
+}
+
+func TestInvokePlugins(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cni-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the plugin logs the command, and returns the address on ADD
+	log := filepath.Join(dir, "log")
+	plugin := "#!/bin/sh\n" +
+		"echo \"$CNI_COMMAND $CNI_CONTAINERID $CNI_IFNAME $0\" >> " + log + "\n" +
+		"if [ \"$CNI_COMMAND\" = ADD ]; then echo '{\"ips\":[{\"version\":\"4\",\"address\":\"10.1.0.2/24\"}]}'; fi\n"
+	failing := "#!/bin/sh\necho '{\"code\":11,\"msg\":\"no address\"}'\nexit 1\n"
+	for name, script := range map[string]string{"first": plugin, "second": plugin, "failing": failing} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(script), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	binDirs = []string{dir}
+	defer func() { binDirs = []string{DefaultBinDir} }()
+
+	list, err := ParseConfList([]byte(`{"name":"net","plugins":[{"type":"first"},{"type":"second"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := &Args{ContainerID: "pod", IfName: "eth0"}
+	result, err := list.Add(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(result), "10.1.0.2/24") {
+		t.Fatalf("incorrect result: %s", result)
+	}
+	if err = list.Del(args, result); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadFile(log)
+	expected := "ADD pod eth0 " + dir + "/first\nADD pod eth0 " + dir + "/second\n" +
+		"DEL pod eth0 " + dir + "/second\nDEL pod eth0 " + dir + "/first\n"
+	if string(data) != expected {
+		t.Fatalf("incorrect invocations:\n%s", data)
+	}
+
+	list, _ = ParseConfList([]byte(`{"name":"net","type":"failing"}`))
+	if _, err = list.Add(args); err == nil || !strings.Contains(err.Error(), "no address") {
+		t.Fatalf("the error of the plugin is not reported: %v", err)
+	}
+	list, _ = ParseConfList([]byte(`{"name":"net","type":"missing"}`))
+	if _, err = list.Add(args); err == nil {
+		t.Fatal("missing plugin should fail")
+	}
+}
+
+func TestPluginRuntimeConfig(t *testing.T) {
+	rc := &RuntimeConfig{PortMappings: []PortMapping{{HostPort: 8080, ContainerPort: 80, Protocol: "tcp"}}}
+	for _, c := range []struct {
+		plugin   string
+		expected string
+	}{
+		{`{"type":"bridge"}`, `null`},
+		{`{"type":"portmap","capabilities":{"portMappings":false}}`, `null`},
+		{`{"type":"portmap","capabilities":{"portMappings":true}}`,
+			`{"portMappings":[{"hostPort":8080,"containerPort":80,"protocol":"tcp"}]}`},
+	} {
+		var plugin map[string]interface{}
+		if err := json.Unmarshal([]byte(c.plugin), &plugin); err != nil {
+			t.Fatal(err)
+		}
+		data, _ := json.Marshal(pluginRuntimeConfig(plugin, rc))
+		if string(data) != c.expected {
+			t.Fatalf("incorrect runtime config of %s: %s", c.plugin, data)
+		}
+	}
+	var plugin map[string]interface{}
+	json.Unmarshal([]byte(`{"type":"portmap","capabilities":{"portMappings":true}}`), &plugin)
+	if pluginRuntimeConfig(plugin, nil) != nil {
+		t.Fatal("no runtime config without the port mappings")
+	}
+}
@@ -0,0 +1,207 @@
+package cni
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+)
+
+const (
+	vethPrefix   = "hcv"
+	bridgePrefix = "hcb"
+)
+
+// Endpoint is an interface set up by the plugins. The plugins create the
+// interface in a temporary network namespace, which is moved back to the
+// host and enslaved to a bridge of its own, where the tap of the VM is
+// added to.
+type Endpoint struct {
+	ContainerID string
+	IfName      string
+	// the network configuration list the plugins were invoked with
+	Config []byte
+	// the result of ADD
+	Result []byte
+	// the runtime config in JSON, DEL is invoked with it too
+	RuntimeConfig []byte
+	HostVeth      string
+	Bridge        string
+}
+
+// Setup invokes ADD of the plugins for the interface ifname of the
+// container with the runtime config rc, which could be nil, and returns the
+// endpoint and its address.
+func (l *ConfList) Setup(containerID, ifname string, rc *RuntimeConfig) (*Endpoint, *Result, error) {
+	ns, err := newNetNS()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create network namespace: %v", err)
+	}
+	defer ns.Close()
+
+	args := &Args{
+		ContainerID:   containerID,
+		NetNS:         fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), int(ns)),
+		IfName:        ifname,
+		RuntimeConfig: rc,
+	}
+	var rcBytes []byte
+	if rc != nil {
+		if rcBytes, err = json.Marshal(rc); err != nil {
+			return nil, nil, err
+		}
+	}
+	data, err := l.Add(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ep := &Endpoint{
+		ContainerID:   containerID,
+		IfName:        ifname,
+		Config:        l.Bytes,
+		Result:        data,
+		RuntimeConfig: rcBytes,
+		HostVeth:      linkName(vethPrefix, containerID, ifname),
+		Bridge:        linkName(bridgePrefix, containerID, ifname),
+	}
+	result, err := ParseResult(data, ifname)
+	if err == nil {
+		err = ep.attach(ns)
+	}
+	if err != nil {
+		if derr := ep.Teardown(); derr != nil {
+			hlog.Log(hlog.WARNING, "failed to tear down CNI interface %s of %s: %v", ifname, containerID, derr)
+		}
+		return nil, nil, err
+	}
+	return ep, result, nil
+}
+
+// Teardown invokes DEL of the plugins with the result of ADD, and removes
+// the devices of the endpoint. The namespace was gone, so the plugins are
+// invoked without one.
+func (ep *Endpoint) Teardown() error {
+	list, err := ParseConfList(ep.Config)
+	if err != nil {
+		return fmt.Errorf("incorrect CNI configuration of %s: %v", ep.IfName, err)
+	}
+	args := &Args{ContainerID: ep.ContainerID, IfName: ep.IfName}
+	if len(ep.RuntimeConfig) > 0 {
+		args.RuntimeConfig = &RuntimeConfig{}
+		if err = json.Unmarshal(ep.RuntimeConfig, args.RuntimeConfig); err != nil {
+			return fmt.Errorf("incorrect CNI runtime config of %s: %v", ep.IfName, err)
+		}
+	}
+	err = list.Del(args, ep.Result)
+
+	// the peer of the veth is removed together
+	for _, name := range []string{ep.HostVeth, ep.Bridge} {
+		if link, lerr := netlink.LinkByName(name); lerr == nil {
+			if lerr = netlink.LinkDel(link); lerr != nil {
+				hlog.Log(hlog.WARNING, "failed to delete %s: %v", name, lerr)
+			}
+		}
+	}
+	return err
+}
+
+// attach moves the interface created by the plugins out of the namespace,
+// the addresses are flushed as they belong to the VM now.
+func (ep *Endpoint) attach(ns netns.NsHandle) error {
+	host, err := netns.GetFromPid(os.Getpid())
+	if err != nil {
+		return err
+	}
+	defer host.Close()
+
+	h, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		return err
+	}
+	defer h.Delete()
+
+	link, err := h.LinkByName(ep.IfName)
+	if err != nil {
+		return fmt.Errorf("interface %s is not created by the plugins: %v", ep.IfName, err)
+	}
+	if err = h.LinkSetDown(link); err != nil {
+		return err
+	}
+	addrs, err := h.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return err
+	}
+	for i := range addrs {
+		if err = h.AddrDel(link, &addrs[i]); err != nil {
+			return err
+		}
+	}
+	if err = h.LinkSetName(link, ep.HostVeth); err != nil {
+		return err
+	}
+	if err = h.LinkSetNsFd(link, int(host)); err != nil {
+		return err
+	}
+
+	veth, err := netlink.LinkByName(ep.HostVeth)
+	if err != nil {
+		return err
+	}
+	// a stale bridge left by a crash
+	if stale, err := netlink.LinkByName(ep.Bridge); err == nil {
+		netlink.LinkDel(stale)
+	}
+	la := netlink.NewLinkAttrs()
+	la.Name = ep.Bridge
+	la.MTU = veth.Attrs().MTU
+	br := &netlink.Bridge{LinkAttrs: la}
+	if err = netlink.LinkAdd(br); err != nil {
+		return fmt.Errorf("failed to create bridge %s: %v", ep.Bridge, err)
+	}
+	if err = netlink.LinkSetMaster(veth, br); err != nil {
+		return err
+	}
+	if err = netlink.LinkSetUp(veth); err != nil {
+		return err
+	}
+	return netlink.LinkSetUp(br)
+}
+
+// newNetNS creates a network namespace without entering it, the namespace
+// lives until the handle is closed.
+func newNetNS() (netns.NsHandle, error) {
+	runtime.LockOSThread()
+
+	orig, err := netns.Get()
+	if err != nil {
+		runtime.UnlockOSThread()
+		return netns.None(), err
+	}
+	defer orig.Close()
+
+	ns, err := netns.New()
+	if err != nil {
+		runtime.UnlockOSThread()
+		return netns.None(), err
+	}
+	if err = netns.Set(orig); err != nil {
+		// keep the thread locked, it exits with the goroutine rather than
+		// running others in the namespace
+		ns.Close()
+		return netns.None(), err
+	}
+	runtime.UnlockOSThread()
+	return ns, nil
+}
+
+// linkName returns a name of the host device unique to the interface.
+func linkName(prefix, containerID, ifname string) string {
+	sum := sha1.Sum([]byte(containerID + "/" + ifname))
+	return fmt.Sprintf("%s%x", prefix, sum[:6])
+}
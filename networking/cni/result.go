@@ -0,0 +1,140 @@
+package cni
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Result is the address, the routes and the DNS of an interface, from the
+// result of ADD.
+type Result struct {
+	// the address in CIDR format
+	IP      string
+	Gateway string
+	Routes  []Route
+	DNS     DNS
+}
+
+type Route struct {
+	Dst string `json:"dst"`
+	GW  string `json:"gw,omitempty"`
+}
+
+type DNS struct {
+	Nameservers []string `json:"nameservers,omitempty"`
+	Domain      string   `json:"domain,omitempty"`
+	Search      []string `json:"search,omitempty"`
+	Options     []string `json:"options,omitempty"`
+}
+
+// the result of the CNI version 0.3.0 and later
+type currentResult struct {
+	Interfaces []struct {
+		Name    string `json:"name"`
+		Sandbox string `json:"sandbox,omitempty"`
+	} `json:"interfaces,omitempty"`
+	IPs []struct {
+		Version   string `json:"version"`
+		Interface *int   `json:"interface,omitempty"`
+		Address   string `json:"address"`
+		Gateway   string `json:"gateway,omitempty"`
+	} `json:"ips,omitempty"`
+	Routes []Route `json:"routes,omitempty"`
+	DNS    DNS     `json:"dns,omitempty"`
+}
+
+// the result of the CNI version 0.1.0 and 0.2.0
+type legacyResult struct {
+	IP4 *struct {
+		IP      string  `json:"ip"`
+		Gateway string  `json:"gateway,omitempty"`
+		Routes  []Route `json:"routes,omitempty"`
+	} `json:"ip4,omitempty"`
+	DNS DNS `json:"dns,omitempty"`
+}
+
+// ParseResult gets the IPv4 address of the interface ifname in the
+// sandbox from the result of ADD.
+func ParseResult(data []byte, ifname string) (*Result, error) {
+	var cur currentResult
+	if err := json.Unmarshal(data, &cur); err != nil {
+		return nil, fmt.Errorf("failed to parse CNI result: %v", err)
+	}
+	if len(cur.IPs) == 0 {
+		var legacy legacyResult
+		if err := json.Unmarshal(data, &legacy); err == nil && legacy.IP4 != nil {
+			return &Result{
+				IP:      legacy.IP4.IP,
+				Gateway: legacy.IP4.Gateway,
+				Routes:  legacy.IP4.Routes,
+				DNS:     legacy.DNS,
+			}, nil
+		}
+		return nil, fmt.Errorf("CNI result has no ip address: %s", data)
+	}
+
+	for _, ip := range cur.IPs {
+		addr, _, err := net.ParseCIDR(ip.Address)
+		if err != nil || addr.To4() == nil {
+			continue
+		}
+		// the ip of the interface in the sandbox, or of no interface
+		if ip.Interface != nil && *ip.Interface >= 0 && *ip.Interface < len(cur.Interfaces) {
+			inf := cur.Interfaces[*ip.Interface]
+			if inf.Sandbox == "" || inf.Name != ifname {
+				continue
+			}
+		}
+		r := &Result{
+			IP:      ip.Address,
+			Gateway: ip.Gateway,
+			DNS:     cur.DNS,
+		}
+		for _, route := range cur.Routes {
+			if dst, _, err := net.ParseCIDR(route.Dst); err == nil && dst.To4() != nil {
+				r.Routes = append(r.Routes, route)
+			}
+		}
+		return r, nil
+	}
+	return nil, fmt.Errorf("CNI result has no IPv4 address of %s: %s", ifname, data)
+}
+
+// DefaultGateway returns the gateway of the default route, or the gateway
+// of the address.
+func (r *Result) DefaultGateway() string {
+	for _, route := range r.Routes {
+		if route.Dst == "0.0.0.0/0" && route.GW != "" {
+			return route.GW
+		}
+	}
+	for _, route := range r.Routes {
+		if route.Dst == "0.0.0.0/0" {
+			return r.Gateway
+		}
+	}
+	return ""
+}
+
+// ResolvConf returns the content of resolv.conf with the DNS of the
+// result, or an empty string if there is no nameserver.
+func (r *Result) ResolvConf() string {
+	if len(r.DNS.Nameservers) == 0 {
+		return ""
+	}
+	lines := []string{}
+	for _, ns := range r.DNS.Nameservers {
+		lines = append(lines, "nameserver "+ns)
+	}
+	if len(r.DNS.Search) > 0 {
+		lines = append(lines, "search "+strings.Join(r.DNS.Search, " "))
+	} else if r.DNS.Domain != "" {
+		lines = append(lines, "domain "+r.DNS.Domain)
+	}
+	if len(r.DNS.Options) > 0 {
+		lines = append(lines, "options "+strings.Join(r.DNS.Options, " "))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
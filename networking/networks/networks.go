@@ -0,0 +1,432 @@
+package networks
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/errors"
+	"github.com/hyperhq/hyperd/networking/portmapping/iptables"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor/network"
+	"github.com/hyperhq/runv/hypervisor/network/ipallocator"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	bridgePrefix = "hy-"
+	// the traffic forwarded between the hyperd bridges, including the
+	// default one, is dropped by the isolation chains, which are jumped to
+	// ahead of the rules accepting the traffic of the bridges
+	isolationChain  = "HYPER-ISOLATION"
+	isolationChain2 = "HYPER-ISOLATION-2"
+)
+
+// Network is a named network with its own bridge and address allocator.
+type Network struct {
+	spec    *apitypes.Network
+	subnet  *net.IPNet
+	gateway net.IP
+
+	lock      sync.Mutex
+	allocator *ipallocator.IPAllocator
+	// the endpoints by the allocated ip
+	endpoints map[string]*apitypes.NetworkEndpoint
+}
+
+var (
+	lock            sync.RWMutex
+	networks        = make(map[string]*Network)
+	disableIptables bool
+)
+
+// SetDisableIptables enables or disables the masquerade and isolation
+// rules of the networks.
+func SetDisableIptables(disable bool) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if disable == disableIptables {
+		return nil
+	}
+	action := iptables.Delete
+	if !disable {
+		action = iptables.Insert
+	}
+	for _, n := range networks {
+		if err := n.setupIptables(action); err != nil {
+			return err
+		}
+	}
+	if !disable && len(networks) > 0 {
+		if err := setupIsolationChains(); err != nil {
+			return err
+		}
+	}
+	disableIptables = disable
+	return nil
+}
+
+// Create sets up the bridge of the network, and registers it. The spec is
+// validated and filled with the defaults.
+func Create(spec *apitypes.Network) (*Network, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	if spec.Bridge == "" {
+		spec.Bridge = bridgePrefix + spec.Name
+		if len(spec.Bridge) > 15 {
+			spec.Bridge = spec.Bridge[:15]
+		}
+	}
+
+	_, subnet, _ := net.ParseCIDR(spec.Subnet)
+	n := &Network{
+		spec:      spec,
+		subnet:    subnet,
+		gateway:   net.ParseIP(spec.Gateway).To4(),
+		allocator: ipallocator.New(),
+		endpoints: make(map[string]*apitypes.NetworkEndpoint),
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := n.checkConflict(); err != nil {
+		return nil, err
+	}
+	if _, err := n.allocator.RequestIP(n.subnet, n.gateway); err != nil {
+		return nil, err
+	}
+	if err := n.setupBridge(); err != nil {
+		hlog.Log(hlog.ERROR, "failed to set up bridge %s of network %s: %v", spec.Bridge, spec.Name, err)
+		return nil, err
+	}
+	if !disableIptables {
+		err := n.setupIptables(iptables.Insert)
+		if err == nil {
+			err = setupIsolationChains()
+		}
+		if err != nil {
+			hlog.Log(hlog.ERROR, "%v", err)
+			n.setupIptables(iptables.Delete)
+			n.deleteBridge()
+			return nil, err
+		}
+	}
+
+	networks[spec.Name] = n
+	hlog.Log(hlog.INFO, "network %s is set up on bridge %s, subnet %s", spec.Name, spec.Bridge, spec.Subnet)
+	return n, nil
+}
+
+// checkConflict refuses the network overlapping the default bridge or the
+// other networks, the lock should be held.
+func (n *Network) checkConflict() error {
+	if _, ok := networks[n.spec.Name]; ok {
+		return fmt.Errorf("network %s already exists", n.spec.Name)
+	}
+	if n.spec.Bridge == network.BridgeIface {
+		return fmt.Errorf("bridge %s is the default bridge", n.spec.Bridge)
+	}
+	if overlap(n.subnet, network.BridgeIPv4Net) {
+		return fmt.Errorf("subnet %s overlaps the default bridge %s", n.subnet, network.BridgeIPv4Net)
+	}
+	for _, o := range networks {
+		if o.spec.Bridge == n.spec.Bridge {
+			return fmt.Errorf("bridge %s is used by network %s", n.spec.Bridge, o.spec.Name)
+		}
+		if overlap(n.subnet, o.subnet) {
+			return fmt.Errorf("subnet %s overlaps network %s (%s)", n.subnet, o.spec.Name, o.subnet)
+		}
+	}
+	return nil
+}
+
+func overlap(a, b *net.IPNet) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// setupBridge creates the bridge, or checks the address of the existing
+// one, e.g. the bridge of a restored network.
+func (n *Network) setupBridge() error {
+	addr := &net.IPNet{IP: n.gateway, Mask: n.subnet.Mask}
+	br, err := netlink.LinkByName(n.spec.Bridge)
+	if err == nil {
+		addrs, err := netlink.AddrList(br, netlink.FAMILY_V4)
+		if err != nil {
+			return err
+		}
+		for _, a := range addrs {
+			if a.IPNet.String() == addr.String() {
+				return n.setBridgeMtu(br)
+			}
+		}
+		return fmt.Errorf("bridge %s exists without address %s", n.spec.Bridge, addr)
+	}
+
+	la := netlink.NewLinkAttrs()
+	la.Name = n.spec.Bridge
+	br = &netlink.Bridge{LinkAttrs: la}
+	if err := netlink.LinkAdd(br); err != nil && !os.IsExist(err) {
+		return err
+	}
+	if err := netlink.AddrAdd(br, &netlink.Addr{IPNet: addr}); err != nil {
+		netlink.LinkDel(br)
+		return err
+	}
+	if err := n.setBridgeMtu(br); err != nil {
+		netlink.LinkDel(br)
+		return err
+	}
+	return netlink.LinkSetUp(br)
+}
+
+func (n *Network) setBridgeMtu(br netlink.Link) error {
+	if n.spec.Mtu == 0 || br.Attrs().MTU == int(n.spec.Mtu) {
+		return nil
+	}
+	return netlink.LinkSetMTU(br, int(n.spec.Mtu))
+}
+
+func (n *Network) deleteBridge() error {
+	br, err := netlink.LinkByName(n.spec.Bridge)
+	if err != nil {
+		return nil
+	}
+	return netlink.LinkDel(br)
+}
+
+type rule struct {
+	table iptables.Table
+	chain string
+	args  []string
+}
+
+// setupIptables inserts or deletes the isolation rules of the network, and
+// the masquerade rules if it is masqueraded.
+func (n *Network) setupIptables(action iptables.Action) error {
+	if err := applyRules(action, isolationRules(n.spec.Bridge)); err != nil {
+		return fmt.Errorf("failed to set up isolation of network %s: %v", n.spec.Name, err)
+	}
+	if n.spec.Masquerade {
+		if err := n.setupMasquerade(action); err != nil {
+			return fmt.Errorf("failed to set up masquerade of network %s: %v", n.spec.Name, err)
+		}
+	}
+	return nil
+}
+
+// setupMasquerade inserts or deletes the rules forwarding the traffic of
+// the network to the outside of the host. The traffic to the other hyperd
+// bridges is dropped by the isolation chains before it is accepted here.
+func (n *Network) setupMasquerade(action iptables.Action) error {
+	bridge, subnet := n.spec.Bridge, n.subnet.String()
+	return applyRules(action, []rule{
+		{iptables.Nat, "POSTROUTING", []string{"-s", subnet, "!", "-o", bridge, "-j", "MASQUERADE"}},
+		{iptables.Filter, "FORWARD", []string{"-i", bridge, "!", "-o", bridge, "-j", "ACCEPT"}},
+		{iptables.Filter, "FORWARD", []string{"-o", bridge, "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"}},
+	})
+}
+
+// isolationRules returns the rules of the bridge in the isolation chains:
+// the traffic from the bridge to the others goes to the second chain, which
+// drops the traffic to any hyperd bridge.
+func isolationRules(bridge string) []rule {
+	return []rule{
+		{iptables.Filter, isolationChain, []string{"-i", bridge, "!", "-o", bridge, "-j", isolationChain2}},
+		{iptables.Filter, isolationChain2, []string{"-o", bridge, "-j", "DROP"}},
+	}
+}
+
+// setupIsolationChains creates the isolation chains with the rules of the
+// default bridge, and moves the jump to them to the first of FORWARD, ahead
+// of the rules accepting the traffic of the bridges. The jump to the policy
+// chain is moved ahead of it afterwards, see policy.KeepFirst.
+func setupIsolationChains() error {
+	iptables.Raw("-N", isolationChain)
+	iptables.Raw("-N", isolationChain2)
+	if network.BridgeIface != "" {
+		if err := applyRules(iptables.Insert, isolationRules(network.BridgeIface)); err != nil {
+			return fmt.Errorf("failed to set up isolation of bridge %s: %v", network.BridgeIface, err)
+		}
+	}
+
+	jump := []string{"-j", isolationChain}
+	if output, err := iptables.Raw("-S", "FORWARD", "1"); err == nil &&
+		strings.TrimSpace(string(output)) == "-A FORWARD -j "+isolationChain {
+		return nil
+	}
+	if iptables.Exists(iptables.Filter, "FORWARD", jump...) {
+		iptables.Raw(append([]string{"-D", "FORWARD"}, jump...)...)
+	}
+	if output, err := iptables.Raw(append([]string{"-I", "FORWARD"}, jump...)...); err != nil {
+		return err
+	} else if len(output) != 0 {
+		return &iptables.ChainError{Chain: "FORWARD goto " + isolationChain, Output: output}
+	}
+	return nil
+}
+
+func applyRules(action iptables.Action, rules []rule) error {
+	for _, r := range rules {
+		exists := iptables.Exists(r.table, r.chain, r.args...)
+		if (action == iptables.Insert) == exists {
+			continue
+		}
+		args := append([]string{"-t", string(r.table), string(action), r.chain}, r.args...)
+		if output, err := iptables.Raw(args...); err != nil {
+			return err
+		} else if len(output) != 0 {
+			return &iptables.ChainError{Chain: r.chain, Output: output}
+		}
+	}
+	return nil
+}
+
+// Remove deletes the bridge and the rules of the network, the network in
+// use by any endpoint could not be removed.
+func Remove(name string) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	n, ok := networks[name]
+	if !ok {
+		return errors.ErrNetworkNotFound.WithArgs(name)
+	}
+	n.lock.Lock()
+	inUse := len(n.endpoints)
+	n.lock.Unlock()
+	if inUse > 0 {
+		return errors.ErrNetworkInUse.WithArgs(name, inUse)
+	}
+
+	if !disableIptables {
+		if err := n.setupIptables(iptables.Delete); err != nil {
+			hlog.Log(hlog.WARNING, "failed to remove the rules of network %s: %v", name, err)
+		}
+	}
+	if err := n.deleteBridge(); err != nil {
+		hlog.Log(hlog.ERROR, "failed to delete bridge %s of network %s: %v", n.spec.Bridge, name, err)
+		return err
+	}
+	delete(networks, name)
+	hlog.Log(hlog.INFO, "network %s is removed", name)
+	return nil
+}
+
+// Get returns the network of the name.
+func Get(name string) (*Network, error) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	n, ok := networks[name]
+	if !ok {
+		return nil, errors.ErrNetworkNotFound.WithArgs(name)
+	}
+	return n, nil
+}
+
+// List returns the networks sorted by name.
+func List() []*Network {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	result := make([]*Network, 0, len(networks))
+	for _, n := range networks {
+		result = append(result, n)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].spec.Name < result[j].spec.Name })
+	return result
+}
+
+func (n *Network) Name() string {
+	return n.spec.Name
+}
+
+func (n *Network) Spec() *apitypes.Network {
+	return n.spec
+}
+
+func (n *Network) Bridge() string {
+	return n.spec.Bridge
+}
+
+func (n *Network) Gateway() string {
+	return n.spec.Gateway
+}
+
+func (n *Network) Mtu() uint64 {
+	return n.spec.Mtu
+}
+
+// Info returns the network and its endpoints.
+func (n *Network) Info() *apitypes.NetworkInfo {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	info := &apitypes.NetworkInfo{
+		Network:   n.spec,
+		Endpoints: make([]*apitypes.NetworkEndpoint, 0, len(n.endpoints)),
+	}
+	for _, ep := range n.endpoints {
+		info.Endpoints = append(info.Endpoints, ep)
+	}
+	sort.Slice(info.Endpoints, func(i, j int) bool {
+		a, b := info.Endpoints[i], info.Endpoints[j]
+		if a.PodID != b.PodID {
+			return a.PodID < b.PodID
+		}
+		return a.InterfaceID < b.InterfaceID
+	})
+	return info
+}
+
+// Allocate allocates the requested ip, or any free address if ip is empty,
+// to the interface of the pod, and returns the address in CIDR format.
+func (n *Network) Allocate(podId, interfaceId, ip string) (string, error) {
+	var requested net.IP
+	if ip != "" {
+		parsed, _, err := network.IpParser(ip)
+		if err != nil || parsed == nil {
+			return "", fmt.Errorf("incorrect ip %s in network %s", ip, n.spec.Name)
+		}
+		requested = parsed.To4()
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	addr, err := n.allocator.RequestIP(n.subnet, requested)
+	if err != nil {
+		return "", fmt.Errorf("failed to allocate ip %s in network %s: %v", ip, n.spec.Name, err)
+	}
+	n.endpoints[addr.String()] = &apitypes.NetworkEndpoint{
+		PodID:       podId,
+		InterfaceID: interfaceId,
+		Ip:          addr.String(),
+	}
+	ones, _ := n.subnet.Mask.Size()
+	return fmt.Sprintf("%s/%d", addr, ones), nil
+}
+
+// Release returns the address, in CIDR format or not, to the network.
+func (n *Network) Release(ip string) error {
+	addr, _, err := network.IpParser(ip)
+	if err != nil || addr == nil {
+		return fmt.Errorf("incorrect ip %s in network %s", ip, n.spec.Name)
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	delete(n.endpoints, addr.String())
+	return n.allocator.ReleaseIP(n.subnet, addr.To4())
+}
@@ -0,0 +1,311 @@
+package policy
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/networking/portmapping/iptables"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	// the chain jumped to at first from FORWARD, which jumps to the
+	// chains of the pod interfaces
+	policyChain   = "HYPER-POLICY"
+	ingressPrefix = "HYPER-PI-"
+	egressPrefix  = "HYPER-PE-"
+)
+
+// Endpoint is an interface of a pod. The traffic from the pod is matched by
+// the tap device, which could not be spoofed by the pod, and the traffic to
+// the pod is matched by the address.
+type Endpoint struct {
+	Tap string
+	Ip  string
+}
+
+type pod struct {
+	labels    map[string]string
+	policy    *apitypes.NetworkPolicy
+	endpoints []Endpoint
+}
+
+var (
+	lock            sync.Mutex
+	pods            = make(map[string]*pod)
+	disableIptables bool
+	// the chains of the interfaces in iptables, the ones no longer
+	// rendered are removed on the next update
+	chains = make(map[string]bool)
+)
+
+// Setup creates the policy chain jumped to at first from FORWARD. The
+// chains left by the previous run of hyperd are removed on the first
+// update, unless the pods are restored.
+func Setup(disable bool) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	disableIptables = disable
+	if disableIptables {
+		return nil
+	}
+	if err := setupChain(); err != nil {
+		hlog.Log(hlog.ERROR, "failed to set up network policy chain: %v", err)
+		return err
+	}
+
+	output, err := iptables.Raw("-S")
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "-N" &&
+			(strings.HasPrefix(fields[1], ingressPrefix) || strings.HasPrefix(fields[1], egressPrefix)) {
+			chains[fields[1]] = true
+		}
+	}
+	return nil
+}
+
+// SetDisableIptables enables or disables the network policies, the pods
+// with a policy could not be started while it is disabled.
+func SetDisableIptables(disable bool) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if disable == disableIptables {
+		return nil
+	}
+	disableIptables = disable
+	if disable {
+		return nil
+	}
+	return apply()
+}
+
+// KeepFirst moves the jump to the policy chain back to the first of
+// FORWARD, it should be called after the other FORWARD rules are inserted,
+// e.g. the ones of a named network, which would accept the traffic before
+// the policies.
+func KeepFirst() error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if disableIptables {
+		return nil
+	}
+	return setupChain()
+}
+
+func setupChain() error {
+	iptables.Raw("-N", policyChain)
+
+	jump := []string{"-j", policyChain}
+	if output, err := iptables.Raw("-S", "FORWARD", "1"); err == nil &&
+		strings.TrimSpace(string(output)) == "-A FORWARD -j "+policyChain {
+		return nil
+	}
+	if iptables.Exists(iptables.Filter, "FORWARD", jump...) {
+		iptables.Raw(append([]string{"-D", "FORWARD"}, jump...)...)
+	}
+	if output, err := iptables.Raw(append([]string{"-I", "FORWARD"}, jump...)...); err != nil {
+		return err
+	} else if len(output) != 0 {
+		return &iptables.ChainError{Chain: "FORWARD goto " + policyChain, Output: output}
+	}
+	return nil
+}
+
+// Update registers the labels, the policy and the interfaces of the pod,
+// and enforces the policies of all the pods, as the pod might be a peer
+// of the others.
+func Update(podId string, labels map[string]string, policy *apitypes.NetworkPolicy, endpoints []Endpoint) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if disableIptables && (policy.IsolateIngress() || policy.IsolateEgress()) {
+		return fmt.Errorf("network policy of pod %s could not be enforced with iptables disabled", podId)
+	}
+
+	p := &pod{
+		labels:    make(map[string]string, len(labels)),
+		policy:    policy,
+		endpoints: endpoints,
+	}
+	for k, v := range labels {
+		p.labels[k] = v
+	}
+	old, ok := pods[podId]
+	pods[podId] = p
+	if err := apply(); err != nil {
+		if ok {
+			pods[podId] = old
+		} else {
+			delete(pods, podId)
+		}
+		return err
+	}
+	return nil
+}
+
+// Remove removes the chains of the pod, and the pod from the peers of the
+// other policies.
+func Remove(podId string) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, ok := pods[podId]; !ok {
+		return
+	}
+	delete(pods, podId)
+	if err := apply(); err != nil {
+		hlog.Log(hlog.ERROR, "failed to remove network policy of pod %s: %v", podId, err)
+	}
+}
+
+// apply renders the policies of all the pods, and applies them atomically,
+// the lock should be held.
+func apply() error {
+	if disableIptables {
+		return nil
+	}
+	if err := setupChain(); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(pods))
+	for id := range pods {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var (
+		buf      bytes.Buffer
+		rules    bytes.Buffer
+		rendered = make(map[string]bool)
+	)
+	buf.WriteString("*filter\n")
+	buf.WriteString(":" + policyChain + " - [0:0]\n")
+	for _, id := range ids {
+		p := pods[id]
+		for _, ep := range p.endpoints {
+			if p.policy.IsolateIngress() {
+				chain := ingressPrefix + ep.Tap
+				rendered[chain] = true
+				buf.WriteString(":" + chain + " - [0:0]\n")
+				fmt.Fprintf(&rules, "-A %s -d %s -j %s\n", policyChain, ep.Ip, chain)
+				writeChain(&rules, chain, "-s", p.policy.Ingress)
+			}
+			if p.policy.IsolateEgress() {
+				chain := egressPrefix + ep.Tap
+				rendered[chain] = true
+				buf.WriteString(":" + chain + " - [0:0]\n")
+				fmt.Fprintf(&rules, "-A %s -m physdev --physdev-in %s -j %s\n", policyChain, ep.Tap, chain)
+				writeChain(&rules, chain, "-d", p.policy.Egress)
+			}
+		}
+	}
+	buf.Write(rules.Bytes())
+	for chain := range chains {
+		if !rendered[chain] {
+			buf.WriteString(":" + chain + " - [0:0]\n")
+			buf.WriteString("-X " + chain + "\n")
+		}
+	}
+	buf.WriteString("COMMIT\n")
+
+	if err := iptables.Restore(buf.Bytes()); err != nil {
+		hlog.Log(hlog.ERROR, "failed to apply network policies: %v", err)
+		return err
+	}
+	chains = rendered
+	return nil
+}
+
+// writeChain writes the rules of the chain, the traffic allowed by any rule
+// returns to the policy chain, and the others are dropped. peerFlag is -s
+// for ingress, and -d for egress.
+func writeChain(buf *bytes.Buffer, chain, peerFlag string, rules []*apitypes.NetworkPolicyRule) {
+	fmt.Fprintf(buf, "-A %s -m conntrack --ctstate RELATED,ESTABLISHED -j RETURN\n", chain)
+	for _, rule := range rules {
+		peers := []string{""}
+		if len(rule.Peers) > 0 {
+			peers = peerAddrs(rule.Peers)
+		}
+		ports := []string{""}
+		if len(rule.Ports) > 0 {
+			ports = portMatches(rule.Ports)
+		}
+		for _, peer := range peers {
+			for _, port := range ports {
+				line := "-A " + chain
+				if peer != "" {
+					line += " " + peerFlag + " " + peer
+				}
+				if port != "" {
+					line += " " + port
+				}
+				buf.WriteString(line + " -j RETURN\n")
+			}
+		}
+	}
+	fmt.Fprintf(buf, "-A %s -j DROP\n", chain)
+}
+
+// peerAddrs returns the CIDRs and the addresses of the selected pods.
+func peerAddrs(peers []*apitypes.NetworkPolicyPeer) []string {
+	addrs := []string{}
+	for _, peer := range peers {
+		if peer.Cidr != "" {
+			addrs = append(addrs, peer.Cidr)
+			continue
+		}
+		ids := make([]string, 0, len(pods))
+		for id, p := range pods {
+			if selected(p.labels, peer.PodSelector) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			for _, ep := range pods[id].endpoints {
+				addrs = append(addrs, ep.Ip)
+			}
+		}
+	}
+	return addrs
+}
+
+func selected(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func portMatches(ports []*apitypes.NetworkPolicyPort) []string {
+	matches := []string{}
+	for _, port := range ports {
+		protocols := []string{port.Protocol}
+		if port.Protocol == "" {
+			protocols = []string{"tcp", "udp"}
+		}
+		for _, proto := range protocols {
+			match := "-p " + proto
+			if port.Port > 0 {
+				match += " --dport " + strconv.Itoa(int(port.Port))
+			}
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
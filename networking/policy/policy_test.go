@@ -0,0 +1,38 @@
+package policy
+
+import (
+	"bytes"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestWriteChain(t *testing.T) {
+	pods = map[string]*pod{
+		"web-1": {labels: map[string]string{"app": "web"}, endpoints: []Endpoint{{Tap: "tap1", Ip: "192.168.123.2"}}},
+		"web-2": {labels: map[string]string{"app": "web", "tier": "a"}, endpoints: []Endpoint{{Tap: "tap2", Ip: "192.168.123.3"}}},
+		"db":    {labels: map[string]string{"app": "db"}, endpoints: []Endpoint{{Tap: "tap3", Ip: "192.168.123.4"}}},
+	}
+	defer func() { pods = make(map[string]*pod) }()
+
+	var buf bytes.Buffer
+	writeChain(&buf, "HYPER-PI-tap3", "-s", []*apitypes.NetworkPolicyRule{
+		{
+			Peers: []*apitypes.NetworkPolicyPeer{{PodSelector: map[string]string{"app": "web"}}, {Cidr: "10.0.0.0/8"}},
+			Ports: []*apitypes.NetworkPolicyPort{{Protocol: "tcp", Port: 3306}},
+		},
+		{Ports: []*apitypes.NetworkPolicyPort{{Port: 53}}},
+		{Peers: []*apitypes.NetworkPolicyPeer{{PodSelector: map[string]string{"app": "cache"}}}},
+	})
+	expected := `-A HYPER-PI-tap3 -m conntrack --ctstate RELATED,ESTABLISHED -j RETURN
+-A HYPER-PI-tap3 -s 192.168.123.2 -p tcp --dport 3306 -j RETURN
+-A HYPER-PI-tap3 -s 192.168.123.3 -p tcp --dport 3306 -j RETURN
+-A HYPER-PI-tap3 -s 10.0.0.0/8 -p tcp --dport 3306 -j RETURN
+-A HYPER-PI-tap3 -p tcp --dport 53 -j RETURN
+-A HYPER-PI-tap3 -p udp --dport 53 -j RETURN
+-A HYPER-PI-tap3 -j DROP
+`
+	if buf.String() != expected {
+		t.Fatalf("incorrect rules:\n%s", buf.String())
+	}
+}
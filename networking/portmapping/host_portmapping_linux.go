@@ -205,6 +205,44 @@ func setupIptablesPortMaps(containerip string, maps []*PortMapping) error {
 	return nil
 }
 
+// restoreIptablesPortMaps allocates the ports of the mappings set up before
+// the restart of hyperd, whose iptables rules are kept, from the PortMapper
+// again, and starts their userland proxies if enabled. The ports allocated
+// are released if any of them fails.
+func restoreIptablesPortMaps(containerip string, maps []*PortMapping) (err error) {
+	type hostPort struct {
+		protocol string
+		port     int
+	}
+	allocated := []hostPort{}
+	defer func() {
+		if err != nil {
+			for _, a := range allocated {
+				PortMapper.ReleaseMap(a.protocol, a.port)
+			}
+		}
+	}()
+
+	for _, m := range maps {
+		if !strings.EqualFold(m.Protocol, "udp") {
+			m.Protocol = "tcp"
+		}
+		if m.FromPorts.End == 0 {
+			m.FromPorts.End = m.FromPorts.Begin
+		}
+		if m.ToPorts.End == 0 {
+			m.ToPorts.End = m.ToPorts.Begin
+		}
+		for i, j := m.FromPorts.Begin, m.ToPorts.Begin; i <= m.FromPorts.End; i, j = i+1, j+1 {
+			if err = PortMapper.AllocateMap(m.Protocol, i, containerip, j); err != nil {
+				return err
+			}
+			allocated = append(allocated, hostPort{m.Protocol, i})
+		}
+	}
+	return nil
+}
+
 func releaseIptablesPortMaps(containerip string, maps []*PortMapping) error {
 
 release_loop:
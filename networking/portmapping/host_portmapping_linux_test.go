@@ -0,0 +1,39 @@
+package portmapping
+
+import (
+	"testing"
+
+	"github.com/hyperhq/hyperd/networking/portmapping/portmapper"
+)
+
+func TestRestoreIptablesPortMapsRollback(t *testing.T) {
+	saved := PortMapper
+	PortMapper = portmapper.New()
+	defer func() { PortMapper = saved }()
+
+	if err := PortMapper.AllocateMap("tcp", 8082, "192.168.123.3", 80); err != nil {
+		t.Fatal(err)
+	}
+
+	maps := []*PortMapping{
+		{Protocol: "udp", FromPorts: &PortRange{Begin: 53}, ToPorts: &PortRange{Begin: 53}},
+		{Protocol: "tcp", FromPorts: &PortRange{Begin: 8080, End: 8082}, ToPorts: &PortRange{Begin: 80, End: 82}},
+	}
+	if err := restoreIptablesPortMaps("192.168.123.2", maps); err == nil {
+		t.Fatal("expected the restore to fail on the port allocated already")
+	}
+
+	// the ports allocated before the failure are released, and the port
+	// of the other container is kept
+	for _, p := range []struct {
+		protocol string
+		port     int
+	}{{"udp", 53}, {"tcp", 8080}, {"tcp", 8081}} {
+		if err := PortMapper.AllocateMap(p.protocol, p.port, "192.168.123.4", 80); err != nil {
+			t.Fatalf("port %d/%s is not released: %v", p.port, p.protocol, err)
+		}
+	}
+	if err := PortMapper.AllocateMap("tcp", 8082, "192.168.123.4", 80); err == nil {
+		t.Fatal("port 8082/tcp of the other container is released")
+	}
+}
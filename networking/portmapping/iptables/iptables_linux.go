@@ -192,3 +192,35 @@ func Raw(args ...string) ([]byte, error) {
 
 	return output, err
 }
+
+// Restore applies the rules in the iptables-restore format atomically, the
+// chains not declared in the rules are kept.
+func Restore(rules []byte) error {
+	if err := initCheck(); err != nil {
+		return err
+	}
+	path, err := exec.LookPath("iptables-restore")
+	if err != nil {
+		return ErrIptablesNotFound
+	}
+
+	args := []string{"--noflush"}
+	if supportsXlock {
+		args = append(args, "--wait")
+	}
+	hlog.Log(hlog.TRACE, "%s %v:\n%s", path, args, rules)
+
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = bytes.NewReader(rules)
+	output, err := cmd.CombinedOutput()
+	if err != nil && supportsXlock && strings.Contains(string(output), "unrecognized option") {
+		// the iptables-restore older than iptables does not take the lock
+		cmd = exec.Command(path, "--noflush")
+		cmd.Stdin = bytes.NewReader(rules)
+		output, err = cmd.CombinedOutput()
+	}
+	if err != nil {
+		return fmt.Errorf("iptables-restore failed: %s (%s)", output, err)
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 type PortMap struct {
 	containerIP   string
 	containerPort int
+	proxy         Proxy
 }
 
 func newPortMap(containerip string, containerport int) *PortMap {
@@ -26,10 +27,25 @@ type PortMapper struct {
 	tcpMap PortSet
 	udpMap PortSet
 	mutex  sync.Mutex
+
+	// start a userland proxy for each allocated port, which forwards the
+	// loopback and hairpin traffic to the container
+	userlandProxy bool
 }
 
 func New() *PortMapper {
-	return &PortMapper{PortSet{}, PortSet{}, sync.Mutex{}}
+	return &PortMapper{
+		tcpMap: PortSet{},
+		udpMap: PortSet{},
+	}
+}
+
+// SetUserlandProxy enables or disables the userland proxies of the ports
+// allocated afterwards, the allocated ones are not changed.
+func (p *PortMapper) SetUserlandProxy(enable bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.userlandProxy = enable
 }
 
 func (p *PortMapper) AllocateMap(protocol string, hostPort int,
@@ -52,6 +68,14 @@ func (p *PortMapper) AllocateMap(protocol string, hostPort int,
 	}
 
 	allocated := newPortMap(containerIP, ContainerPort)
+	if p.userlandProxy {
+		proxy, err := NewProxy(protocol, hostPort, containerIP, ContainerPort)
+		if err != nil {
+			return fmt.Errorf("failed to start userland proxy of host port %d: %v", hostPort, err)
+		}
+		allocated.proxy = proxy
+		go proxy.Run()
+	}
 	pset[hostPort] = allocated
 
 	return nil
@@ -69,9 +93,11 @@ func (p *PortMapper) ReleaseMap(protocol string, hostPort int) error {
 		pset = p.tcpMap
 	}
 
-	_, ok := pset[hostPort]
+	e, ok := pset[hostPort]
 	if !ok {
 		glog.Errorf("Host port %d has not been used", hostPort)
+	} else if e.proxy != nil {
+		e.proxy.Close()
 	}
 
 	delete(pset, hostPort)
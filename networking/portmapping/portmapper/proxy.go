@@ -0,0 +1,291 @@
+package portmapper
+
+import (
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// the UDP "connections" of a client are forgotten after the timeout
+	udpConnTrackTimeout = 90 * time.Second
+	udpBufSize          = 65507
+)
+
+// Proxy forwards the connections to a host port to the container, the
+// loopback and hairpin traffic, which is not DNATed by the iptables
+// rules, reaches the container through it.
+type Proxy interface {
+	// Run forwards the traffic until the proxy is closed.
+	Run()
+	// Close stops the proxy and closes the forwarding connections.
+	Close()
+	// FrontendAddr is the address the proxy listens on.
+	FrontendAddr() net.Addr
+}
+
+// NewProxy listens on the host port of all the addresses, and forwards the
+// traffic to the backend address.
+func NewProxy(protocol string, hostPort int, backendIP string, backendPort int) (Proxy, error) {
+	frontend := net.JoinHostPort("", strconv.Itoa(hostPort))
+	backend := net.JoinHostPort(backendIP, strconv.Itoa(backendPort))
+	if strings.EqualFold(protocol, "udp") {
+		return newUDPProxy(frontend, backend)
+	}
+	return newTCPProxy(frontend, backend)
+}
+
+type tcpProxy struct {
+	listener net.Listener
+	backend  string
+
+	lock   sync.Mutex
+	conns  map[net.Conn]bool
+	closed bool
+}
+
+func newTCPProxy(frontend, backend string) (*tcpProxy, error) {
+	l, err := net.Listen("tcp", frontend)
+	if err != nil {
+		return nil, err
+	}
+	return &tcpProxy{
+		listener: l,
+		backend:  backend,
+		conns:    make(map[net.Conn]bool),
+	}, nil
+}
+
+func (p *tcpProxy) Run() {
+	for {
+		client, err := p.listener.Accept()
+		if err != nil {
+			if !p.isClosed() {
+				glog.Errorf("userland proxy %s stopped accepting: %v", p.listener.Addr(), err)
+			}
+			return
+		}
+		go p.forward(client)
+	}
+}
+
+func (p *tcpProxy) forward(client net.Conn) {
+	backend, err := net.Dial("tcp", p.backend)
+	if err != nil {
+		glog.Warningf("userland proxy %s failed to connect %s: %v", p.listener.Addr(), p.backend, err)
+		client.Close()
+		return
+	}
+	if !p.track(client, backend) {
+		client.Close()
+		backend.Close()
+		return
+	}
+	defer p.untrack(client, backend)
+
+	var wg sync.WaitGroup
+	copyHalf := func(dst, src net.Conn) {
+		defer wg.Done()
+		io.Copy(dst, src)
+		// let the peer know the end of the stream, the other direction
+		// keeps forwarding
+		if c, ok := dst.(*net.TCPConn); ok {
+			c.CloseWrite()
+		} else {
+			dst.Close()
+		}
+	}
+	wg.Add(2)
+	go copyHalf(backend, client)
+	go copyHalf(client, backend)
+	wg.Wait()
+	client.Close()
+	backend.Close()
+}
+
+func (p *tcpProxy) track(conns ...net.Conn) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.closed {
+		return false
+	}
+	for _, c := range conns {
+		p.conns[c] = true
+	}
+	return true
+}
+
+func (p *tcpProxy) untrack(conns ...net.Conn) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, c := range conns {
+		delete(p.conns, c)
+	}
+}
+
+func (p *tcpProxy) isClosed() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.closed
+}
+
+func (p *tcpProxy) Close() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	p.listener.Close()
+	for c := range p.conns {
+		c.Close()
+	}
+	p.conns = nil
+}
+
+func (p *tcpProxy) FrontendAddr() net.Addr {
+	return p.listener.Addr()
+}
+
+// udpProxy forwards the datagrams of each client through its own socket
+// to the backend, and the replies back to the client.
+type udpProxy struct {
+	listener *net.UDPConn
+	backend  *net.UDPAddr
+
+	lock   sync.Mutex
+	conns  map[string]*net.UDPConn
+	closed bool
+}
+
+func newUDPProxy(frontend, backend string) (*udpProxy, error) {
+	laddr, err := net.ResolveUDPAddr("udp", frontend)
+	if err != nil {
+		return nil, err
+	}
+	baddr, err := net.ResolveUDPAddr("udp", backend)
+	if err != nil {
+		return nil, err
+	}
+	l, err := net.ListenUDP("udp", laddr)
+	if err != nil {
+		return nil, err
+	}
+	return &udpProxy{
+		listener: l,
+		backend:  baddr,
+		conns:    make(map[string]*net.UDPConn),
+	}, nil
+}
+
+func (p *udpProxy) Run() {
+	buf := make([]byte, udpBufSize)
+	for {
+		n, from, err := p.listener.ReadFromUDP(buf)
+		if err != nil {
+			if !p.isClosed() {
+				glog.Errorf("userland proxy %s stopped reading: %v", p.listener.LocalAddr(), err)
+			}
+			return
+		}
+
+		conn, err := p.connOf(from)
+		if err != nil {
+			glog.Warningf("userland proxy %s failed to connect %s: %v", p.listener.LocalAddr(), p.backend, err)
+			continue
+		}
+		if conn == nil {
+			return
+		}
+		if _, err = conn.Write(buf[:n]); err != nil {
+			glog.V(1).Infof("userland proxy %s failed to forward to %s: %v", p.listener.LocalAddr(), p.backend, err)
+			continue
+		}
+		// the client is active, keep waiting for the replies
+		conn.SetReadDeadline(time.Now().Add(udpConnTrackTimeout))
+	}
+}
+
+// connOf returns the socket to the backend of the client, a new one is
+// created for a new client. It returns nil if the proxy is closed.
+func (p *udpProxy) connOf(client *net.UDPAddr) (*net.UDPConn, error) {
+	key := client.String()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.closed {
+		return nil, nil
+	}
+	if conn, ok := p.conns[key]; ok {
+		return conn, nil
+	}
+	conn, err := net.DialUDP("udp", nil, p.backend)
+	if err != nil {
+		return nil, err
+	}
+	p.conns[key] = conn
+	go p.reply(conn, client, key)
+	return conn, nil
+}
+
+// reply forwards the replies of the backend to the client, until neither
+// of them sends anything for udpConnTrackTimeout.
+func (p *udpProxy) reply(conn *net.UDPConn, client *net.UDPAddr, key string) {
+	defer func() {
+		p.lock.Lock()
+		if p.conns != nil && p.conns[key] == conn {
+			delete(p.conns, key)
+		}
+		p.lock.Unlock()
+		conn.Close()
+	}()
+
+	buf := make([]byte, udpBufSize)
+	for {
+		conn.SetReadDeadline(time.Now().Add(udpConnTrackTimeout))
+		n, err := conn.Read(buf)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				return
+			}
+			// e.g. the port unreachable of the backend, wait for the
+			// retries of the client
+			if !p.isClosed() && !strings.Contains(err.Error(), "use of closed") {
+				continue
+			}
+			return
+		}
+		if _, err = p.listener.WriteToUDP(buf[:n], client); err != nil {
+			return
+		}
+	}
+}
+
+func (p *udpProxy) isClosed() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.closed
+}
+
+func (p *udpProxy) Close() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	p.listener.Close()
+	for _, conn := range p.conns {
+		conn.Close()
+	}
+	p.conns = nil
+}
+
+func (p *udpProxy) FrontendAddr() net.Addr {
+	return p.listener.LocalAddr()
+}
@@ -0,0 +1,132 @@
+package portmapper
+
+import (
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestTCPProxy(t *testing.T) {
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+	go func() {
+		for {
+			c, err := backend.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(c, c)
+				c.Close()
+			}()
+		}
+	}()
+
+	baddr := backend.Addr().(*net.TCPAddr)
+	proxy, err := NewProxy("tcp", 0, baddr.IP.String(), baddr.Port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go proxy.Run()
+
+	port := proxy.FrontendAddr().(*net.TCPAddr).Port
+	c, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err = c.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 5)
+	if _, err = io.ReadFull(c, buf); err != nil || string(buf) != "hello" {
+		t.Fatalf("unexpected reply %q: %v", buf, err)
+	}
+
+	// the forwarding connections are closed with the proxy
+	proxy.Close()
+	if _, err = c.Read(buf); err == nil {
+		t.Fatal("connection should be closed with the proxy")
+	}
+	if _, err = net.DialTimeout("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), time.Second); err == nil {
+		t.Fatal("proxy should not listen after closed")
+	}
+}
+
+func TestUDPProxy(t *testing.T) {
+	backend, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, from, err := backend.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			backend.WriteToUDP(buf[:n], from)
+		}
+	}()
+
+	baddr := backend.LocalAddr().(*net.UDPAddr)
+	proxy, err := NewProxy("udp", 0, baddr.IP.String(), baddr.Port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go proxy.Run()
+	defer proxy.Close()
+
+	port := proxy.FrontendAddr().(*net.UDPAddr).Port
+	for _, msg := range []string{"ping", "pong"} {
+		c, err := net.Dial("udp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetDeadline(time.Now().Add(5 * time.Second))
+		if _, err = c.Write([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+		buf := make([]byte, 1024)
+		n, err := c.Read(buf)
+		if err != nil || string(buf[:n]) != msg {
+			t.Fatalf("unexpected reply %q: %v", buf[:n], err)
+		}
+		c.Close()
+	}
+}
+
+func TestAllocateMapWithProxy(t *testing.T) {
+	l, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+
+	pm := New()
+	pm.SetUserlandProxy(true)
+	if err = pm.AllocateMap("tcp", port, "127.0.0.1", 80); err != nil {
+		t.Fatal(err)
+	}
+	if pm.tcpMap[port].proxy == nil {
+		t.Fatal("userland proxy is not started")
+	}
+	if err = pm.AllocateMap("tcp", port, "127.0.0.1", 80); err == nil {
+		t.Fatal("host port should not be allocated twice")
+	}
+	pm.ReleaseMap("tcp", port)
+
+	// the port is free again after the proxy is closed with the mapping
+	if l, err = net.Listen("tcp", net.JoinHostPort("", strconv.Itoa(port))); err != nil {
+		t.Fatalf("host port is not released: %v", err)
+	}
+	l.Close()
+}
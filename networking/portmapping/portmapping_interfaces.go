@@ -42,3 +42,12 @@ func ReleasePortMaps(containerip string, externalPrefix []string, maps []*PortMa
 	}
 	return postExec, nil
 }
+
+// RestorePortMaps re-allocates the host ports of the mappings of a pod
+// restored after the restart of hyperd, the rules are not set up again.
+func RestorePortMaps(containerip string, maps []*PortMapping) error {
+	if len(maps) == 0 || disableIptables {
+		return nil
+	}
+	return restoreIptablesPortMaps(containerip, maps)
+}
@@ -12,6 +12,7 @@ import (
 var (
 	disableIptables bool
 	bridgeIface     string
+	bridgeAddr      string
 )
 
 //setup environment for iptables and IP forwarding
@@ -20,6 +21,7 @@ func Setup(bIface, addr string, disable bool) error {
 
 	disableIptables = disable
 	bridgeIface = bIface
+	bridgeAddr = addr
 
 	if disableIptables {
 		hlog.Log(hlog.DEBUG, "Iptables is disabled")
@@ -36,6 +38,22 @@ func Setup(bIface, addr string, disable bool) error {
 	return nil
 }
 
+// SetDisableIptables enables or disables the iptables rules, it takes
+// effect on the port mappings set up afterwards.
+func SetDisableIptables(disable bool) error {
+	if disable == disableIptables {
+		return nil
+	}
+	return Setup(bridgeIface, bridgeAddr, disable)
+}
+
+// SetUserlandProxy enables or disables the userland proxies, which forward
+// the loopback and hairpin traffic of the mapped host ports to the
+// containers. It takes effect on the port mappings set up afterwards.
+func SetUserlandProxy(enable bool) {
+	PortMapper.SetUserlandProxy(enable)
+}
+
 func setupIPTables(addr string) error {
 	if disableIptables {
 		return nil
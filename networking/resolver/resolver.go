@@ -0,0 +1,231 @@
+package resolver
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/utils"
+	"github.com/miekg/dns"
+)
+
+const (
+	DefaultDomain = "hyper.local"
+
+	// the names of the pods change as the pods come and go
+	ttl            = 5
+	forwardTimeout = 5 * time.Second
+	hostResolvConf = "/etc/resolv.conf"
+)
+
+// LookupFunc returns the addresses of a name in the domain, the name is
+// relative to the domain, e.g. "web" or "nginx.web". The client is the
+// address querying the name, for the names only meaningful in its pod.
+type LookupFunc func(name string, client net.IP) []net.IP
+
+var (
+	lock sync.RWMutex
+	// the address listened on, empty if the server is not started
+	address   string
+	domain    string
+	upstreams []string
+	lookup    LookupFunc
+	servers   []*dns.Server
+)
+
+// Setup starts the DNS server on the port 53 of the address, for both UDP
+// and TCP. The names in the domain are resolved with fn, and the others are
+// forwarded to the upstreams, or to the nameservers of the host if none is
+// configured.
+func Setup(addr, dom string, ups []string, fn LookupFunc) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if dom == "" {
+		dom = DefaultDomain
+	}
+	if len(ups) == 0 {
+		ups = hostNameservers()
+	}
+
+	listen := net.JoinHostPort(addr, "53")
+	pc, err := net.ListenPacket("udp", listen)
+	if err != nil {
+		hlog.Log(hlog.ERROR, "failed to listen on udp %s: %v", listen, err)
+		return err
+	}
+	l, err := net.Listen("tcp", listen)
+	if err != nil {
+		pc.Close()
+		hlog.Log(hlog.ERROR, "failed to listen on tcp %s: %v", listen, err)
+		return err
+	}
+
+	address = addr
+	domain = dns.Fqdn(strings.ToLower(dom))
+	lookup = fn
+	upstreams = []string{}
+	for _, up := range ups {
+		if _, _, err := net.SplitHostPort(up); err != nil {
+			up = net.JoinHostPort(up, "53")
+		}
+		// never forward the queries to the server itself
+		if up == listen {
+			continue
+		}
+		upstreams = append(upstreams, up)
+	}
+	servers = []*dns.Server{
+		{PacketConn: pc, Handler: dns.HandlerFunc(serve)},
+		{Listener: l, Handler: dns.HandlerFunc(serve)},
+	}
+	for _, srv := range servers {
+		go func(srv *dns.Server) {
+			if err := srv.ActivateAndServe(); err != nil {
+				hlog.Log(hlog.ERROR, "DNS server on %s stopped: %v", listen, err)
+			}
+		}(srv)
+	}
+	hlog.Log(hlog.INFO, "DNS server of %s listens on %s, forwarding to %v", domain, listen, upstreams)
+	return nil
+}
+
+// Shutdown stops the DNS server.
+func Shutdown() {
+	lock.Lock()
+	defer lock.Unlock()
+
+	for _, srv := range servers {
+		srv.Shutdown()
+	}
+	servers = nil
+	address = ""
+}
+
+// Enabled tells whether the DNS server is started.
+func Enabled() bool {
+	lock.RLock()
+	defer lock.RUnlock()
+	return address != ""
+}
+
+// ResolvConf returns the resolv.conf using the DNS server as the
+// nameserver, the names are searched in the domain of the pod, i.e. the
+// containers and the services of the pod, then in the domain, i.e. the
+// other pods.
+func ResolvConf(podId string, search, options []string) string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	dom := strings.TrimSuffix(domain, ".")
+	domains := append([]string{}, search...)
+	if id := strings.ToLower(podId); utils.IsDNSLabel(id) {
+		domains = append(domains, id+"."+dom)
+	}
+	domains = append(domains, dom)
+
+	lines := []string{
+		"nameserver " + address,
+		"search " + strings.Join(domains, " "),
+	}
+	if len(options) > 0 {
+		lines = append(lines, "options "+strings.Join(options, " "))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func serve(w dns.ResponseWriter, req *dns.Msg) {
+	var client net.IP
+	switch addr := w.RemoteAddr().(type) {
+	case *net.UDPAddr:
+		client = addr.IP
+	case *net.TCPAddr:
+		client = addr.IP
+	}
+
+	resp := answer(req, client)
+	if resp == nil {
+		resp = forward(req, w.LocalAddr().Network())
+	}
+	if err := w.WriteMsg(resp); err != nil {
+		hlog.Log(hlog.DEBUG, "failed to reply the DNS query of %v: %v", client, err)
+	}
+}
+
+// answer resolves the name in the domain, it returns nil if the query is
+// not for a name in the domain. The names not found are NXDOMAIN, and the
+// names without the queried type of records have no answer.
+func answer(req *dns.Msg, client net.IP) *dns.Msg {
+	lock.RLock()
+	dom, fn := domain, lookup
+	lock.RUnlock()
+
+	if len(req.Question) != 1 || dom == "" {
+		return nil
+	}
+	q := req.Question[0]
+	qname := strings.ToLower(dns.Fqdn(q.Name))
+	if q.Qclass != dns.ClassINET || !dns.IsSubDomain(dom, qname) {
+		return nil
+	}
+
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.Authoritative = true
+	resp.RecursionAvailable = true
+
+	name := strings.TrimSuffix(strings.TrimSuffix(qname, dom), ".")
+	var ips []net.IP
+	if name != "" && fn != nil {
+		ips = fn(name, client)
+	}
+	if len(ips) == 0 {
+		if name != "" {
+			resp.Rcode = dns.RcodeNameError
+		}
+		return resp
+	}
+	if q.Qtype != dns.TypeA && q.Qtype != dns.TypeANY {
+		return resp
+	}
+	for _, ip := range ips {
+		if ip4 := ip.To4(); ip4 != nil {
+			resp.Answer = append(resp.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: ttl},
+				A:   ip4,
+			})
+		}
+	}
+	return resp
+}
+
+// forward sends the query to the upstreams in turn, and returns the first
+// response, or SERVFAIL if none of them responds.
+func forward(req *dns.Msg, network string) *dns.Msg {
+	lock.RLock()
+	ups := upstreams
+	lock.RUnlock()
+
+	c := &dns.Client{Net: network, Timeout: forwardTimeout}
+	for _, up := range ups {
+		resp, _, err := c.Exchange(req, up)
+		if err == nil {
+			return resp
+		}
+		hlog.Log(hlog.DEBUG, "failed to forward DNS query to %s: %v", up, err)
+	}
+	resp := new(dns.Msg)
+	resp.SetRcode(req, dns.RcodeServerFailure)
+	return resp
+}
+
+func hostNameservers() []string {
+	conf, err := dns.ClientConfigFromFile(hostResolvConf)
+	if err != nil {
+		hlog.Log(hlog.WARNING, "failed to read the nameservers of the host: %v", err)
+		return nil
+	}
+	return conf.Servers
+}
@@ -0,0 +1,95 @@
+package resolver
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestAnswer(t *testing.T) {
+	domain = "hyper.local."
+	lookup = func(name string, client net.IP) []net.IP {
+		switch name {
+		case "web", "nginx.web":
+			return []net.IP{net.ParseIP("192.168.123.2")}
+		case "db":
+			if client.Equal(net.ParseIP("192.168.123.2")) {
+				return []net.IP{net.ParseIP("10.0.0.1")}
+			}
+		}
+		return nil
+	}
+	defer func() { domain, lookup = "", nil }()
+
+	query := func(name string, qtype uint16, client string) *dns.Msg {
+		req := new(dns.Msg)
+		req.SetQuestion(name, qtype)
+		return answer(req, net.ParseIP(client))
+	}
+
+	resp := query("Nginx.Web.hyper.local.", dns.TypeA, "192.168.123.3")
+	if resp == nil || resp.Rcode != dns.RcodeSuccess || len(resp.Answer) != 1 ||
+		resp.Answer[0].(*dns.A).A.String() != "192.168.123.2" {
+		t.Fatalf("incorrect answer of the container: %v", resp)
+	}
+	if resp = query("db.hyper.local.", dns.TypeA, "192.168.123.2"); resp == nil || len(resp.Answer) != 1 {
+		t.Fatalf("service of the pod is not resolved: %v", resp)
+	}
+	if resp = query("db.hyper.local.", dns.TypeA, "192.168.123.3"); resp == nil || resp.Rcode != dns.RcodeNameError {
+		t.Fatalf("service of another pod should not be resolved: %v", resp)
+	}
+	if resp = query("web.hyper.local.", dns.TypeAAAA, "192.168.123.3"); resp == nil || resp.Rcode != dns.RcodeSuccess || len(resp.Answer) != 0 {
+		t.Fatalf("AAAA query of a pod should have no answer: %v", resp)
+	}
+	if resp = query("example.com.", dns.TypeA, "192.168.123.3"); resp != nil {
+		t.Fatalf("name out of the domain should be forwarded: %v", resp)
+	}
+}
+
+func TestForward(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		resp.Answer = append(resp.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.ParseIP("93.184.216.34"),
+		})
+		w.WriteMsg(resp)
+	})}
+	go srv.ActivateAndServe()
+	defer srv.Shutdown()
+
+	upstreams = []string{"127.0.0.1:1", pc.LocalAddr().String()}
+	defer func() { upstreams = nil }()
+
+	req := new(dns.Msg)
+	req.SetQuestion("example.com.", dns.TypeA)
+	resp := forward(req, "udp")
+	if resp.Rcode != dns.RcodeSuccess || len(resp.Answer) != 1 {
+		t.Fatalf("query is not forwarded: %v", resp)
+	}
+
+	upstreams = nil
+	if resp = forward(req, "udp"); resp.Rcode != dns.RcodeServerFailure {
+		t.Fatalf("query without upstreams should fail: %v", resp)
+	}
+}
+
+func TestResolvConf(t *testing.T) {
+	address, domain = "192.168.123.1", "hyper.local."
+	defer func() { address, domain = "", "" }()
+
+	expected := "nameserver 192.168.123.1\nsearch example.com web.hyper.local hyper.local\noptions ndots:2\n"
+	if conf := ResolvConf("Web", []string{"example.com"}, []string{"ndots:2"}); conf != expected {
+		t.Fatalf("incorrect resolv.conf: %q", conf)
+	}
+	expected = "nameserver 192.168.123.1\nsearch hyper.local\n"
+	if conf := ResolvConf("web_1", nil, nil); conf != expected {
+		t.Fatalf("incorrect resolv.conf: %q", conf)
+	}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/hyperhq/hyperd/lib/metrics"
+)
+
+var (
+	httpRequests = metrics.NewCounterVec("hyperd_http_requests_total",
+		"Total REST API requests.", "method", "route", "code")
+	httpLatency = metrics.NewHistogramVec("hyperd_http_request_duration_seconds",
+		"Latency of REST API requests.", nil, "method", "route")
+)
+
+func init() {
+	metrics.MustRegister(httpRequests, httpLatency)
+}
+
+// statusRecorder records the status code written to the response, the
+// hijacking, flushing and close notification of the underlying writer
+// are kept for the attach and streaming handlers.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if r.status == 0 {
+		r.status = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	// a hijacked connection is reported as switching protocols
+	if r.status == 0 {
+		r.status = http.StatusSwitchingProtocols
+	}
+	return r.ResponseWriter.(http.Hijacker).Hijack()
+}
+
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) CloseNotify() <-chan bool {
+	return r.ResponseWriter.(http.CloseNotifier).CloseNotify()
+}
+
+func observeHTTPRequest(r *http.Request, rec *statusRecorder, start time.Time) {
+	route := "unknown"
+	if cur := mux.CurrentRoute(r); cur != nil {
+		if tpl, err := cur.GetPathTemplate(); err == nil {
+			route = tpl
+		}
+	}
+	status := rec.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	httpRequests.Inc(r.Method, route, strconv.Itoa(status))
+	httpLatency.Observe(time.Since(start).Seconds(), r.Method, route)
+}
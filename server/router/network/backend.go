@@ -0,0 +1,14 @@
+package network
+
+import (
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// Backend is the methods that need to be implemented to provide
+// system specific functionality.
+type Backend interface {
+	CmdCreateNetwork(data []byte) (*apitypes.Network, error)
+	CmdListNetworks() ([]*apitypes.Network, error)
+	CmdInspectNetwork(name string) (*apitypes.NetworkInfo, error)
+	CmdRemoveNetwork(name string) error
+}
@@ -0,0 +1,62 @@
+package network
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hyperhq/hyperd/server/httputils"
+	"golang.org/x/net/context"
+)
+
+func (n *networkRouter) getNetworks(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	data, err := n.backend.CmdListNetworks()
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (n *networkRouter) getNetworkInspect(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	data, err := n.backend.CmdInspectNetwork(r.Form.Get("name"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, data)
+}
+
+func (n *networkRouter) postNetworkCreate(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	data, err := n.backend.CmdCreateNetwork(body)
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusCreated, data)
+}
+
+func (n *networkRouter) deleteNetwork(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	if err := n.backend.CmdRemoveNetwork(r.Form.Get("name")); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -1,6 +1,8 @@
 package pod
 
 import (
+	"io"
+
 	"github.com/hyperhq/hyperd/engine"
 )
 
@@ -11,6 +13,8 @@ type Backend interface {
 	CmdGetPodStats(podId string) (interface{}, error)
 	CmdCreatePod(podArgs string) (*engine.Env, error)
 	CmdSetPodLabels(podId string, override bool, labels map[string]string) (*engine.Env, error)
+	CmdUpdatePod(podId, podArgs string, dryRun bool) (*engine.Env, error)
+	CmdApplyPods(podArgs, managedBy string, prune, recreate, dryRun bool) (*engine.Env, error)
 	CmdStartPod(podId string) (*engine.Env, error)
 	CmdPausePod(podId string) error
 	CmdUnpausePod(podId string) error
@@ -23,4 +27,11 @@ type Backend interface {
 	CmdListPortMappings(podId string) (*engine.Env, error)
 	CmdAddPortMappings(podId string, pms []byte) (*engine.Env, error)
 	CmdDeletePortMappings(podId string, pms []byte) (*engine.Env, error)
+
+	//bandwidth
+	CmdSetPodBandwidth(podId, ifId string, bw []byte) (*engine.Env, error)
+
+	//port forward
+	CmdDialPort(podId string, port int32) (io.ReadWriteCloser, error)
+	CmdTunnelPort(stdin io.ReadCloser, stdout io.WriteCloser, conn io.ReadWriteCloser, podId string, port int32) error
 }
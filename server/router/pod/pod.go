@@ -26,13 +26,17 @@ func NewRouter(b Backend) router.Router {
 		// POST
 		local.NewPostRoute("/pod/create", r.postPodCreate),
 		local.NewPostRoute("/pod/labels", r.postPodLabels),
+		local.NewPostRoute("/pod/update", r.postPodUpdate),
+		local.NewPostRoute("/pod/apply", r.postPodApply),
 		local.NewPostRoute("/pod/start", r.postPodStart),
 		local.NewPostRoute("/pod/stop", r.postPodStop),
 		local.NewPostRoute("/pod/kill", r.postPodKill),
 		local.NewPostRoute("/pod/pause", r.postPodPause),
 		local.NewPostRoute("/pod/unpause", r.postPodUnpause),
+		local.NewPostRoute("/pod/{id}/portforward", r.postPodPortForward),
 		// PUT
 		local.NewPutRoute("/pod/{id}/portmappings/{action}", r.putPortMappings),
+		local.NewPutRoute("/pod/{id}/interfaces/{interface}/bandwidth", r.putPodBandwidth),
 		// DELETE
 		local.NewDeleteRoute("/pod", r.deletePod),
 	}
@@ -2,8 +2,11 @@ package pod
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 
 	"github.com/golang/glog"
 	"github.com/hyperhq/hyperd/server/httputils"
@@ -100,6 +103,48 @@ func (p *podRouter) postPodLabels(ctx context.Context, w http.ResponseWriter, r
 	return env.WriteJSON(w, http.StatusCreated)
 }
 
+func (p *podRouter) postPodUpdate(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	podId := r.Form.Get("podId")
+	podArgs, _ := ioutil.ReadAll(r.Body)
+	glog.V(1).Infof("Args string is %s", string(podArgs))
+
+	env, err := p.backend.CmdUpdatePod(podId, string(podArgs), httputils.BoolValue(r, "dryRun"))
+	if err != nil {
+		return err
+	}
+
+	return env.WriteJSON(w, http.StatusOK)
+}
+
+func (p *podRouter) postPodApply(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	podArgs, _ := ioutil.ReadAll(r.Body)
+	glog.V(1).Infof("Args string is %s", string(podArgs))
+
+	env, err := p.backend.CmdApplyPods(string(podArgs), r.Form.Get("managedBy"),
+		httputils.BoolValue(r, "prune"), httputils.BoolValue(r, "recreate"), httputils.BoolValue(r, "dryRun"))
+	if err != nil {
+		return err
+	}
+
+	return env.WriteJSON(w, http.StatusOK)
+}
+
 func (p *podRouter) postPodStart(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
 	if err := httputils.ParseForm(r); err != nil {
 		return err
@@ -230,3 +275,44 @@ func (p *podRouter) putPortMappings(ctx context.Context, w http.ResponseWriter,
 	}
 	return nil
 }
+
+// bandwidth
+func (p *podRouter) putPodBandwidth(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	bw, _ := ioutil.ReadAll(r.Body)
+	_, err := p.backend.CmdSetPodBandwidth(vars["id"], vars["interface"], bw)
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// port forward
+func (p *podRouter) postPodPortForward(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	port, err := strconv.Atoi(r.Form.Get("port"))
+	if err != nil {
+		return err
+	}
+
+	// connect before upgrading, so the failure is returned as an http error
+	conn, err := p.backend.CmdDialPort(vars["id"], int32(port))
+	if err != nil {
+		return err
+	}
+
+	// Setting up the streaming http interface.
+	inStream, outStream, err := httputils.HijackConnection(w)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer httputils.CloseStreams(inStream, outStream)
+
+	fmt.Fprintf(outStream, "HTTP/1.1 101 UPGRADED\r\nContent-Type: application/vnd.docker.raw-stream\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
+
+	return p.backend.CmdTunnelPort(inStream, outStream.(io.WriteCloser), conn, vars["id"], int32(port))
+}
@@ -10,6 +10,6 @@ import (
 type Backend interface {
 	CmdGetServices(podId string) ([]*apitypes.UserService, error)
 	CmdAddService(podId, data string) (*engine.Env, error)
-	CmdUpdateService(podId, services string) (*engine.Env, error)
+	CmdUpdateService(podId, services string, merge bool) (*engine.Env, error)
 	CmdDeleteService(podId, services string) (*engine.Env, error)
 }
@@ -44,7 +44,7 @@ func (s *serviceRouter) postServiceUpdate(ctx context.Context, w http.ResponseWr
 	podId := r.Form.Get("podId")
 	services := r.Form.Get("services")
 
-	data, err := s.backend.CmdUpdateService(podId, services)
+	data, err := s.backend.CmdUpdateService(podId, services, httputils.BoolValue(r, "merge"))
 	if err != nil {
 		return err
 	}
@@ -39,6 +39,15 @@ func (s *systemRouter) getInfo(ctx context.Context, w http.ResponseWriter, r *ht
 		status = append(status, [2]string{driverStatus.Name, driverStatus.Status})
 	}
 	env.SetJson("DriverStatus", status)
+	if info.Capacity != nil {
+		env.SetJson("Capacity", info.Capacity)
+	}
+	if len(info.IpConflicts) > 0 {
+		env.SetJson("IPConflicts", info.IpConflicts)
+	}
+	if len(info.MissingNetworks) > 0 {
+		env.SetJson("MissingNetworks", info.MissingNetworks)
+	}
 
 	if info.Name != "" {
 		env.SetJson("Name", info.Name)
@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	ddaemon "github.com/docker/docker/daemon"
 	"github.com/docker/docker/pkg/authorization"
@@ -17,6 +18,7 @@ import (
 	"github.com/hyperhq/hyperd/server/router/build"
 	"github.com/hyperhq/hyperd/server/router/container"
 	"github.com/hyperhq/hyperd/server/router/local"
+	"github.com/hyperhq/hyperd/server/router/network"
 	"github.com/hyperhq/hyperd/server/router/pod"
 	"github.com/hyperhq/hyperd/server/router/service"
 	"github.com/hyperhq/hyperd/server/router/system"
@@ -161,6 +163,10 @@ func (s *Server) makeHTTPHandler(handler httputils.APIFunc) http.HandlerFunc {
 		ctx := context.Background()
 		handlerFunc := s.handleWithGlobalMiddlewares(handler)
 
+		rec := &statusRecorder{ResponseWriter: w}
+		defer observeHTTPRequest(r, rec, time.Now())
+		w = rec
+
 		vars := mux.Vars(r)
 		if vars == nil {
 			vars = make(map[string]string)
@@ -178,6 +184,7 @@ func (s *Server) InitRouters(d *daemon.Daemon) {
 	s.addRouter(container.NewRouter(d))
 	s.addRouter(pod.NewRouter(d))
 	s.addRouter(service.NewRouter(d))
+	s.addRouter(network.NewRouter(d))
 	s.addRouter(local.NewRouter(d))
 	s.addRouter(system.NewRouter(d))
 	s.addRouter(build.NewRouter(d))
@@ -150,3 +150,8 @@ func (s *ServerRPC) ImageRemove(ctx context.Context, req *types.ImageRemoveReque
 		Images: resp,
 	}, nil
 }
+
+// ImageGC removes unused images to reclaim disk space
+func (s *ServerRPC) ImageGC(ctx context.Context, req *types.ImageGCRequest) (*types.ImageGCResponse, error) {
+	return s.daemon.ImageGC.Run(req.DryRun, req.Force)
+}
@@ -56,3 +56,8 @@ func (s *ServerRPC) Version(c context.Context, req *types.VersionRequest) (*type
 func (s *ServerRPC) Ping(c context.Context, req *types.PingRequest) (*types.PingResponse, error) {
 	return &types.PingResponse{HyperdStats: "OK"}, nil
 }
+
+// ReloadConfig reloads the config file of hyperd
+func (s *ServerRPC) ReloadConfig(c context.Context, req *types.ReloadConfigRequest) (*types.ReloadConfigResponse, error) {
+	return s.daemon.ReloadConfig()
+}
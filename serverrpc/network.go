@@ -0,0 +1,52 @@
+package serverrpc
+
+import (
+	"fmt"
+
+	"github.com/hyperhq/hyperd/types"
+	"golang.org/x/net/context"
+)
+
+// NetworkCreate creates a named network
+func (s *ServerRPC) NetworkCreate(ctx context.Context, req *types.NetworkCreateRequest) (*types.NetworkCreateResponse, error) {
+	if req.Network == nil {
+		return nil, fmt.Errorf("Network is required for NetworkCreate")
+	}
+
+	n, err := s.daemon.CreateNetwork(req.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.NetworkCreateResponse{
+		Network: n,
+	}, nil
+}
+
+// NetworkList gets a list of the named networks
+func (s *ServerRPC) NetworkList(ctx context.Context, req *types.NetworkListRequest) (*types.NetworkListResponse, error) {
+	return &types.NetworkListResponse{
+		Networks: s.daemon.ListNetworks(),
+	}, nil
+}
+
+// NetworkInspect gets a named network and its endpoints
+func (s *ServerRPC) NetworkInspect(ctx context.Context, req *types.NetworkInspectRequest) (*types.NetworkInspectResponse, error) {
+	info, err := s.daemon.InspectNetwork(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.NetworkInspectResponse{
+		Network: info,
+	}, nil
+}
+
+// NetworkRemove removes a named network without endpoints
+func (s *ServerRPC) NetworkRemove(ctx context.Context, req *types.NetworkRemoveRequest) (*types.NetworkRemoveResponse, error) {
+	if err := s.daemon.RemoveNetwork(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.NetworkRemoveResponse{}, nil
+}
@@ -47,6 +47,34 @@ func (s *ServerRPC) PodRemove(ctx context.Context, req *types.PodRemoveRequest)
 	}, nil
 }
 
+// PodUpdate applies a new spec to a running pod
+func (s *ServerRPC) PodUpdate(ctx context.Context, req *types.PodUpdateRequest) (*types.PodUpdateResponse, error) {
+	if req.PodSpec == nil {
+		return nil, fmt.Errorf("PodSpec is required for PodUpdate")
+	}
+
+	changes, err := s.daemon.UpdatePod(req.PodID, req.PodSpec, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PodUpdateResponse{
+		Changes: changes,
+	}, nil
+}
+
+// PodApply creates, updates or removes the pods to match the specs
+func (s *ServerRPC) PodApply(ctx context.Context, req *types.PodApplyRequest) (*types.PodApplyResponse, error) {
+	results, err := s.daemon.ApplyPods(req.Pods, req.ManagedBy, req.Prune, req.Recreate, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PodApplyResponse{
+		Results: results,
+	}, nil
+}
+
 // PodStop stops a pod
 func (s *ServerRPC) PodStop(ctx context.Context, req *types.PodStopRequest) (*types.PodStopResponse, error) {
 	code, cause, err := s.daemon.StopPod(req.PodID)
@@ -262,3 +290,18 @@ func convertRunvFsToGrpcType(fs runvtypes.FsStats) *types.FsStats {
 	grpcFs.WeightedIoTime = fs.WeightedIoTime
 	return grpcFs
 }
+
+// PodSetBandwidth changes the rate limits of an interface of a Pod
+func (s *ServerRPC) PodSetBandwidth(ctx context.Context, req *types.PodSetBandwidthRequest) (*types.PodSetBandwidthResponse, error) {
+	p, ok := s.daemon.PodList.Get(req.PodID)
+	if !ok {
+		return nil, fmt.Errorf("Pod not found")
+	}
+
+	err := p.SetInterfaceBandwidth(req.InterfaceID, req.Bandwidth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PodSetBandwidthResponse{}, nil
+}
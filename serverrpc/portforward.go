@@ -0,0 +1,75 @@
+package serverrpc
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// PortForward tunnels a TCP connection to a port of the specified pod
+func (s *ServerRPC) PortForward(stream types.PublicAPI_PortForwardServer) error {
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("stream.Recv error: %v", err)
+	}
+	glog.V(3).Infof("PortForward with ServerStream %s request %s", stream, req.String())
+
+	ir, iw := io.Pipe()
+	or, ow := io.Pipe()
+
+	go func() {
+		data := req.Data
+		for {
+			if len(data) > 0 {
+				if _, err := iw.Write(data); err != nil {
+					glog.Errorf("Write pipe error: %v", err)
+					return
+				}
+			}
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				iw.Close()
+				return
+			}
+			if err != nil {
+				iw.CloseWithError(err)
+				return
+			}
+			data = msg.Data
+		}
+	}()
+
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		defer or.Close()
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := or.Read(buf)
+			if n > 0 {
+				if err := stream.Send(&types.PortForwardMessage{Data: buf[:n]}); err != nil {
+					glog.Errorf("Send to stream error: %v", err)
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	err = s.daemon.PortForward(ir, ow, req.PodID, req.Port)
+	ir.Close()
+	ow.Close()
+	<-sent
+	if err != nil {
+		return fmt.Errorf("s.daemon.PortForward with request %s error: %v", req.String(), err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/daemon"
+	"github.com/hyperhq/hyperd/lib/metrics"
 	"github.com/hyperhq/hyperd/types"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -18,6 +19,22 @@ type ServerRPC struct {
 	daemon *daemon.Daemon
 }
 
+var (
+	grpcRequests = metrics.NewCounterVec("hyperd_grpc_requests_total",
+		"Total gRPC requests.", "method", "code")
+	grpcLatency = metrics.NewHistogramVec("hyperd_grpc_request_duration_seconds",
+		"Latency of gRPC requests.", nil, "method")
+)
+
+func init() {
+	metrics.MustRegister(grpcRequests, grpcLatency)
+}
+
+func observeRequest(method string, elapsed time.Duration, err error) {
+	grpcRequests.Inc(method, grpc.Code(err).String())
+	grpcLatency.Observe(elapsed.Seconds(), method)
+}
+
 type re interface {
 	String() string
 }
@@ -29,6 +46,7 @@ func unaryLoger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo
 	start := time.Now()
 	resp, err = handler(ctx, req)
 	elapsed := time.Now().Sub(start)
+	observeRequest(info.FullMethod, elapsed, err)
 
 	if err == nil {
 		glog.V(3).Infof("%s elapsed %s done %v with request %s", info.FullMethod, elapsed, resp.(re).String(), reqMsg)
@@ -45,6 +63,7 @@ func streamLoger(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerI
 	start := time.Now()
 	err := handler(srv, ss)
 	elapsed := time.Now().Sub(start)
+	observeRequest(info.FullMethod, elapsed, err)
 
 	if err == nil {
 		glog.V(3).Infof("%s elapsed %s done with ServerStream %v", info.FullMethod, elapsed, ss)
@@ -1 +1,32 @@
 package serverrpc
+
+import (
+	"github.com/hyperhq/hyperd/types"
+	"golang.org/x/net/context"
+)
+
+// VMFactoryStatus gets the status of the VM factory of the runtime
+func (s *ServerRPC) VMFactoryStatus(ctx context.Context, req *types.VMFactoryStatusRequest) (*types.VMFactoryStatusResponse, error) {
+	f, err := s.daemon.RuntimeFactory(req.Runtime)
+	if err != nil {
+		return nil, err
+	}
+	return f.Status(), nil
+}
+
+// VMFactoryUpdate adds, resizes, drops or warms up a VM factory profile of
+// the runtime
+func (s *ServerRPC) VMFactoryUpdate(ctx context.Context, req *types.VMFactoryUpdateRequest) (*types.VMFactoryUpdateResponse, error) {
+	f, err := s.daemon.RuntimeFactory(req.Runtime)
+	if err != nil {
+		return nil, err
+	}
+	err = f.Update(req.Action, req.Profile, int(req.Count))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VMFactoryUpdateResponse{
+		Profiles: f.Status().Profiles,
+	}, nil
+}
@@ -5,18 +5,51 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Unknwon/goconfig"
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/utils"
 )
 
+// RegistrySectionPrefix is the prefix of the config sections describing
+// a registry, such as [Registry.registry.example.com:5000]
+const RegistrySectionPrefix = "Registry."
+
+// RegistryConfig is the per registry configuration, the CA is the path of
+// the CA bundle used to verify the registry, and the credentials are used
+// when the pull request does not carry any.
+type RegistryConfig struct {
+	CA       string
+	Insecure bool
+	Username string
+	Password string
+	Auth     string
+	Email    string
+}
+
+// RuntimeSectionPrefix is the prefix of the config sections describing a
+// runtime profile of the pods, such as [Runtime.newkernel]
+const RuntimeSectionPrefix = "Runtime."
+
+// RuntimeConfig is a named profile of the hypervisor booting the sandboxes,
+// selected by the runtime of the pod spec. The keys absent in the section
+// are inherited from the default runtime.
+type RuntimeConfig struct {
+	Driver          string
+	Kernel          string
+	Initrd          string
+	EnableVsock     bool
+	VmFactoryPolicy string
+}
+
 type HyperConfig struct {
 	ConfigFile string
 
 	Root            string
 	Host            string
 	GRPCHost        string
+	MetricsHost     string
 	StorageDriver   string
 	StorageBaseSize string
 	VmFactoryPolicy string
@@ -26,10 +59,40 @@ type HyperConfig struct {
 	Bridge          string
 	BridgeIP        string
 	DisableIptables bool
+	UserlandProxy   bool
+	NetworkPlugin   string
+	CniConfDir      string
+	CniBinDir       string
+	DisableDns      bool
+	DnsDomain       string
+	DnsUpstreams    []string
 	EnableVsock     bool
 	DefaultLog      string
 	DefaultLogOpt   map[string]string
 	GDBTCPPort      int
+	LogVerbosity    int
+	ReloadOnSighup  bool
+
+	ImageGCHighThreshold int
+	ImageGCLowThreshold  int
+	ImageGCInterval      time.Duration
+	ImageGCMinAge        time.Duration
+
+	RegistryMirrors    []string
+	InsecureRegistries []string
+	Registries         map[string]*RegistryConfig
+
+	Runtimes map[string]*RuntimeConfig
+
+	AllocatableCPU        int
+	AllocatableMemory     int
+	CPUOvercommitRatio    float64
+	MemoryOvercommitRatio float64
+
+	EvictionMemoryAvailable  int
+	EvictionStorageThreshold int
+	EvictionLogThreshold     int
+	EvictionInterval         time.Duration
 
 	logPrefix string
 }
@@ -67,11 +130,21 @@ func NewHyperConfig(config string) *HyperConfig {
 	driver, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "Hypervisor")
 	c.Driver = strings.ToLower(driver)
 	c.DisableIptables = cfg.MustBool(goconfig.DEFAULT_SECTION, "DisableIptables", false)
+	c.UserlandProxy = cfg.MustBool(goconfig.DEFAULT_SECTION, "UserlandProxy", false)
+	c.NetworkPlugin, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "NetworkPlugin")
+	c.CniConfDir, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "CniConfDir")
+	c.CniBinDir, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "CniBinDir")
+	c.DisableDns = cfg.MustBool(goconfig.DEFAULT_SECTION, "DisableDns", false)
+	c.DnsDomain, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "DnsDomain")
+	c.DnsUpstreams = cfg.MustValueArray(goconfig.DEFAULT_SECTION, "DnsUpstreams", ",")
 	c.EnableVsock = cfg.MustBool(goconfig.DEFAULT_SECTION, "EnableVsock", false)
 	c.DefaultLog, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Logger")
 	c.DefaultLogOpt, _ = cfg.GetSection("Log")
 	c.VmFactoryPolicy, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "VmFactoryPolicy")
 	c.GRPCHost, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "gRPCHost")
+	c.MetricsHost, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "MetricsHost")
+	c.LogVerbosity = cfg.MustInt(goconfig.DEFAULT_SECTION, "LogVerbosity", -1)
+	c.ReloadOnSighup = cfg.MustBool(goconfig.DEFAULT_SECTION, "ReloadOnSighup", false)
 	port, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "GDBTCPPort")
 	if port != "" {
 		c.GDBTCPPort, err = strconv.Atoi(port)
@@ -81,6 +154,83 @@ func NewHyperConfig(config string) *HyperConfig {
 		}
 	}
 
+	c.RegistryMirrors = cfg.MustValueArray(goconfig.DEFAULT_SECTION, "RegistryMirrors", ",")
+	c.InsecureRegistries = cfg.MustValueArray(goconfig.DEFAULT_SECTION, "InsecureRegistries", ",")
+	c.Registries = make(map[string]*RegistryConfig)
+	for _, section := range cfg.GetSectionList() {
+		if !strings.HasPrefix(section, RegistrySectionPrefix) {
+			continue
+		}
+		host := strings.TrimPrefix(section, RegistrySectionPrefix)
+		r := &RegistryConfig{
+			CA:       cfg.MustValue(section, "CA"),
+			Insecure: cfg.MustBool(section, "Insecure", false),
+			Username: cfg.MustValue(section, "Username"),
+			Password: cfg.MustValue(section, "Password"),
+			Auth:     cfg.MustValue(section, "Auth"),
+			Email:    cfg.MustValue(section, "Email"),
+		}
+		if r.Insecure {
+			c.InsecureRegistries = append(c.InsecureRegistries, host)
+		}
+		c.Registries[host] = r
+	}
+
+	c.Runtimes = make(map[string]*RuntimeConfig)
+	for _, section := range cfg.GetSectionList() {
+		if !strings.HasPrefix(section, RuntimeSectionPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(section, RuntimeSectionPrefix)
+		c.Runtimes[name] = &RuntimeConfig{
+			Driver:          strings.ToLower(cfg.MustValue(section, "Hypervisor", c.Driver)),
+			Kernel:          cfg.MustValue(section, "Kernel", c.Kernel),
+			Initrd:          cfg.MustValue(section, "Initrd", c.Initrd),
+			EnableVsock:     cfg.MustBool(section, "EnableVsock", c.EnableVsock),
+			VmFactoryPolicy: cfg.MustValue(section, "VmFactoryPolicy"),
+		}
+	}
+
+	c.AllocatableCPU = cfg.MustInt(goconfig.DEFAULT_SECTION, "AllocatableCPU", 0)
+	c.AllocatableMemory = cfg.MustInt(goconfig.DEFAULT_SECTION, "AllocatableMemory", 0)
+	c.CPUOvercommitRatio = cfg.MustFloat64(goconfig.DEFAULT_SECTION, "CPUOvercommitRatio", 0)
+	c.MemoryOvercommitRatio = cfg.MustFloat64(goconfig.DEFAULT_SECTION, "MemoryOvercommitRatio", 0)
+	if c.AllocatableCPU < 0 || c.AllocatableMemory < 0 || c.CPUOvercommitRatio < 0 || c.MemoryOvercommitRatio < 0 {
+		c.Log(hlog.ERROR, "invalid allocatable capacity: cpu %d, memory %d, overcommit ratios %v, %v",
+			c.AllocatableCPU, c.AllocatableMemory, c.CPUOvercommitRatio, c.MemoryOvercommitRatio)
+		return nil
+	}
+
+	c.ImageGCHighThreshold = cfg.MustInt(goconfig.DEFAULT_SECTION, "ImageGCHighThreshold", 0)
+	c.ImageGCLowThreshold = cfg.MustInt(goconfig.DEFAULT_SECTION, "ImageGCLowThreshold", 0)
+	if c.ImageGCHighThreshold < 0 || c.ImageGCHighThreshold > 100 || c.ImageGCLowThreshold < 0 || c.ImageGCLowThreshold > c.ImageGCHighThreshold {
+		c.Log(hlog.ERROR, "invalid image gc thresholds: high %d, low %d", c.ImageGCHighThreshold, c.ImageGCLowThreshold)
+		return nil
+	}
+	c.EvictionMemoryAvailable = cfg.MustInt(goconfig.DEFAULT_SECTION, "EvictionMemoryAvailable", 0)
+	c.EvictionStorageThreshold = cfg.MustInt(goconfig.DEFAULT_SECTION, "EvictionStorageThreshold", 0)
+	c.EvictionLogThreshold = cfg.MustInt(goconfig.DEFAULT_SECTION, "EvictionLogThreshold", 0)
+	if c.EvictionMemoryAvailable < 0 || c.EvictionStorageThreshold < 0 || c.EvictionStorageThreshold > 100 || c.EvictionLogThreshold < 0 || c.EvictionLogThreshold > 100 {
+		c.Log(hlog.ERROR, "invalid eviction thresholds: memory available %d, storage %d, log %d",
+			c.EvictionMemoryAvailable, c.EvictionStorageThreshold, c.EvictionLogThreshold)
+		return nil
+	}
+	for key, d := range map[string]*time.Duration{
+		"ImageGCInterval":  &c.ImageGCInterval,
+		"ImageGCMinAge":    &c.ImageGCMinAge,
+		"EvictionInterval": &c.EvictionInterval,
+	} {
+		v, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, key)
+		if v == "" {
+			continue
+		}
+		*d, err = time.ParseDuration(v)
+		if err != nil {
+			c.Log(hlog.ERROR, "read config file %s %s failed: %v", key, v, err)
+			return nil
+		}
+	}
+
 	c.Log(hlog.INFO, "config items: %#v", c)
 	return c
 }
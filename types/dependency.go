@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	DEPENDENCY_STARTED   = "started"
+	DEPENDENCY_HEALTHY   = "healthy"
+	DEPENDENCY_COMPLETED = "completed"
+)
+
+// DependencyCondition returns the condition of the dependency, "started" if
+// it is not specified.
+func (d *UserContainerDependency) DependencyCondition() string {
+	if d.Condition == "" {
+		return DEPENDENCY_STARTED
+	}
+	return d.Condition
+}
+
+// SortContainerDependencies sorts the containers in layers, each container
+// depends only on the containers of the earlier layers. The dependencies on
+// the containers not in the list are ignored, and an error is returned if
+// the dependencies have a cycle.
+func SortContainerDependencies(containers []*UserContainer) ([][]*UserContainer, error) {
+	var (
+		byName  = make(map[string]*UserContainer, len(containers))
+		pending = make(map[*UserContainer]int, len(containers))
+		layers  = [][]*UserContainer{}
+	)
+	for _, c := range containers {
+		if c.Name != "" {
+			byName[c.Name] = c
+		}
+	}
+	for _, c := range containers {
+		pending[c] = 0
+		for _, d := range c.DependsOn {
+			if _, ok := byName[d.Container]; ok {
+				pending[c]++
+			}
+		}
+	}
+
+	for done := 0; done < len(containers); {
+		var layer []*UserContainer
+		for _, c := range containers {
+			if pending[c] == 0 {
+				layer = append(layer, c)
+			}
+		}
+		if len(layer) == 0 {
+			cycle := []string{}
+			for _, c := range containers {
+				if pending[c] > 0 {
+					cycle = append(cycle, c.Name)
+				}
+			}
+			return nil, fmt.Errorf("dependency cycle among containers %s", strings.Join(cycle, ", "))
+		}
+		for _, c := range layer {
+			pending[c] = -1
+		}
+		for _, c := range containers {
+			if pending[c] <= 0 {
+				continue
+			}
+			for _, d := range c.DependsOn {
+				if dc, ok := byName[d.Container]; ok && containsContainer(layer, dc) {
+					pending[c]--
+				}
+			}
+		}
+		layers = append(layers, layer)
+		done += len(layer)
+	}
+	return layers, nil
+}
+
+func containsContainer(containers []*UserContainer, c *UserContainer) bool {
+	for _, x := range containers {
+		if x == c {
+			return true
+		}
+	}
+	return false
+}
@@ -15,11 +15,12 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type PersistPodLayout struct {
-	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GlobalSpec string   `protobuf:"bytes,11,opt,name=globalSpec,proto3" json:"globalSpec,omitempty"`
-	Containers []string `protobuf:"bytes,21,rep,name=containers" json:"containers,omitempty"`
-	Volumes    []string `protobuf:"bytes,22,rep,name=volumes" json:"volumes,omitempty"`
-	Interfaces []string `protobuf:"bytes,23,rep,name=interfaces" json:"interfaces,omitempty"`
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GlobalSpec     string   `protobuf:"bytes,11,opt,name=globalSpec,proto3" json:"globalSpec,omitempty"`
+	Containers     []string `protobuf:"bytes,21,rep,name=containers" json:"containers,omitempty"`
+	Volumes        []string `protobuf:"bytes,22,rep,name=volumes" json:"volumes,omitempty"`
+	Interfaces     []string `protobuf:"bytes,23,rep,name=interfaces" json:"interfaces,omitempty"`
+	InitContainers []string `protobuf:"bytes,24,rep,name=initContainers" json:"initContainers,omitempty"`
 }
 
 func (m *PersistPodLayout) Reset()                    { *m = PersistPodLayout{} }
@@ -62,11 +63,22 @@ func (m *PersistPodLayout) GetInterfaces() []string {
 	return nil
 }
 
+func (m *PersistPodLayout) GetInitContainers() []string {
+	if m != nil {
+		return m.InitContainers
+	}
+	return nil
+}
+
 type PersistPodMeta struct {
-	Id        string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Services  []*UserService    `protobuf:"bytes,11,rep,name=services" json:"services,omitempty"`
-	Labels    map[string]string `protobuf:"bytes,12,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	CreatedAt int64             `protobuf:"varint,21,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Services    []*UserService    `protobuf:"bytes,11,rep,name=services" json:"services,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,12,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt   int64             `protobuf:"varint,21,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	FinishedAt  int64             `protobuf:"varint,22,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	ExitCodes   map[string]int32  `protobuf:"bytes,13,rep,name=exitCodes" json:"exitCodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	StopReason  string            `protobuf:"bytes,14,opt,name=stopReason,proto3" json:"stopReason,omitempty"`
+	StopMessage string            `protobuf:"bytes,15,opt,name=stopMessage,proto3" json:"stopMessage,omitempty"`
 }
 
 func (m *PersistPodMeta) Reset()                    { *m = PersistPodMeta{} }
@@ -102,6 +114,34 @@ func (m *PersistPodMeta) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *PersistPodMeta) GetFinishedAt() int64 {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return 0
+}
+
+func (m *PersistPodMeta) GetExitCodes() map[string]int32 {
+	if m != nil {
+		return m.ExitCodes
+	}
+	return nil
+}
+
+func (m *PersistPodMeta) GetStopReason() string {
+	if m != nil {
+		return m.StopReason
+	}
+	return ""
+}
+
+func (m *PersistPodMeta) GetStopMessage() string {
+	if m != nil {
+		return m.StopMessage
+	}
+	return ""
+}
+
 type SandboxPersistInfo struct {
 	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	PersistInfo []byte `protobuf:"bytes,2,opt,name=PersistInfo,proto3" json:"PersistInfo,omitempty"`
@@ -211,6 +251,7 @@ type PersistInterface struct {
 	Pod      string                    `protobuf:"bytes,2,opt,name=pod,proto3" json:"pod,omitempty"`
 	Spec     *UserInterface            `protobuf:"bytes,11,opt,name=spec" json:"spec,omitempty"`
 	Descript *api.InterfaceDescription `protobuf:"bytes,12,opt,name=descript" json:"descript,omitempty"`
+	Cni      *PersistCni               `protobuf:"bytes,13,opt,name=cni" json:"cni,omitempty"`
 }
 
 func (m *PersistInterface) Reset()                    { *m = PersistInterface{} }
@@ -246,6 +287,128 @@ func (m *PersistInterface) GetDescript() *api.InterfaceDescription {
 	return nil
 }
 
+func (m *PersistInterface) GetCni() *PersistCni {
+	if m != nil {
+		return m.Cni
+	}
+	return nil
+}
+
+type PersistCni struct {
+	ContainerID   string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Ifname        string `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Config        []byte `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Result        []byte `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	HostVeth      string `protobuf:"bytes,5,opt,name=hostVeth,proto3" json:"hostVeth,omitempty"`
+	Bridge        string `protobuf:"bytes,6,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	RuntimeConfig []byte `protobuf:"bytes,7,opt,name=runtimeConfig,proto3" json:"runtimeConfig,omitempty"`
+	// the resolv.conf of the DNS in the result
+	ResolvConf string `protobuf:"bytes,8,opt,name=resolvConf,proto3" json:"resolvConf,omitempty"`
+}
+
+func (m *PersistCni) Reset()                    { *m = PersistCni{} }
+func (m *PersistCni) String() string            { return proto.CompactTextString(m) }
+func (*PersistCni) ProtoMessage()               {}
+func (*PersistCni) Descriptor() ([]byte, []int) { return fileDescriptorPersist, []int{6} }
+
+func (m *PersistCni) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *PersistCni) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PersistCni) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *PersistCni) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *PersistCni) GetHostVeth() string {
+	if m != nil {
+		return m.HostVeth
+	}
+	return ""
+}
+
+func (m *PersistCni) GetBridge() string {
+	if m != nil {
+		return m.Bridge
+	}
+	return ""
+}
+
+func (m *PersistCni) GetRuntimeConfig() []byte {
+	if m != nil {
+		return m.RuntimeConfig
+	}
+	return nil
+}
+
+func (m *PersistCni) GetResolvConf() string {
+	if m != nil {
+		return m.ResolvConf
+	}
+	return ""
+}
+
+// PersistIPAllocation is an address allocated to an interface of a pod from
+// the default bridge, or the named network if not empty.
+type PersistIPAllocation struct {
+	Ip        string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Network   string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Pod       string `protobuf:"bytes,3,opt,name=pod,proto3" json:"pod,omitempty"`
+	Interface string `protobuf:"bytes,4,opt,name=interface,proto3" json:"interface,omitempty"`
+}
+
+func (m *PersistIPAllocation) Reset()                    { *m = PersistIPAllocation{} }
+func (m *PersistIPAllocation) String() string            { return proto.CompactTextString(m) }
+func (*PersistIPAllocation) ProtoMessage()               {}
+func (*PersistIPAllocation) Descriptor() ([]byte, []int) { return fileDescriptorPersist, []int{7} }
+
+func (m *PersistIPAllocation) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *PersistIPAllocation) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *PersistIPAllocation) GetPod() string {
+	if m != nil {
+		return m.Pod
+	}
+	return ""
+}
+
+func (m *PersistIPAllocation) GetInterface() string {
+	if m != nil {
+		return m.Interface
+	}
+	return ""
+}
+
 type PersistPortmappings struct {
 	Pod          string         `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
 	ContainerIP  string         `protobuf:"bytes,2,opt,name=containerIP,proto3" json:"containerIP,omitempty"`
@@ -255,7 +418,7 @@ type PersistPortmappings struct {
 func (m *PersistPortmappings) Reset()                    { *m = PersistPortmappings{} }
 func (m *PersistPortmappings) String() string            { return proto.CompactTextString(m) }
 func (*PersistPortmappings) ProtoMessage()               {}
-func (*PersistPortmappings) Descriptor() ([]byte, []int) { return fileDescriptorPersist, []int{6} }
+func (*PersistPortmappings) Descriptor() ([]byte, []int) { return fileDescriptorPersist, []int{8} }
 
 func (m *PersistPortmappings) GetPod() string {
 	if m != nil {
@@ -285,44 +448,61 @@ func init() {
 	proto.RegisterType((*PersistContainer)(nil), "types.PersistContainer")
 	proto.RegisterType((*PersistVolume)(nil), "types.PersistVolume")
 	proto.RegisterType((*PersistInterface)(nil), "types.PersistInterface")
+	proto.RegisterType((*PersistCni)(nil), "types.PersistCni")
+	proto.RegisterType((*PersistIPAllocation)(nil), "types.PersistIPAllocation")
 	proto.RegisterType((*PersistPortmappings)(nil), "types.PersistPortmappings")
 }
 
 func init() { proto.RegisterFile("persist.proto", fileDescriptorPersist) }
 
 var fileDescriptorPersist = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x5d, 0x6f, 0xd3, 0x3e,
-	0x14, 0xc6, 0x95, 0x76, 0xdb, 0x7f, 0x3b, 0xe9, 0xa6, 0xfe, 0xcd, 0x36, 0x4c, 0x85, 0x50, 0xa8,
-	0x84, 0xd4, 0xab, 0x54, 0x2a, 0x02, 0x31, 0xee, 0x10, 0x2f, 0x52, 0xa5, 0x4d, 0xaa, 0x52, 0xc1,
-	0xbd, 0x93, 0x78, 0xad, 0x45, 0x6a, 0x1b, 0xdb, 0xa9, 0xc8, 0x25, 0xdf, 0x80, 0x1b, 0xee, 0xf8,
-	0x78, 0x7c, 0x10, 0x14, 0xc7, 0x79, 0x29, 0xad, 0xc4, 0x05, 0x77, 0xf6, 0x73, 0x9e, 0xf3, 0xf4,
-	0x97, 0x63, 0xbb, 0x70, 0x2e, 0xa9, 0xd2, 0x4c, 0x9b, 0x50, 0x2a, 0x61, 0x04, 0x3a, 0x36, 0x85,
-	0xa4, 0x7a, 0x14, 0xae, 0x98, 0x59, 0xe7, 0x71, 0x98, 0x88, 0xcd, 0x74, 0x5d, 0x48, 0xaa, 0xd6,
-	0x5f, 0xa6, 0x2a, 0xe7, 0xdb, 0x29, 0x91, 0x6c, 0x9a, 0x52, 0x9d, 0x28, 0x26, 0x0d, 0x13, 0x5c,
-	0x57, 0x6d, 0x23, 0xdf, 0xb6, 0x55, 0x9b, 0xf1, 0x4f, 0x0f, 0x86, 0x8b, 0x2a, 0x75, 0x21, 0xd2,
-	0x5b, 0x52, 0x88, 0xdc, 0xa0, 0x0b, 0xe8, 0xb1, 0x14, 0x7b, 0x81, 0x37, 0x39, 0x8b, 0x7a, 0x2c,
-	0x45, 0x4f, 0x00, 0x56, 0x99, 0x88, 0x49, 0xb6, 0x94, 0x34, 0xc1, 0xbe, 0xd5, 0x3b, 0x4a, 0x59,
-	0x4f, 0x04, 0x37, 0x84, 0x71, 0xaa, 0x34, 0xbe, 0x0a, 0xfa, 0x65, 0xbd, 0x55, 0x10, 0x86, 0xff,
-	0xb6, 0x22, 0xcb, 0x37, 0x54, 0xe3, 0x6b, 0x5b, 0xac, 0xb7, 0x65, 0x27, 0xe3, 0x86, 0xaa, 0x7b,
-	0x92, 0x50, 0x8d, 0x1f, 0x56, 0x9d, 0xad, 0x32, 0xfe, 0xe5, 0xc1, 0x45, 0x8b, 0x77, 0x47, 0x0d,
-	0xd9, 0x83, 0x0b, 0xe1, 0x54, 0x53, 0xb5, 0x65, 0x65, 0x80, 0x1f, 0xf4, 0x27, 0xfe, 0x0c, 0x85,
-	0xd5, 0x17, 0x7e, 0xd4, 0x54, 0x2d, 0xab, 0x52, 0xd4, 0x78, 0xd0, 0x0d, 0x9c, 0x64, 0x24, 0xa6,
-	0x99, 0xc6, 0x03, 0xeb, 0x7e, 0xea, 0xdc, 0xbb, 0x3f, 0x13, 0xde, 0x5a, 0xcf, 0x7b, 0x6e, 0x54,
-	0x11, 0xb9, 0x06, 0xf4, 0x18, 0xce, 0x12, 0x45, 0x89, 0xa1, 0xe9, 0x1b, 0x83, 0xaf, 0x02, 0x6f,
-	0xd2, 0x8f, 0x5a, 0x61, 0x74, 0x03, 0x7e, 0xa7, 0x09, 0x0d, 0xa1, 0xff, 0x99, 0x16, 0x0e, 0xb4,
-	0x5c, 0xa2, 0x4b, 0x38, 0xde, 0x92, 0x2c, 0xa7, 0xb8, 0x67, 0xb5, 0x6a, 0xf3, 0xba, 0xf7, 0xca,
-	0x1b, 0x7f, 0x00, 0xb4, 0x24, 0x3c, 0x8d, 0xc5, 0x57, 0x47, 0x31, 0xe7, 0xf7, 0x62, 0xef, 0x4b,
-	0x03, 0xf0, 0x3b, 0x65, 0x9b, 0x32, 0x88, 0xba, 0xd2, 0xf8, 0x47, 0x7b, 0x9a, 0x6f, 0xeb, 0xf1,
-	0xef, 0xc5, 0x0c, 0xa1, 0x2f, 0x45, 0xea, 0x20, 0xca, 0x25, 0x9a, 0xc0, 0x91, 0xae, 0x4f, 0xd6,
-	0x9f, 0x5d, 0x76, 0xc6, 0xd7, 0xa4, 0x44, 0xd6, 0x81, 0x5e, 0xc0, 0x69, 0x7d, 0xa3, 0xf0, 0xc0,
-	0xba, 0x1f, 0x85, 0x44, 0xb2, 0xb0, 0xf1, 0xbd, 0x6b, 0xef, 0x5b, 0xd4, 0x58, 0xc7, 0xdf, 0x3d,
-	0x38, 0x77, 0x5c, 0x9f, 0xec, 0xc9, 0x23, 0x04, 0x47, 0x9c, 0x6c, 0xa8, 0xc3, 0xb2, 0xeb, 0x03,
-	0x60, 0xcf, 0x76, 0xc0, 0xfe, 0xef, 0x80, 0x55, 0x31, 0x8e, 0x6a, 0xb6, 0x47, 0x75, 0x6d, 0xa9,
-	0x2a, 0xd3, 0x61, 0xa4, 0xce, 0xa8, 0xe6, 0xf5, 0x7d, 0xfb, 0xa7, 0x51, 0x35, 0x29, 0x7f, 0x19,
-	0x55, 0xe3, 0x3b, 0xcc, 0xf5, 0xcd, 0x83, 0x07, 0xcd, 0x55, 0x54, 0x66, 0x43, 0xa4, 0x64, 0x7c,
-	0xa5, 0x6b, 0x14, 0xaf, 0x45, 0x09, 0xc0, 0x6f, 0xde, 0xd8, 0x7c, 0xe1, 0x20, 0xbb, 0x12, 0x7a,
-	0x09, 0x03, 0x29, 0x94, 0xb9, 0x73, 0x19, 0x7f, 0x3c, 0x8f, 0x45, 0x5b, 0x8a, 0x76, 0x7c, 0xf1,
-	0x89, 0xfd, 0x6f, 0x78, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x65, 0x89, 0x38, 0x67, 0x70, 0x04,
-	0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0xaf, 0xe3, 0x34,
+	0x10, 0x56, 0x9a, 0xd7, 0x6e, 0x3b, 0x69, 0xcb, 0xc3, 0xec, 0x16, 0x53, 0xad, 0x50, 0x09, 0x0b,
+	0xea, 0x29, 0x95, 0x1e, 0x02, 0xb1, 0x88, 0xcb, 0xb2, 0xbb, 0x48, 0x4f, 0xda, 0x27, 0x55, 0x79,
+	0x62, 0xef, 0x6e, 0xe2, 0xb6, 0xd6, 0xa6, 0x76, 0xb0, 0xdd, 0xb2, 0x3d, 0xf2, 0x0f, 0xb8, 0xf0,
+	0x67, 0x38, 0x73, 0xe0, 0xe7, 0xf0, 0x13, 0x90, 0xed, 0x24, 0x4e, 0xb7, 0x95, 0xde, 0x81, 0x5b,
+	0xe6, 0x9b, 0x6f, 0x66, 0xbe, 0x99, 0xb1, 0x1d, 0x18, 0x95, 0x54, 0x2a, 0xa6, 0x74, 0x52, 0x4a,
+	0xa1, 0x05, 0xea, 0xea, 0x63, 0x49, 0xd5, 0x34, 0xd9, 0x30, 0xbd, 0xdd, 0xaf, 0x92, 0x4c, 0xec,
+	0x16, 0xdb, 0x63, 0x49, 0xe5, 0xf6, 0xd7, 0x85, 0xdc, 0xf3, 0xc3, 0x82, 0x94, 0x6c, 0x91, 0x53,
+	0x95, 0x49, 0x56, 0x6a, 0x26, 0xb8, 0x72, 0x61, 0xd3, 0xc8, 0x86, 0x39, 0x23, 0xfe, 0x3b, 0x80,
+	0xeb, 0xa5, 0xcb, 0xba, 0x14, 0xf9, 0x1b, 0x72, 0x14, 0x7b, 0x8d, 0xc6, 0xd0, 0x61, 0x39, 0x0e,
+	0x66, 0xc1, 0x7c, 0x90, 0x76, 0x58, 0x8e, 0x3e, 0x07, 0xd8, 0x14, 0x62, 0x45, 0x8a, 0xfb, 0x92,
+	0x66, 0x38, 0xb2, 0x78, 0x0b, 0x31, 0xfe, 0x4c, 0x70, 0x4d, 0x18, 0xa7, 0x52, 0xe1, 0x27, 0xb3,
+	0xd0, 0xf8, 0x3d, 0x82, 0x30, 0x3c, 0x3a, 0x88, 0x62, 0xbf, 0xa3, 0x0a, 0x4f, 0xac, 0xb3, 0x36,
+	0x4d, 0x24, 0xe3, 0x9a, 0xca, 0x35, 0xc9, 0xa8, 0xc2, 0x9f, 0xba, 0x48, 0x8f, 0xa0, 0xaf, 0x61,
+	0xcc, 0x38, 0xd3, 0x2f, 0x7d, 0x76, 0x6c, 0x39, 0x1f, 0xa0, 0xf1, 0x3f, 0x21, 0x8c, 0x7d, 0x1b,
+	0x77, 0x54, 0x93, 0xb3, 0x26, 0x12, 0xe8, 0x2b, 0x2a, 0x0f, 0xcc, 0x14, 0x8a, 0x66, 0xe1, 0x3c,
+	0xba, 0x41, 0x89, 0x9b, 0xc4, 0x2f, 0x8a, 0xca, 0x7b, 0xe7, 0x4a, 0x1b, 0x0e, 0x7a, 0x0e, 0xbd,
+	0x82, 0xac, 0x68, 0xa1, 0xf0, 0xd0, 0xb2, 0xbf, 0xa8, 0xd8, 0xa7, 0x65, 0x92, 0x37, 0x96, 0xf3,
+	0x9a, 0x6b, 0x79, 0x4c, 0xab, 0x00, 0xf4, 0x14, 0x06, 0x99, 0xa4, 0x44, 0xd3, 0xfc, 0x85, 0xc6,
+	0x4f, 0x66, 0xc1, 0x3c, 0x4c, 0x3d, 0x60, 0x7a, 0x5e, 0x33, 0xce, 0xd4, 0xd6, 0xba, 0x27, 0xd6,
+	0xdd, 0x42, 0xd0, 0x4f, 0x30, 0xa0, 0xef, 0x4d, 0x77, 0x39, 0x55, 0x78, 0x64, 0x6b, 0x3f, 0xbb,
+	0x5c, 0xfb, 0x75, 0x4d, 0x73, 0xe5, 0x7d, 0x98, 0xa9, 0xa1, 0xb4, 0x28, 0x53, 0x4a, 0x94, 0xe0,
+	0x78, 0xec, 0x36, 0xe6, 0x11, 0x34, 0x83, 0xc8, 0x58, 0x77, 0x54, 0x29, 0xb2, 0xa1, 0xf8, 0x23,
+	0x4b, 0x68, 0x43, 0xd3, 0xe7, 0x10, 0xb5, 0x5a, 0x43, 0xd7, 0x10, 0xbe, 0xa3, 0xc7, 0x6a, 0x9c,
+	0xe6, 0x13, 0x3d, 0x86, 0xee, 0x81, 0x14, 0x7b, 0x8a, 0x3b, 0x16, 0x73, 0xc6, 0x0f, 0x9d, 0xef,
+	0x83, 0xe9, 0x8f, 0x30, 0x3e, 0x55, 0xf6, 0x50, 0x74, 0xb7, 0x15, 0x1d, 0xff, 0x0c, 0xe8, 0x9e,
+	0xf0, 0x7c, 0x25, 0xde, 0x57, 0xdd, 0xde, 0xf2, 0xb5, 0x38, 0xdb, 0xe6, 0x0c, 0xa2, 0x96, 0xdb,
+	0x66, 0x19, 0xa6, 0x6d, 0x28, 0xfe, 0xd3, 0x9f, 0xec, 0xe6, 0xa0, 0x9c, 0xa5, 0xb9, 0x86, 0xb0,
+	0x14, 0x79, 0xd5, 0x82, 0xf9, 0x44, 0x73, 0xb8, 0x52, 0xf5, 0x29, 0x8f, 0x6e, 0x1e, 0xb7, 0x8e,
+	0x48, 0x93, 0x25, 0xb5, 0x0c, 0xf4, 0x2d, 0xf4, 0xeb, 0xdb, 0x85, 0x87, 0x96, 0xfd, 0x59, 0x42,
+	0x4a, 0x96, 0x34, 0xbc, 0x57, 0xfe, 0xee, 0xa5, 0x0d, 0x35, 0xfe, 0x23, 0x80, 0x51, 0xa5, 0xeb,
+	0xad, 0xbd, 0x05, 0x08, 0xc1, 0x15, 0x27, 0x3b, 0x5a, 0xc9, 0xb2, 0xdf, 0x17, 0x84, 0x7d, 0x75,
+	0x22, 0xec, 0xe3, 0x96, 0x30, 0x97, 0xa6, 0x52, 0x75, 0x73, 0xa6, 0x6a, 0x62, 0x55, 0x39, 0xd2,
+	0x65, 0x49, 0x7f, 0xf9, 0x51, 0xdd, 0xd6, 0x77, 0xef, 0x7f, 0x8d, 0xaa, 0xc9, 0xf2, 0xc0, 0xa8,
+	0x1a, 0xde, 0x45, 0x5d, 0xe8, 0x4b, 0x08, 0x33, 0xce, 0xf0, 0xe8, 0xa4, 0xe3, 0x7a, 0xa7, 0x9c,
+	0xa5, 0xc6, 0x1b, 0xff, 0x1b, 0x00, 0x78, 0xcc, 0x1c, 0x8c, 0xe6, 0xe5, 0xb9, 0x7d, 0x55, 0xe9,
+	0x6f, 0x43, 0x68, 0x02, 0x3d, 0xb6, 0xb6, 0x03, 0x77, 0xbd, 0x54, 0x96, 0xc1, 0x33, 0xc1, 0xd7,
+	0x6c, 0x83, 0x43, 0x7b, 0x9a, 0x2a, 0xcb, 0xe0, 0x92, 0xaa, 0x7d, 0xa1, 0xf1, 0x95, 0xc3, 0x9d,
+	0x85, 0xa6, 0xd0, 0xdf, 0x0a, 0xa5, 0xdf, 0x52, 0xbd, 0xc5, 0x5d, 0x9b, 0xa9, 0xb1, 0x4d, 0xcc,
+	0x4a, 0xb2, 0x7c, 0x43, 0x71, 0xcf, 0xd5, 0x70, 0x16, 0x7a, 0x06, 0x23, 0xb9, 0xe7, 0x9a, 0xed,
+	0xe8, 0x4b, 0x57, 0xea, 0x91, 0x4d, 0x79, 0x0a, 0x9a, 0xdb, 0x2b, 0xa9, 0x12, 0xc5, 0xc1, 0xd8,
+	0xb8, 0xef, 0x6e, 0xaf, 0x47, 0x62, 0x01, 0x9f, 0xd4, 0xeb, 0x5a, 0xbe, 0x28, 0x0a, 0x91, 0x11,
+	0x33, 0x38, 0xbb, 0xb1, 0xb2, 0xd9, 0x58, 0x69, 0x9e, 0x5d, 0x4e, 0xf5, 0x6f, 0x42, 0xbe, 0xab,
+	0x3a, 0xad, 0xcd, 0x7a, 0x97, 0xa1, 0xdf, 0xe5, 0x53, 0x18, 0x34, 0xcf, 0xae, 0xed, 0x73, 0x90,
+	0x7a, 0x20, 0xfe, 0x3d, 0x68, 0x2a, 0x2e, 0x85, 0xd4, 0x3b, 0x52, 0x96, 0x8c, 0x6f, 0x54, 0x9d,
+	0x27, 0xf0, 0x79, 0x4e, 0xc6, 0xbf, 0xac, 0xea, 0xb6, 0x21, 0xf4, 0x1d, 0x0c, 0x4b, 0x21, 0xf5,
+	0x5d, 0x95, 0xe3, 0x83, 0xb7, 0x78, 0xe9, 0x5d, 0xe9, 0x09, 0x6f, 0xd5, 0xb3, 0x3f, 0xac, 0x6f,
+	0xfe, 0x1b, 0x00, 0x1f, 0xe3, 0x63, 0x01, 0x05, 0x07, 0x00, 0x00,
 }
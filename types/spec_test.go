@@ -1,8 +1,12 @@
 package types
 
 import (
+	"encoding/json"
 	"flag"
+	"strings"
 	"testing"
+
+	"gopkg.in/yaml.v2"
 )
 
 func init() {
@@ -620,3 +624,278 @@ func TestMergePortMappings(t *testing.T) {
 		t.Fatalf("failed with udp port overlapped rules: %v", tp.Portmappings)
 	}
 }
+
+func TestPortMappingEqualTo(t *testing.T) {
+	pm := &PortMapping{HostPort: "8080", ContainerPort: "80", Protocol: "tcp"}
+	if !pm.EqualTo(&PortMapping{HostPort: "8080", ContainerPort: "80", Protocol: "tcp"}) {
+		t.Fatal("same port mappings should be equal")
+	}
+	if pm.EqualTo(&PortMapping{HostPort: "8081", ContainerPort: "80", Protocol: "tcp"}) {
+		t.Fatal("port mappings of different host ports should not be equal")
+	}
+	if pm.EqualTo(&PortMapping{HostPort: "80", ContainerPort: "80", Protocol: "tcp"}) {
+		t.Fatal("host port should not be compared with the container port")
+	}
+}
+
+func TestReorganizeInitContainers(t *testing.T) {
+	p := &UserPod{
+		Id:             "pod",
+		InitContainers: []*UserContainer{{Image: "busybox"}},
+		Containers:     []*UserContainer{{Image: "nginx"}, {Name: "named", Image: "nginx"}},
+	}
+	if err := p.ReorganizeContainers(true); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"pod-busybox-init-0", "pod-nginx-0", "named"}
+	all := p.AllContainers()
+	if len(all) != len(expected) {
+		t.Fatalf("expect %d containers, got %d", len(expected), len(all))
+	}
+	for i, c := range all {
+		if c.Name != expected[i] {
+			t.Fatalf("expect container %d named %s, got %s", i, expected[i], c.Name)
+		}
+	}
+	if p.LookupContainer("pod-busybox-init-0") == nil {
+		t.Fatal("failed to lookup init container")
+	}
+}
+
+func TestSortContainerDependencies(t *testing.T) {
+	app := &UserContainer{Name: "app", DependsOn: []*UserContainerDependency{{Container: "proxy"}, {Container: "migrate", Condition: "completed"}}}
+	proxy := &UserContainer{Name: "proxy"}
+	migrate := &UserContainer{Name: "migrate", DependsOn: []*UserContainerDependency{{Container: "db"}}}
+
+	layers, err := SortContainerDependencies([]*UserContainer{app, proxy, migrate})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(layers) != 2 || len(layers[0]) != 2 || layers[1][0] != app {
+		t.Fatalf("unexpected layers %v", layers)
+	}
+
+	proxy.DependsOn = []*UserContainerDependency{{Container: "app"}}
+	if _, err = SortContainerDependencies([]*UserContainer{app, proxy, migrate}); err == nil {
+		t.Fatal("dependency cycle is not detected")
+	}
+
+	migrate.DependsOn = nil
+	pod := &UserPod{Containers: []*UserContainer{app, proxy, migrate}}
+	if err = pod.Validate(); err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("pod with dependency cycle is not rejected: %v", err)
+	}
+}
+
+func TestValidateLifecycleHooks(t *testing.T) {
+	c := &UserContainer{Name: "c", PreStop: &UserContainerHook{Command: []string{"deregister"}}}
+	pod := &UserPod{Containers: []*UserContainer{c}}
+	if err := pod.Validate(); err != nil {
+		t.Fatalf("valid hook is rejected: %v", err)
+	}
+
+	c.PostStart = &UserContainerHook{}
+	if err := pod.Validate(); err == nil {
+		t.Fatal("empty hook is not rejected")
+	}
+
+	c.PostStart = &UserContainerHook{Http: &UserContainerHTTPHook{Port: 70000}}
+	if err := pod.Validate(); err == nil {
+		t.Fatal("hook with incorrect port is not rejected")
+	}
+}
+
+func TestValidateContainerResources(t *testing.T) {
+	c := &UserContainer{Name: "c", Resources: &UserContainerResources{CpuShares: 512, CpuQuota: 50000, Memory: 64, PidsLimit: 100}}
+	pod := &UserPod{Containers: []*UserContainer{c}, Resource: &UserResource{Vcpu: 1, Memory: 128}}
+	if err := pod.Validate(); err != nil {
+		t.Fatalf("valid resources are rejected: %v", err)
+	}
+
+	c.Resources.Memory = 256
+	if err := pod.Validate(); err == nil || !strings.Contains(err.Error(), "exceeds the pod memory") {
+		t.Fatalf("memory limit exceeding the pod is not rejected: %v", err)
+	}
+
+	c.Resources.Memory = 64
+	c.Resources.CpuPeriod = 100
+	if err := pod.Validate(); err == nil {
+		t.Fatal("incorrect cpu period is not rejected")
+	}
+
+	c.Resources.CpuPeriod = 0
+	c.Resources.PidsLimit = -1
+	if err := pod.Validate(); err == nil {
+		t.Fatal("negative pids limit is not rejected")
+	}
+}
+
+func TestValidateNetwork(t *testing.T) {
+	n := &Network{Name: "tenant-a", Subnet: "10.10.0.0/24"}
+	if err := n.Validate(); err != nil {
+		t.Fatalf("valid network is rejected: %v", err)
+	}
+	if n.Gateway != "10.10.0.1" {
+		t.Fatalf("the default gateway should be 10.10.0.1, got %s", n.Gateway)
+	}
+
+	for _, bad := range []*Network{
+		{Name: "Tenant_A", Subnet: "10.10.0.0/24"},
+		{Name: "a", Subnet: "10.10.0.1/24"},
+		{Name: "a", Subnet: "10.10.0.0/31"},
+		{Name: "a", Subnet: "fd00::/64"},
+		{Name: "a", Subnet: "10.10.0.0/24", Gateway: "10.10.1.1"},
+		{Name: "a", Subnet: "10.10.0.0/24", Bridge: "a-very-long-bridge"},
+	} {
+		if err := bad.Validate(); err == nil {
+			t.Fatalf("invalid network %v is not rejected", bad)
+		}
+	}
+
+	pod := &UserPod{Interfaces: []*UserInterface{{Network: "tenant-a", Bridge: "br0"}}}
+	if err := pod.Validate(); err == nil {
+		t.Fatal("interface with both network and bridge is not rejected")
+	}
+}
+
+func TestValidateNetworkPolicy(t *testing.T) {
+	np := &NetworkPolicy{
+		Ingress: []*NetworkPolicyRule{{
+			Peers: []*NetworkPolicyPeer{{Cidr: "10.0.0.0/8"}, {PodSelector: map[string]string{"app": "web"}}},
+			Ports: []*NetworkPolicyPort{{Protocol: "tcp", Port: 80}, {Port: 53}},
+		}},
+	}
+	if err := (&UserPod{NetworkPolicy: np}).Validate(); err != nil {
+		t.Fatalf("valid network policy is rejected: %v", err)
+	}
+	if !np.IsolateIngress() || np.IsolateEgress() {
+		t.Fatal("only the ingress should be isolated by default")
+	}
+	np.PolicyTypes = []string{POLICY_EGRESS}
+	if np.IsolateIngress() || !np.IsolateEgress() {
+		t.Fatal("only the egress should be isolated")
+	}
+	var none *NetworkPolicy
+	if none.IsolateIngress() || none.IsolateEgress() {
+		t.Fatal("pod without network policy should not be isolated")
+	}
+
+	for _, bad := range []*NetworkPolicy{
+		{PolicyTypes: []string{"ingress"}},
+		{Ingress: []*NetworkPolicyRule{{Peers: []*NetworkPolicyPeer{{}}}}},
+		{Ingress: []*NetworkPolicyRule{{Peers: []*NetworkPolicyPeer{{Cidr: "10.0.0.1"}}}}},
+		{Egress: []*NetworkPolicyRule{{Peers: []*NetworkPolicyPeer{{Cidr: "10.0.0.0/8", PodSelector: map[string]string{"a": "b"}}}}}},
+		{Egress: []*NetworkPolicyRule{{Ports: []*NetworkPolicyPort{{Protocol: "sctp"}}}}},
+		{Egress: []*NetworkPolicyRule{{Ports: []*NetworkPolicyPort{{Port: 65536}}}}},
+	} {
+		if err := (&UserPod{NetworkPolicy: bad}).Validate(); err == nil {
+			t.Fatalf("invalid network policy %v is not rejected", bad)
+		}
+	}
+}
+
+func TestValidateInterfaceBandwidth(t *testing.T) {
+	pod := &UserPod{Interfaces: []*UserInterface{{
+		Bandwidth: &InterfaceBandwidth{IngressRate: 10000000, IngressBurst: 65536, EgressRate: 1000000},
+	}}}
+	if err := pod.Validate(); err != nil {
+		t.Fatalf("valid bandwidth is rejected: %v", err)
+	}
+	for _, bad := range []*InterfaceBandwidth{
+		{IngressBurst: 65536},
+		{IngressRate: 10000000, EgressBurst: 65536},
+	} {
+		pod.Interfaces[0].Bandwidth = bad
+		if err := pod.Validate(); err == nil {
+			t.Fatalf("invalid bandwidth %v is not rejected", bad)
+		}
+	}
+}
+
+func TestValidateHostAliases(t *testing.T) {
+	pod := &UserPod{
+		HostAliases: []*HostAlias{{Ip: "10.0.0.1", Hostnames: []string{"db", "db.example.com"}}},
+		Services:    []*UserService{{ServiceIP: "10.254.0.1", ServicePort: 80, Protocol: "tcp", Name: "web"}},
+	}
+	if err := pod.Validate(); err != nil {
+		t.Fatalf("valid host aliases are rejected: %v", err)
+	}
+	for _, bad := range []*HostAlias{
+		{Ip: "db", Hostnames: []string{"db"}},
+		{Ip: "10.0.0.1"},
+		{Ip: "10.0.0.1", Hostnames: []string{"db example"}},
+	} {
+		pod.HostAliases = []*HostAlias{bad}
+		if err := pod.Validate(); err == nil {
+			t.Fatalf("invalid host alias %v is not rejected", bad)
+		}
+	}
+
+	pod.HostAliases = nil
+	pod.Services[0].Name = "Web_1"
+	if err := pod.Validate(); err == nil {
+		t.Fatal("invalid service name is not rejected")
+	}
+}
+
+func TestValidateService(t *testing.T) {
+	srv := &UserService{
+		ServiceIP:          "10.254.0.1",
+		ServicePort:        80,
+		Protocol:           "tcp",
+		Scheduler:          "wrr",
+		PersistenceTimeout: 300,
+		ForwardMode:        "gatewaying",
+		Hosts: []*UserServiceBackend{
+			{HostIP: "192.168.1.2", HostPort: 80, Weight: 9},
+			{HostIP: "192.168.1.3", HostPort: 80, Weight: 1},
+		},
+		HealthCheck: &ServiceHealthCheck{Interval: 5, UnhealthyThreshold: 2},
+	}
+	if err := srv.Validate(); err != nil {
+		t.Fatalf("valid service is rejected: %v", err)
+	}
+
+	for _, change := range []func(s *UserService){
+		func(s *UserService) { s.Scheduler = "random" },
+		func(s *UserService) { s.ForwardMode = "nat" },
+		func(s *UserService) { s.PersistenceTimeout = -1 },
+		func(s *UserService) { s.Hosts[0].Weight = -1 },
+		func(s *UserService) { s.HealthCheck.Timeout = -1 },
+		func(s *UserService) { s.Protocol = "udp" },
+	} {
+		bad := *srv
+		bad.Hosts = []*UserServiceBackend{{HostIP: "192.168.1.2", HostPort: 80}}
+		bad.HealthCheck = &ServiceHealthCheck{}
+		change(&bad)
+		if err := bad.Validate(); err == nil {
+			t.Fatalf("invalid service %v is not rejected", bad)
+		}
+	}
+}
+
+func TestServiceBackendWeightSet(t *testing.T) {
+	for data, expected := range map[string]bool{
+		`{"hostIP": "192.168.1.2", "hostPort": 80, "weight": 0}`: true,
+		`{"hostIP": "192.168.1.2", "hostPort": 80, "weight": 3}`: true,
+		`{"hostIP": "192.168.1.2", "hostPort": 80}`:              false,
+	} {
+		var b UserServiceBackend
+		if err := json.Unmarshal([]byte(data), &b); err != nil {
+			t.Fatal(err)
+		}
+		if b.WeightSet != expected {
+			t.Fatalf("weightSet of %s is %v", data, b.WeightSet)
+		}
+	}
+
+	var pod UserPod
+	data := "services:\n- hosts:\n  - hostip: 192.168.1.2\n    weight: 0\n  - hostip: 192.168.1.3\n"
+	if err := yaml.Unmarshal([]byte(data), &pod); err != nil {
+		t.Fatal(err)
+	}
+	if hosts := pod.Services[0].Hosts; !hosts[0].WeightSet || hosts[1].WeightSet {
+		t.Fatalf("unexpected weightSet of the yaml backends: %v", hosts)
+	}
+}
@@ -6,10 +6,12 @@
 Package types is a generated protocol buffer package.
 
 It is generated from these files:
+
 	types.proto
 	persist.proto
 
 It has these top-level messages:
+
 	ContainerPort
 	EnvironmentVar
 	VolumeMount
@@ -17,12 +19,14 @@ It has these top-level messages:
 	RunningStatus
 	TermStatus
 	ContainerStatus
+	ContainerEvent
 	ContainerInfo
 	Container
 	RBDVolumeSource
 	PodVolume
 	PodSpec
 	PodStatus
+	PodInterfaceStatus
 	PodInfo
 	ImageInfo
 	PodStats
@@ -62,17 +66,30 @@ It has these top-level messages:
 	UserUser
 	Ulimit
 	UserContainer
+	UserContainerResources
+	UserContainerHook
+	UserContainerHTTPHook
+	UserContainerDependency
+	UserContainerHealthCheck
 	UserResource
 	UserFile
 	UserVolumeOption
 	UserVolume
 	UserInterface
+	InterfaceBandwidth
 	UserServiceBackend
+	ServiceHealthCheck
 	UserService
 	PodLogConfig
 	PortMapping
 	PortmappingWhiteList
 	UserPod
+	HostAlias
+	NetworkPolicy
+	NetworkPolicyRule
+	NetworkPolicyPeer
+	NetworkPolicyPort
+	UserPodRuntime
 	PodCreateRequest
 	PodCreateResponse
 	PodRemoveRequest
@@ -82,6 +99,9 @@ It has these top-level messages:
 	DriverStatus
 	InfoRequest
 	InfoResponse
+	IPConflict
+	IPAllocation
+	HostCapacity
 	ExecCreateRequest
 	ExecCreateResponse
 	ExecStartRequest
@@ -95,6 +115,7 @@ It has these top-level messages:
 	WaitRequest
 	WaitResponse
 	AttachMessage
+	PortForwardMessage
 	ContainerCreateRequest
 	ContainerCreateResponse
 	ContainerStartRequest
@@ -111,8 +132,21 @@ It has these top-level messages:
 	ImageRemoveRequest
 	ImageDelete
 	ImageRemoveResponse
+	ImageGCRequest
+	ImageGCCandidate
+	ImageGCStats
+	ImageGCResponse
 	ContainerStopRequest
 	ContainerStopResponse
+	ReloadConfigRequest
+	ReloadConfigRejected
+	ReloadConfigResponse
+	VMFactoryProfile
+	VMFactoryProfileStatus
+	VMFactoryStatusRequest
+	VMFactoryStatusResponse
+	VMFactoryUpdateRequest
+	VMFactoryUpdateResponse
 	VersionRequest
 	VersionResponse
 	ServiceListResponse
@@ -127,6 +161,19 @@ It has these top-level messages:
 	PortMappingListResponse
 	PortMappingModifyRequest
 	PortMappingModifyResponse
+	PodSetBandwidthRequest
+	PodSetBandwidthResponse
+	Network
+	NetworkEndpoint
+	NetworkInfo
+	NetworkCreateRequest
+	NetworkCreateResponse
+	NetworkListRequest
+	NetworkListResponse
+	NetworkInspectRequest
+	NetworkInspectResponse
+	NetworkRemoveRequest
+	NetworkRemoveResponse
 	PodStopRequest
 	PodStopResponse
 	PodSignalRequest
@@ -135,6 +182,12 @@ It has these top-level messages:
 	PodPauseResponse
 	PodUnpauseRequest
 	PodUnpauseResponse
+	PodUpdateRequest
+	PodUpdateChange
+	PodUpdateResponse
+	PodApplyRequest
+	PodApplyResult
+	PodApplyResponse
 	PodLabelsRequest
 	PodLabelsResponse
 	PodStatsRequest
@@ -151,6 +204,8 @@ It has these top-level messages:
 	PersistContainer
 	PersistVolume
 	PersistInterface
+	PersistCni
+	PersistIPAllocation
 	PersistPortmappings
 */
 package types
@@ -361,12 +416,13 @@ func (m *TermStatus) GetFinishedAt() string {
 }
 
 type ContainerStatus struct {
-	Name        string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ContainerID string         `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	Phase       string         `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
-	Waiting     *WaitingStatus `protobuf:"bytes,4,opt,name=waiting" json:"waiting,omitempty"`
-	Running     *RunningStatus `protobuf:"bytes,5,opt,name=running" json:"running,omitempty"`
-	Terminated  *TermStatus    `protobuf:"bytes,6,opt,name=terminated" json:"terminated,omitempty"`
+	Name        string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContainerID string            `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Phase       string            `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	Waiting     *WaitingStatus    `protobuf:"bytes,4,opt,name=waiting" json:"waiting,omitempty"`
+	Running     *RunningStatus    `protobuf:"bytes,5,opt,name=running" json:"running,omitempty"`
+	Terminated  *TermStatus       `protobuf:"bytes,6,opt,name=terminated" json:"terminated,omitempty"`
+	Events      []*ContainerEvent `protobuf:"bytes,7,rep,name=events" json:"events,omitempty"`
 }
 
 func (m *ContainerStatus) Reset()                    { *m = ContainerStatus{} }
@@ -416,6 +472,45 @@ func (m *ContainerStatus) GetTerminated() *TermStatus {
 	return nil
 }
 
+func (m *ContainerStatus) GetEvents() []*ContainerEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type ContainerEvent struct {
+	Time    string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *ContainerEvent) Reset()                    { *m = ContainerEvent{} }
+func (m *ContainerEvent) String() string            { return proto.CompactTextString(m) }
+func (*ContainerEvent) ProtoMessage()               {}
+func (*ContainerEvent) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{7} }
+
+func (m *ContainerEvent) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *ContainerEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ContainerEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type ContainerInfo struct {
 	Container *Container       `protobuf:"bytes,1,opt,name=container" json:"container,omitempty"`
 	CreatedAt int64            `protobuf:"varint,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
@@ -426,7 +521,7 @@ type ContainerInfo struct {
 func (m *ContainerInfo) Reset()                    { *m = ContainerInfo{} }
 func (m *ContainerInfo) String() string            { return proto.CompactTextString(m) }
 func (*ContainerInfo) ProtoMessage()               {}
-func (*ContainerInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{7} }
+func (*ContainerInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{8} }
 
 func (m *ContainerInfo) GetContainer() *Container {
 	if m != nil {
@@ -476,7 +571,7 @@ type Container struct {
 func (m *Container) Reset()                    { *m = Container{} }
 func (m *Container) String() string            { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{8} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{9} }
 
 func (m *Container) GetName() string {
 	if m != nil {
@@ -589,7 +684,7 @@ type RBDVolumeSource struct {
 func (m *RBDVolumeSource) Reset()                    { *m = RBDVolumeSource{} }
 func (m *RBDVolumeSource) String() string            { return proto.CompactTextString(m) }
 func (*RBDVolumeSource) ProtoMessage()               {}
-func (*RBDVolumeSource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{9} }
+func (*RBDVolumeSource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{10} }
 
 func (m *RBDVolumeSource) GetMonitors() []string {
 	if m != nil {
@@ -650,7 +745,7 @@ type PodVolume struct {
 func (m *PodVolume) Reset()                    { *m = PodVolume{} }
 func (m *PodVolume) String() string            { return proto.CompactTextString(m) }
 func (*PodVolume) ProtoMessage()               {}
-func (*PodVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{10} }
+func (*PodVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{11} }
 
 func (m *PodVolume) GetName() string {
 	if m != nil {
@@ -681,17 +776,18 @@ func (m *PodVolume) GetRbd() *RBDVolumeSource {
 }
 
 type PodSpec struct {
-	Volumes    []*PodVolume      `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
-	Containers []*Container      `protobuf:"bytes,2,rep,name=containers" json:"containers,omitempty"`
-	Labels     map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Vcpu       int32             `protobuf:"varint,4,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
-	Memory     int32             `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	Volumes        []*PodVolume      `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
+	Containers     []*Container      `protobuf:"bytes,2,rep,name=containers" json:"containers,omitempty"`
+	Labels         map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Vcpu           int32             `protobuf:"varint,4,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
+	Memory         int32             `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	InitContainers []*Container      `protobuf:"bytes,6,rep,name=initContainers" json:"initContainers,omitempty"`
 }
 
 func (m *PodSpec) Reset()                    { *m = PodSpec{} }
 func (m *PodSpec) String() string            { return proto.CompactTextString(m) }
 func (*PodSpec) ProtoMessage()               {}
-func (*PodSpec) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{11} }
+func (*PodSpec) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{12} }
 
 func (m *PodSpec) GetVolumes() []*PodVolume {
 	if m != nil {
@@ -728,21 +824,30 @@ func (m *PodSpec) GetMemory() int32 {
 	return 0
 }
 
+func (m *PodSpec) GetInitContainers() []*Container {
+	if m != nil {
+		return m.InitContainers
+	}
+	return nil
+}
+
 type PodStatus struct {
-	Phase           string             `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
-	Message         string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Reason          string             `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	HostIP          string             `protobuf:"bytes,4,opt,name=hostIP,proto3" json:"hostIP,omitempty"`
-	PodIP           []string           `protobuf:"bytes,5,rep,name=podIP" json:"podIP,omitempty"`
-	StartTime       string             `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	ContainerStatus []*ContainerStatus `protobuf:"bytes,7,rep,name=containerStatus" json:"containerStatus,omitempty"`
-	FinishTime      string             `protobuf:"bytes,8,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	Phase           string                `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message         string                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason          string                `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	HostIP          string                `protobuf:"bytes,4,opt,name=hostIP,proto3" json:"hostIP,omitempty"`
+	PodIP           []string              `protobuf:"bytes,5,rep,name=podIP" json:"podIP,omitempty"`
+	StartTime       string                `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	ContainerStatus []*ContainerStatus    `protobuf:"bytes,7,rep,name=containerStatus" json:"containerStatus,omitempty"`
+	FinishTime      string                `protobuf:"bytes,8,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	ExitCodes       map[string]int32      `protobuf:"bytes,9,rep,name=exitCodes" json:"exitCodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Interfaces      []*PodInterfaceStatus `protobuf:"bytes,10,rep,name=interfaces" json:"interfaces,omitempty"`
 }
 
 func (m *PodStatus) Reset()                    { *m = PodStatus{} }
 func (m *PodStatus) String() string            { return proto.CompactTextString(m) }
 func (*PodStatus) ProtoMessage()               {}
-func (*PodStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{12} }
+func (*PodStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{13} }
 
 func (m *PodStatus) GetPhase() string {
 	if m != nil {
@@ -800,6 +905,60 @@ func (m *PodStatus) GetFinishTime() string {
 	return ""
 }
 
+func (m *PodStatus) GetExitCodes() map[string]int32 {
+	if m != nil {
+		return m.ExitCodes
+	}
+	return nil
+}
+
+func (m *PodStatus) GetInterfaces() []*PodInterfaceStatus {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+type PodInterfaceStatus struct {
+	Id         string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ip         string              `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	HostDevice string              `protobuf:"bytes,3,opt,name=hostDevice,proto3" json:"hostDevice,omitempty"`
+	Bandwidth  *InterfaceBandwidth `protobuf:"bytes,4,opt,name=bandwidth" json:"bandwidth,omitempty"`
+}
+
+func (m *PodInterfaceStatus) Reset()                    { *m = PodInterfaceStatus{} }
+func (m *PodInterfaceStatus) String() string            { return proto.CompactTextString(m) }
+func (*PodInterfaceStatus) ProtoMessage()               {}
+func (*PodInterfaceStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{14} }
+
+func (m *PodInterfaceStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PodInterfaceStatus) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *PodInterfaceStatus) GetHostDevice() string {
+	if m != nil {
+		return m.HostDevice
+	}
+	return ""
+}
+
+func (m *PodInterfaceStatus) GetBandwidth() *InterfaceBandwidth {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return nil
+}
+
 type PodInfo struct {
 	PodID      string     `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
 	Kind       string     `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
@@ -814,7 +973,7 @@ type PodInfo struct {
 func (m *PodInfo) Reset()                    { *m = PodInfo{} }
 func (m *PodInfo) String() string            { return proto.CompactTextString(m) }
 func (*PodInfo) ProtoMessage()               {}
-func (*PodInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{13} }
+func (*PodInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{15} }
 
 func (m *PodInfo) GetPodID() string {
 	if m != nil {
@@ -885,7 +1044,7 @@ type ImageInfo struct {
 func (m *ImageInfo) Reset()                    { *m = ImageInfo{} }
 func (m *ImageInfo) String() string            { return proto.CompactTextString(m) }
 func (*ImageInfo) ProtoMessage()               {}
-func (*ImageInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{14} }
+func (*ImageInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{16} }
 
 func (m *ImageInfo) GetId() string {
 	if m != nil {
@@ -949,7 +1108,7 @@ type PodStats struct {
 func (m *PodStats) Reset()                    { *m = PodStats{} }
 func (m *PodStats) String() string            { return proto.CompactTextString(m) }
 func (*PodStats) ProtoMessage()               {}
-func (*PodStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{15} }
+func (*PodStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{17} }
 
 func (m *PodStats) GetCpu() *CpuStats {
 	if m != nil {
@@ -1008,7 +1167,7 @@ type CpuStats struct {
 func (m *CpuStats) Reset()                    { *m = CpuStats{} }
 func (m *CpuStats) String() string            { return proto.CompactTextString(m) }
 func (*CpuStats) ProtoMessage()               {}
-func (*CpuStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{16} }
+func (*CpuStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{18} }
 
 func (m *CpuStats) GetUsage() *CpuUsage {
 	if m != nil {
@@ -1034,7 +1193,7 @@ type CpuUsage struct {
 func (m *CpuUsage) Reset()                    { *m = CpuUsage{} }
 func (m *CpuUsage) String() string            { return proto.CompactTextString(m) }
 func (*CpuUsage) ProtoMessage()               {}
-func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{17} }
+func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{19} }
 
 func (m *CpuUsage) GetTotal() uint64 {
 	if m != nil {
@@ -1078,7 +1237,7 @@ type BlkioStats struct {
 func (m *BlkioStats) Reset()                    { *m = BlkioStats{} }
 func (m *BlkioStats) String() string            { return proto.CompactTextString(m) }
 func (*BlkioStats) ProtoMessage()               {}
-func (*BlkioStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{18} }
+func (*BlkioStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20} }
 
 func (m *BlkioStats) GetIoServiceBytesRecursive() []*BlkioStatEntry {
 	if m != nil {
@@ -1148,7 +1307,7 @@ type BlkioStatEntry struct {
 func (m *BlkioStatEntry) Reset()                    { *m = BlkioStatEntry{} }
 func (m *BlkioStatEntry) String() string            { return proto.CompactTextString(m) }
 func (*BlkioStatEntry) ProtoMessage()               {}
-func (*BlkioStatEntry) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{19} }
+func (*BlkioStatEntry) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21} }
 
 func (m *BlkioStatEntry) GetName() string {
 	if m != nil {
@@ -1203,7 +1362,7 @@ type MemoryStats struct {
 func (m *MemoryStats) Reset()                    { *m = MemoryStats{} }
 func (m *MemoryStats) String() string            { return proto.CompactTextString(m) }
 func (*MemoryStats) ProtoMessage()               {}
-func (*MemoryStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20} }
+func (*MemoryStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{22} }
 
 func (m *MemoryStats) GetUsage() uint64 {
 	if m != nil {
@@ -1248,7 +1407,7 @@ type MemoryStatsMemoryData struct {
 func (m *MemoryStatsMemoryData) Reset()                    { *m = MemoryStatsMemoryData{} }
 func (m *MemoryStatsMemoryData) String() string            { return proto.CompactTextString(m) }
 func (*MemoryStatsMemoryData) ProtoMessage()               {}
-func (*MemoryStatsMemoryData) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21} }
+func (*MemoryStatsMemoryData) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23} }
 
 func (m *MemoryStatsMemoryData) GetPgfault() uint64 {
 	if m != nil {
@@ -1273,7 +1432,7 @@ type NetworkStats struct {
 func (m *NetworkStats) Reset()                    { *m = NetworkStats{} }
 func (m *NetworkStats) String() string            { return proto.CompactTextString(m) }
 func (*NetworkStats) ProtoMessage()               {}
-func (*NetworkStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{22} }
+func (*NetworkStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{24} }
 
 func (m *NetworkStats) GetInterfaces() []*InterfaceStats {
 	if m != nil {
@@ -1313,7 +1472,7 @@ type TcpStat struct {
 func (m *TcpStat) Reset()                    { *m = TcpStat{} }
 func (m *TcpStat) String() string            { return proto.CompactTextString(m) }
 func (*TcpStat) ProtoMessage()               {}
-func (*TcpStat) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23} }
+func (*TcpStat) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25} }
 
 func (m *TcpStat) GetEstablished() uint64 {
 	if m != nil {
@@ -1407,7 +1566,7 @@ type InterfaceStats struct {
 func (m *InterfaceStats) Reset()                    { *m = InterfaceStats{} }
 func (m *InterfaceStats) String() string            { return proto.CompactTextString(m) }
 func (*InterfaceStats) ProtoMessage()               {}
-func (*InterfaceStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{24} }
+func (*InterfaceStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{26} }
 
 func (m *InterfaceStats) GetName() string {
 	if m != nil {
@@ -1493,7 +1652,7 @@ type FsStats struct {
 func (m *FsStats) Reset()                    { *m = FsStats{} }
 func (m *FsStats) String() string            { return proto.CompactTextString(m) }
 func (*FsStats) ProtoMessage()               {}
-func (*FsStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25} }
+func (*FsStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27} }
 
 func (m *FsStats) GetDevice() string {
 	if m != nil {
@@ -1613,7 +1772,7 @@ type ContainersStats struct {
 func (m *ContainersStats) Reset()                    { *m = ContainersStats{} }
 func (m *ContainersStats) String() string            { return proto.CompactTextString(m) }
 func (*ContainersStats) ProtoMessage()               {}
-func (*ContainersStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{26} }
+func (*ContainersStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{28} }
 
 func (m *ContainersStats) GetContainerID() string {
 	if m != nil {
@@ -1671,7 +1830,7 @@ type PodInfoRequest struct {
 func (m *PodInfoRequest) Reset()                    { *m = PodInfoRequest{} }
 func (m *PodInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodInfoRequest) ProtoMessage()               {}
-func (*PodInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27} }
+func (*PodInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{29} }
 
 func (m *PodInfoRequest) GetPodID() string {
 	if m != nil {
@@ -1687,7 +1846,7 @@ type PodInfoResponse struct {
 func (m *PodInfoResponse) Reset()                    { *m = PodInfoResponse{} }
 func (m *PodInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodInfoResponse) ProtoMessage()               {}
-func (*PodInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{28} }
+func (*PodInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{30} }
 
 func (m *PodInfoResponse) GetPodInfo() *PodInfo {
 	if m != nil {
@@ -1704,7 +1863,7 @@ type PodListRequest struct {
 func (m *PodListRequest) Reset()                    { *m = PodListRequest{} }
 func (m *PodListRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodListRequest) ProtoMessage()               {}
-func (*PodListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{29} }
+func (*PodListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{31} }
 
 func (m *PodListRequest) GetPodID() string {
 	if m != nil {
@@ -1732,7 +1891,7 @@ type PodListResult struct {
 func (m *PodListResult) Reset()                    { *m = PodListResult{} }
 func (m *PodListResult) String() string            { return proto.CompactTextString(m) }
 func (*PodListResult) ProtoMessage()               {}
-func (*PodListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{30} }
+func (*PodListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{32} }
 
 func (m *PodListResult) GetPodID() string {
 	if m != nil {
@@ -1783,7 +1942,7 @@ type PodListResponse struct {
 func (m *PodListResponse) Reset()                    { *m = PodListResponse{} }
 func (m *PodListResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodListResponse) ProtoMessage()               {}
-func (*PodListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{31} }
+func (*PodListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{33} }
 
 func (m *PodListResponse) GetPodList() []*PodListResult {
 	if m != nil {
@@ -1800,7 +1959,7 @@ type ContainerListRequest struct {
 func (m *ContainerListRequest) Reset()                    { *m = ContainerListRequest{} }
 func (m *ContainerListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListRequest) ProtoMessage()               {}
-func (*ContainerListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{32} }
+func (*ContainerListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{34} }
 
 func (m *ContainerListRequest) GetPodID() string {
 	if m != nil {
@@ -1826,7 +1985,7 @@ type ContainerListResult struct {
 func (m *ContainerListResult) Reset()                    { *m = ContainerListResult{} }
 func (m *ContainerListResult) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListResult) ProtoMessage()               {}
-func (*ContainerListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{33} }
+func (*ContainerListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{35} }
 
 func (m *ContainerListResult) GetContainerID() string {
 	if m != nil {
@@ -1863,7 +2022,7 @@ type ContainerListResponse struct {
 func (m *ContainerListResponse) Reset()                    { *m = ContainerListResponse{} }
 func (m *ContainerListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListResponse) ProtoMessage()               {}
-func (*ContainerListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{34} }
+func (*ContainerListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{36} }
 
 func (m *ContainerListResponse) GetContainerList() []*ContainerListResult {
 	if m != nil {
@@ -1880,7 +2039,7 @@ type ContainerInfoRequest struct {
 func (m *ContainerInfoRequest) Reset()                    { *m = ContainerInfoRequest{} }
 func (m *ContainerInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerInfoRequest) ProtoMessage()               {}
-func (*ContainerInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{35} }
+func (*ContainerInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{37} }
 
 func (m *ContainerInfoRequest) GetContainer() string {
 	if m != nil {
@@ -1896,7 +2055,7 @@ type ContainerInfoResponse struct {
 func (m *ContainerInfoResponse) Reset()                    { *m = ContainerInfoResponse{} }
 func (m *ContainerInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerInfoResponse) ProtoMessage()               {}
-func (*ContainerInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{36} }
+func (*ContainerInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{38} }
 
 func (m *ContainerInfoResponse) GetContainerInfo() *ContainerInfo {
 	if m != nil {
@@ -1914,7 +2073,7 @@ type VMListResult struct {
 func (m *VMListResult) Reset()                    { *m = VMListResult{} }
 func (m *VMListResult) String() string            { return proto.CompactTextString(m) }
 func (*VMListResult) ProtoMessage()               {}
-func (*VMListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{37} }
+func (*VMListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{39} }
 
 func (m *VMListResult) GetVmID() string {
 	if m != nil {
@@ -1945,7 +2104,7 @@ type VMListRequest struct {
 func (m *VMListRequest) Reset()                    { *m = VMListRequest{} }
 func (m *VMListRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMListRequest) ProtoMessage()               {}
-func (*VMListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{38} }
+func (*VMListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
 
 func (m *VMListRequest) GetPodID() string {
 	if m != nil {
@@ -1968,7 +2127,7 @@ type VMListResponse struct {
 func (m *VMListResponse) Reset()                    { *m = VMListResponse{} }
 func (m *VMListResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMListResponse) ProtoMessage()               {}
-func (*VMListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{39} }
+func (*VMListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
 
 func (m *VMListResponse) GetVmList() []*VMListResult {
 	if m != nil {
@@ -1989,7 +2148,7 @@ type ImageListRequest struct {
 func (m *ImageListRequest) Reset()                    { *m = ImageListRequest{} }
 func (m *ImageListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImageListRequest) ProtoMessage()               {}
-func (*ImageListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
+func (*ImageListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
 
 func (m *ImageListRequest) GetFilterArgs() string {
 	if m != nil {
@@ -2019,7 +2178,7 @@ type ImageListResponse struct {
 func (m *ImageListResponse) Reset()                    { *m = ImageListResponse{} }
 func (m *ImageListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImageListResponse) ProtoMessage()               {}
-func (*ImageListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
+func (*ImageListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
 
 func (m *ImageListResponse) GetImageList() []*ImageInfo {
 	if m != nil {
@@ -2036,7 +2195,7 @@ type VMCreateRequest struct {
 func (m *VMCreateRequest) Reset()                    { *m = VMCreateRequest{} }
 func (m *VMCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMCreateRequest) ProtoMessage()               {}
-func (*VMCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
+func (*VMCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
 
 func (m *VMCreateRequest) GetCpu() int32 {
 	if m != nil {
@@ -2059,7 +2218,7 @@ type VMCreateResponse struct {
 func (m *VMCreateResponse) Reset()                    { *m = VMCreateResponse{} }
 func (m *VMCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMCreateResponse) ProtoMessage()               {}
-func (*VMCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
+func (*VMCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
 
 func (m *VMCreateResponse) GetVmID() string {
 	if m != nil {
@@ -2075,7 +2234,7 @@ type VMRemoveRequest struct {
 func (m *VMRemoveRequest) Reset()                    { *m = VMRemoveRequest{} }
 func (m *VMRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMRemoveRequest) ProtoMessage()               {}
-func (*VMRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
+func (*VMRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
 
 func (m *VMRemoveRequest) GetVmID() string {
 	if m != nil {
@@ -2092,7 +2251,7 @@ type VMRemoveResponse struct {
 func (m *VMRemoveResponse) Reset()                    { *m = VMRemoveResponse{} }
 func (m *VMRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMRemoveResponse) ProtoMessage()               {}
-func (*VMRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
+func (*VMRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
 
 func (m *VMRemoveResponse) GetCode() int32 {
 	if m != nil {
@@ -2118,7 +2277,7 @@ type UserContainerPort struct {
 func (m *UserContainerPort) Reset()                    { *m = UserContainerPort{} }
 func (m *UserContainerPort) String() string            { return proto.CompactTextString(m) }
 func (*UserContainerPort) ProtoMessage()               {}
-func (*UserContainerPort) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
+func (*UserContainerPort) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
 
 func (m *UserContainerPort) GetHostPort() int32 {
 	if m != nil {
@@ -2158,7 +2317,7 @@ type UserVolumeReference struct {
 func (m *UserVolumeReference) Reset()                    { *m = UserVolumeReference{} }
 func (m *UserVolumeReference) String() string            { return proto.CompactTextString(m) }
 func (*UserVolumeReference) ProtoMessage()               {}
-func (*UserVolumeReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
+func (*UserVolumeReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
 
 func (m *UserVolumeReference) GetPath() string {
 	if m != nil {
@@ -2200,7 +2359,7 @@ type UserFileReference struct {
 func (m *UserFileReference) Reset()                    { *m = UserFileReference{} }
 func (m *UserFileReference) String() string            { return proto.CompactTextString(m) }
 func (*UserFileReference) ProtoMessage()               {}
-func (*UserFileReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
+func (*UserFileReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
 
 func (m *UserFileReference) GetPath() string {
 	if m != nil {
@@ -2253,7 +2412,7 @@ type UserUser struct {
 func (m *UserUser) Reset()                    { *m = UserUser{} }
 func (m *UserUser) String() string            { return proto.CompactTextString(m) }
 func (*UserUser) ProtoMessage()               {}
-func (*UserUser) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
+func (*UserUser) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
 
 func (m *UserUser) GetName() string {
 	if m != nil {
@@ -2285,7 +2444,7 @@ type Ulimit struct {
 func (m *Ulimit) Reset()                    { *m = Ulimit{} }
 func (m *Ulimit) String() string            { return proto.CompactTextString(m) }
 func (*Ulimit) ProtoMessage()               {}
-func (*Ulimit) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
+func (*Ulimit) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{52} }
 
 func (m *Ulimit) GetName() string {
 	if m != nil {
@@ -2309,32 +2468,37 @@ func (m *Ulimit) GetSoft() uint64 {
 }
 
 type UserContainer struct {
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	Workdir       string                 `protobuf:"bytes,3,opt,name=workdir,proto3" json:"workdir,omitempty"`
-	RestartPolicy string                 `protobuf:"bytes,4,opt,name=restartPolicy,proto3" json:"restartPolicy,omitempty"`
-	Tty           bool                   `protobuf:"varint,5,opt,name=tty,proto3" json:"tty,omitempty"`
-	Sysctl        map[string]string      `protobuf:"bytes,6,rep,name=sysctl" json:"sysctl,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Envs          []*EnvironmentVar      `protobuf:"bytes,7,rep,name=envs" json:"envs,omitempty"`
-	Command       []string               `protobuf:"bytes,8,rep,name=command" json:"command,omitempty"`
-	Entrypoint    []string               `protobuf:"bytes,9,rep,name=entrypoint" json:"entrypoint,omitempty"`
-	Ports         []*UserContainerPort   `protobuf:"bytes,10,rep,name=ports" json:"ports,omitempty"`
-	Volumes       []*UserVolumeReference `protobuf:"bytes,11,rep,name=volumes" json:"volumes,omitempty"`
-	Files         []*UserFileReference   `protobuf:"bytes,12,rep,name=files" json:"files,omitempty"`
-	User          *UserUser              `protobuf:"bytes,13,opt,name=user" json:"user,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,14,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Id            string                 `protobuf:"bytes,15,opt,name=id,proto3" json:"id,omitempty"`
-	StopSignal    string                 `protobuf:"bytes,17,opt,name=StopSignal,proto3" json:"StopSignal,omitempty"`
-	Ulimits       []*Ulimit              `protobuf:"bytes,18,rep,name=ulimits" json:"ulimits,omitempty"`
-	LogPath       string                 `protobuf:"bytes,19,opt,name=logPath,proto3" json:"logPath,omitempty"`
-	ReadOnly      bool                   `protobuf:"varint,20,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
-	Cache         string                 `protobuf:"bytes,21,opt,name=cache,proto3" json:"cache,omitempty"`
+	Name          string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                     `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Workdir       string                     `protobuf:"bytes,3,opt,name=workdir,proto3" json:"workdir,omitempty"`
+	RestartPolicy string                     `protobuf:"bytes,4,opt,name=restartPolicy,proto3" json:"restartPolicy,omitempty"`
+	Tty           bool                       `protobuf:"varint,5,opt,name=tty,proto3" json:"tty,omitempty"`
+	Sysctl        map[string]string          `protobuf:"bytes,6,rep,name=sysctl" json:"sysctl,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Envs          []*EnvironmentVar          `protobuf:"bytes,7,rep,name=envs" json:"envs,omitempty"`
+	Command       []string                   `protobuf:"bytes,8,rep,name=command" json:"command,omitempty"`
+	Entrypoint    []string                   `protobuf:"bytes,9,rep,name=entrypoint" json:"entrypoint,omitempty"`
+	Ports         []*UserContainerPort       `protobuf:"bytes,10,rep,name=ports" json:"ports,omitempty"`
+	Volumes       []*UserVolumeReference     `protobuf:"bytes,11,rep,name=volumes" json:"volumes,omitempty"`
+	Files         []*UserFileReference       `protobuf:"bytes,12,rep,name=files" json:"files,omitempty"`
+	User          *UserUser                  `protobuf:"bytes,13,opt,name=user" json:"user,omitempty"`
+	Labels        map[string]string          `protobuf:"bytes,14,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id            string                     `protobuf:"bytes,15,opt,name=id,proto3" json:"id,omitempty"`
+	StopSignal    string                     `protobuf:"bytes,17,opt,name=StopSignal,proto3" json:"StopSignal,omitempty"`
+	Ulimits       []*Ulimit                  `protobuf:"bytes,18,rep,name=ulimits" json:"ulimits,omitempty"`
+	LogPath       string                     `protobuf:"bytes,19,opt,name=logPath,proto3" json:"logPath,omitempty"`
+	ReadOnly      bool                       `protobuf:"varint,20,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	Cache         string                     `protobuf:"bytes,21,opt,name=cache,proto3" json:"cache,omitempty"`
+	DependsOn     []*UserContainerDependency `protobuf:"bytes,22,rep,name=dependsOn" json:"dependsOn,omitempty"`
+	HealthCheck   *UserContainerHealthCheck  `protobuf:"bytes,23,opt,name=healthCheck" json:"healthCheck,omitempty"`
+	PostStart     *UserContainerHook         `protobuf:"bytes,24,opt,name=postStart" json:"postStart,omitempty"`
+	PreStop       *UserContainerHook         `protobuf:"bytes,25,opt,name=preStop" json:"preStop,omitempty"`
+	Resources     *UserContainerResources    `protobuf:"bytes,26,opt,name=resources" json:"resources,omitempty"`
 }
 
 func (m *UserContainer) Reset()                    { *m = UserContainer{} }
 func (m *UserContainer) String() string            { return proto.CompactTextString(m) }
 func (*UserContainer) ProtoMessage()               {}
-func (*UserContainer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
+func (*UserContainer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{53} }
 
 func (m *UserContainer) GetName() string {
 	if m != nil {
@@ -2476,6 +2640,209 @@ func (m *UserContainer) GetCache() string {
 	return ""
 }
 
+func (m *UserContainer) GetDependsOn() []*UserContainerDependency {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *UserContainer) GetHealthCheck() *UserContainerHealthCheck {
+	if m != nil {
+		return m.HealthCheck
+	}
+	return nil
+}
+
+func (m *UserContainer) GetPostStart() *UserContainerHook {
+	if m != nil {
+		return m.PostStart
+	}
+	return nil
+}
+
+func (m *UserContainer) GetPreStop() *UserContainerHook {
+	if m != nil {
+		return m.PreStop
+	}
+	return nil
+}
+
+func (m *UserContainer) GetResources() *UserContainerResources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// UserContainerResources are the cgroup limits of the container applied by
+// hyperstart inside the VM, zero means no limit.
+type UserContainerResources struct {
+	// the relative weight of cpu time among the containers of the pod
+	CpuShares int64 `protobuf:"varint,1,opt,name=cpuShares,proto3" json:"cpuShares,omitempty"`
+	// the cpu time in microseconds allowed in each cpuPeriod, the period is
+	// 100000 (100ms) if not specified
+	CpuQuota  int64 `protobuf:"varint,2,opt,name=cpuQuota,proto3" json:"cpuQuota,omitempty"`
+	CpuPeriod int64 `protobuf:"varint,3,opt,name=cpuPeriod,proto3" json:"cpuPeriod,omitempty"`
+	// memory limit in MB
+	Memory    int32 `protobuf:"varint,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	PidsLimit int64 `protobuf:"varint,5,opt,name=pidsLimit,proto3" json:"pidsLimit,omitempty"`
+}
+
+func (m *UserContainerResources) Reset()                    { *m = UserContainerResources{} }
+func (m *UserContainerResources) String() string            { return proto.CompactTextString(m) }
+func (*UserContainerResources) ProtoMessage()               {}
+func (*UserContainerResources) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{54} }
+
+func (m *UserContainerResources) GetCpuShares() int64 {
+	if m != nil {
+		return m.CpuShares
+	}
+	return 0
+}
+
+func (m *UserContainerResources) GetCpuQuota() int64 {
+	if m != nil {
+		return m.CpuQuota
+	}
+	return 0
+}
+
+func (m *UserContainerResources) GetCpuPeriod() int64 {
+	if m != nil {
+		return m.CpuPeriod
+	}
+	return 0
+}
+
+func (m *UserContainerResources) GetMemory() int32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *UserContainerResources) GetPidsLimit() int64 {
+	if m != nil {
+		return m.PidsLimit
+	}
+	return 0
+}
+
+// UserContainerHook is the handler of a lifecycle event of the container,
+// either a command executed in the container or an HTTP request sent to
+// the pod IP.
+type UserContainerHook struct {
+	Command []string               `protobuf:"bytes,1,rep,name=command" json:"command,omitempty"`
+	Http    *UserContainerHTTPHook `protobuf:"bytes,2,opt,name=http" json:"http,omitempty"`
+}
+
+func (m *UserContainerHook) Reset()                    { *m = UserContainerHook{} }
+func (m *UserContainerHook) String() string            { return proto.CompactTextString(m) }
+func (*UserContainerHook) ProtoMessage()               {}
+func (*UserContainerHook) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{55} }
+
+func (m *UserContainerHook) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *UserContainerHook) GetHttp() *UserContainerHTTPHook {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+type UserContainerHTTPHook struct {
+	Port int32  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// the HTTP method, default GET
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (m *UserContainerHTTPHook) Reset()                    { *m = UserContainerHTTPHook{} }
+func (m *UserContainerHTTPHook) String() string            { return proto.CompactTextString(m) }
+func (*UserContainerHTTPHook) ProtoMessage()               {}
+func (*UserContainerHTTPHook) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{56} }
+
+func (m *UserContainerHTTPHook) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *UserContainerHTTPHook) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *UserContainerHTTPHook) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+// UserContainerDependency is a container of the pod which should reach the
+// condition before the container starts, the condition is one of "started"
+// (default), "healthy" and "completed".
+type UserContainerDependency struct {
+	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Condition string `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+}
+
+func (m *UserContainerDependency) Reset()                    { *m = UserContainerDependency{} }
+func (m *UserContainerDependency) String() string            { return proto.CompactTextString(m) }
+func (*UserContainerDependency) ProtoMessage()               {}
+func (*UserContainerDependency) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{57} }
+
+func (m *UserContainerDependency) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *UserContainerDependency) GetCondition() string {
+	if m != nil {
+		return m.Condition
+	}
+	return ""
+}
+
+// UserContainerHealthCheck is the command executed in the container to check
+// whether it is healthy, the container is healthy if the command exits 0.
+type UserContainerHealthCheck struct {
+	Command []string `protobuf:"bytes,1,rep,name=command" json:"command,omitempty"`
+	// interval between the checks in seconds, default 1
+	Interval int32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (m *UserContainerHealthCheck) Reset()                    { *m = UserContainerHealthCheck{} }
+func (m *UserContainerHealthCheck) String() string            { return proto.CompactTextString(m) }
+func (*UserContainerHealthCheck) ProtoMessage()               {}
+func (*UserContainerHealthCheck) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{58} }
+
+func (m *UserContainerHealthCheck) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *UserContainerHealthCheck) GetInterval() int32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
 type UserResource struct {
 	Vcpu   int32 `protobuf:"varint,1,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
 	Memory int32 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
@@ -2484,7 +2851,7 @@ type UserResource struct {
 func (m *UserResource) Reset()                    { *m = UserResource{} }
 func (m *UserResource) String() string            { return proto.CompactTextString(m) }
 func (*UserResource) ProtoMessage()               {}
-func (*UserResource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{52} }
+func (*UserResource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{59} }
 
 func (m *UserResource) GetVcpu() int32 {
 	if m != nil {
@@ -2510,7 +2877,7 @@ type UserFile struct {
 func (m *UserFile) Reset()                    { *m = UserFile{} }
 func (m *UserFile) String() string            { return proto.CompactTextString(m) }
 func (*UserFile) ProtoMessage()               {}
-func (*UserFile) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{53} }
+func (*UserFile) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{60} }
 
 func (m *UserFile) GetName() string {
 	if m != nil {
@@ -2549,7 +2916,7 @@ type UserVolumeOption struct {
 func (m *UserVolumeOption) Reset()                    { *m = UserVolumeOption{} }
 func (m *UserVolumeOption) String() string            { return proto.CompactTextString(m) }
 func (*UserVolumeOption) ProtoMessage()               {}
-func (*UserVolumeOption) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{54} }
+func (*UserVolumeOption) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{61} }
 
 func (m *UserVolumeOption) GetUser() string {
 	if m != nil {
@@ -2584,7 +2951,7 @@ type UserVolume struct {
 func (m *UserVolume) Reset()                    { *m = UserVolume{} }
 func (m *UserVolume) String() string            { return proto.CompactTextString(m) }
 func (*UserVolume) ProtoMessage()               {}
-func (*UserVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{55} }
+func (*UserVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{62} }
 
 func (m *UserVolume) GetName() string {
 	if m != nil {
@@ -2635,13 +3002,19 @@ type UserInterface struct {
 	Mac     string `protobuf:"bytes,4,opt,name=mac,proto3" json:"mac,omitempty"`
 	Mtu     uint64 `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
 	Gateway string `protobuf:"bytes,6,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Id      string `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
+	// the named network to allocate the address from, the bridge and the
+	// gateway are of the network, and ip is a static address in it
+	Network string `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	// the rate limits of the traffic to and from the pod, shaped on the
+	// tap device of the interface
+	Bandwidth *InterfaceBandwidth `protobuf:"bytes,8,opt,name=bandwidth" json:"bandwidth,omitempty"`
+	Id        string              `protobuf:"bytes,100,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *UserInterface) Reset()                    { *m = UserInterface{} }
 func (m *UserInterface) String() string            { return proto.CompactTextString(m) }
 func (*UserInterface) ProtoMessage()               {}
-func (*UserInterface) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{56} }
+func (*UserInterface) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{63} }
 
 func (m *UserInterface) GetBridge() string {
 	if m != nil {
@@ -2685,6 +3058,20 @@ func (m *UserInterface) GetGateway() string {
 	return ""
 }
 
+func (m *UserInterface) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *UserInterface) GetBandwidth() *InterfaceBandwidth {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return nil
+}
+
 func (m *UserInterface) GetId() string {
 	if m != nil {
 		return m.Id
@@ -2692,15 +3079,67 @@ func (m *UserInterface) GetId() string {
 	return ""
 }
 
+type InterfaceBandwidth struct {
+	// the rates are in bits per second, and the bursts in bytes, the traffic
+	// is not limited if the rate is 0
+	IngressRate  uint64 `protobuf:"varint,1,opt,name=ingressRate,proto3" json:"ingressRate,omitempty"`
+	IngressBurst uint64 `protobuf:"varint,2,opt,name=ingressBurst,proto3" json:"ingressBurst,omitempty"`
+	EgressRate   uint64 `protobuf:"varint,3,opt,name=egressRate,proto3" json:"egressRate,omitempty"`
+	EgressBurst  uint64 `protobuf:"varint,4,opt,name=egressBurst,proto3" json:"egressBurst,omitempty"`
+}
+
+func (m *InterfaceBandwidth) Reset()                    { *m = InterfaceBandwidth{} }
+func (m *InterfaceBandwidth) String() string            { return proto.CompactTextString(m) }
+func (*InterfaceBandwidth) ProtoMessage()               {}
+func (*InterfaceBandwidth) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{64} }
+
+func (m *InterfaceBandwidth) GetIngressRate() uint64 {
+	if m != nil {
+		return m.IngressRate
+	}
+	return 0
+}
+
+func (m *InterfaceBandwidth) GetIngressBurst() uint64 {
+	if m != nil {
+		return m.IngressBurst
+	}
+	return 0
+}
+
+func (m *InterfaceBandwidth) GetEgressRate() uint64 {
+	if m != nil {
+		return m.EgressRate
+	}
+	return 0
+}
+
+func (m *InterfaceBandwidth) GetEgressBurst() uint64 {
+	if m != nil {
+		return m.EgressBurst
+	}
+	return 0
+}
+
 type UserServiceBackend struct {
 	HostIP   string `protobuf:"bytes,1,opt,name=hostIP,proto3" json:"hostIP,omitempty"`
 	HostPort int32  `protobuf:"varint,2,opt,name=hostPort,proto3" json:"hostPort,omitempty"`
+	// the relative weight of the backend in the weighted schedulers, 1 if
+	// not set
+	Weight int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// healthy, unhealthy or unknown, reported by ServiceList if the service
+	// is health checked, ignored in the requests
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// weightSet makes the weight used even if it is 0, which drains the
+	// backend without breaking its established connections. It is set when
+	// the weight is in the JSON or YAML pod files.
+	WeightSet bool `protobuf:"varint,5,opt,name=weightSet,proto3" json:"weightSet,omitempty"`
 }
 
 func (m *UserServiceBackend) Reset()                    { *m = UserServiceBackend{} }
 func (m *UserServiceBackend) String() string            { return proto.CompactTextString(m) }
 func (*UserServiceBackend) ProtoMessage()               {}
-func (*UserServiceBackend) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{57} }
+func (*UserServiceBackend) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{65} }
 
 func (m *UserServiceBackend) GetHostIP() string {
 	if m != nil {
@@ -2716,56 +3155,169 @@ func (m *UserServiceBackend) GetHostPort() int32 {
 	return 0
 }
 
-type UserService struct {
-	ServiceIP   string                `protobuf:"bytes,1,opt,name=serviceIP,proto3" json:"serviceIP,omitempty"`
-	Protocol    string                `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	ServicePort int32                 `protobuf:"varint,3,opt,name=servicePort,proto3" json:"servicePort,omitempty"`
-	Hosts       []*UserServiceBackend `protobuf:"bytes,4,rep,name=hosts" json:"hosts,omitempty"`
+func (m *UserServiceBackend) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
 }
 
-func (m *UserService) Reset()                    { *m = UserService{} }
-func (m *UserService) String() string            { return proto.CompactTextString(m) }
-func (*UserService) ProtoMessage()               {}
-func (*UserService) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{58} }
-
-func (m *UserService) GetServiceIP() string {
+func (m *UserServiceBackend) GetState() string {
 	if m != nil {
-		return m.ServiceIP
+		return m.State
 	}
 	return ""
 }
 
-func (m *UserService) GetProtocol() string {
+func (m *UserServiceBackend) GetWeightSet() bool {
 	if m != nil {
-		return m.Protocol
+		return m.WeightSet
 	}
-	return ""
+	return false
 }
 
-func (m *UserService) GetServicePort() int32 {
+// ServiceHealthCheck probes the TCP ports of the backends from the sandbox,
+// the unhealthy backends are weighted to 0 until they are healthy again.
+type ServiceHealthCheck struct {
+	// seconds between the probes, 10 if not set
+	Interval int32 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// seconds to wait for the connection, 1 if not set
+	Timeout int32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// consecutive failures to be unhealthy, 3 if not set
+	UnhealthyThreshold int32 `protobuf:"varint,3,opt,name=unhealthyThreshold,proto3" json:"unhealthyThreshold,omitempty"`
+	// consecutive successes to be healthy again, 1 if not set
+	HealthyThreshold int32 `protobuf:"varint,4,opt,name=healthyThreshold,proto3" json:"healthyThreshold,omitempty"`
+}
+
+func (m *ServiceHealthCheck) Reset()                    { *m = ServiceHealthCheck{} }
+func (m *ServiceHealthCheck) String() string            { return proto.CompactTextString(m) }
+func (*ServiceHealthCheck) ProtoMessage()               {}
+func (*ServiceHealthCheck) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{66} }
+
+func (m *ServiceHealthCheck) GetInterval() int32 {
 	if m != nil {
-		return m.ServicePort
+		return m.Interval
 	}
 	return 0
 }
 
-func (m *UserService) GetHosts() []*UserServiceBackend {
+func (m *ServiceHealthCheck) GetTimeout() int32 {
 	if m != nil {
-		return m.Hosts
+		return m.Timeout
 	}
-	return nil
+	return 0
 }
 
-type PodLogConfig struct {
-	Type   string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (m *ServiceHealthCheck) GetUnhealthyThreshold() int32 {
+	if m != nil {
+		return m.UnhealthyThreshold
+	}
+	return 0
 }
 
-func (m *PodLogConfig) Reset()                    { *m = PodLogConfig{} }
-func (m *PodLogConfig) String() string            { return proto.CompactTextString(m) }
-func (*PodLogConfig) ProtoMessage()               {}
-func (*PodLogConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{59} }
-
+func (m *ServiceHealthCheck) GetHealthyThreshold() int32 {
+	if m != nil {
+		return m.HealthyThreshold
+	}
+	return 0
+}
+
+type UserService struct {
+	ServiceIP   string                `protobuf:"bytes,1,opt,name=serviceIP,proto3" json:"serviceIP,omitempty"`
+	Protocol    string                `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	ServicePort int32                 `protobuf:"varint,3,opt,name=servicePort,proto3" json:"servicePort,omitempty"`
+	Hosts       []*UserServiceBackend `protobuf:"bytes,4,rep,name=hosts" json:"hosts,omitempty"`
+	// the service IP is resolved by the name in the pod, if the embedded DNS
+	// is enabled
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// the IPVS scheduler, e.g. rr, wrr, lc or sh, rr if not set
+	Scheduler string `protobuf:"bytes,6,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	// seconds the connections of a client stick to the same backend, not
+	// persistent if not set
+	PersistenceTimeout int32 `protobuf:"varint,7,opt,name=persistenceTimeout,proto3" json:"persistenceTimeout,omitempty"`
+	// masquerading, gatewaying or tunneling, masquerading if not set
+	ForwardMode string              `protobuf:"bytes,8,opt,name=forwardMode,proto3" json:"forwardMode,omitempty"`
+	HealthCheck *ServiceHealthCheck `protobuf:"bytes,9,opt,name=healthCheck" json:"healthCheck,omitempty"`
+}
+
+func (m *UserService) Reset()                    { *m = UserService{} }
+func (m *UserService) String() string            { return proto.CompactTextString(m) }
+func (*UserService) ProtoMessage()               {}
+func (*UserService) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{67} }
+
+func (m *UserService) GetServiceIP() string {
+	if m != nil {
+		return m.ServiceIP
+	}
+	return ""
+}
+
+func (m *UserService) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *UserService) GetServicePort() int32 {
+	if m != nil {
+		return m.ServicePort
+	}
+	return 0
+}
+
+func (m *UserService) GetHosts() []*UserServiceBackend {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
+func (m *UserService) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserService) GetScheduler() string {
+	if m != nil {
+		return m.Scheduler
+	}
+	return ""
+}
+
+func (m *UserService) GetPersistenceTimeout() int32 {
+	if m != nil {
+		return m.PersistenceTimeout
+	}
+	return 0
+}
+
+func (m *UserService) GetForwardMode() string {
+	if m != nil {
+		return m.ForwardMode
+	}
+	return ""
+}
+
+func (m *UserService) GetHealthCheck() *ServiceHealthCheck {
+	if m != nil {
+		return m.HealthCheck
+	}
+	return nil
+}
+
+type PodLogConfig struct {
+	Type   string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *PodLogConfig) Reset()                    { *m = PodLogConfig{} }
+func (m *PodLogConfig) String() string            { return proto.CompactTextString(m) }
+func (*PodLogConfig) ProtoMessage()               {}
+func (*PodLogConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{68} }
+
 func (m *PodLogConfig) GetType() string {
 	if m != nil {
 		return m.Type
@@ -2789,7 +3341,7 @@ type PortMapping struct {
 func (m *PortMapping) Reset()                    { *m = PortMapping{} }
 func (m *PortMapping) String() string            { return proto.CompactTextString(m) }
 func (*PortMapping) ProtoMessage()               {}
-func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{60} }
+func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{69} }
 
 func (m *PortMapping) GetContainerPort() string {
 	if m != nil {
@@ -2824,7 +3376,7 @@ type PortmappingWhiteList struct {
 func (m *PortmappingWhiteList) Reset()                    { *m = PortmappingWhiteList{} }
 func (m *PortmappingWhiteList) String() string            { return proto.CompactTextString(m) }
 func (*PortmappingWhiteList) ProtoMessage()               {}
-func (*PortmappingWhiteList) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{61} }
+func (*PortmappingWhiteList) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{70} }
 
 func (m *PortmappingWhiteList) GetInternalNetworks() []string {
 	if m != nil {
@@ -2860,12 +3412,25 @@ type UserPod struct {
 	Portmappings          []*PortMapping        `protobuf:"bytes,16,rep,name=portmappings" json:"portmappings,omitempty"`
 	DnsOptions            []string              `protobuf:"bytes,17,rep,name=dnsOptions" json:"dnsOptions,omitempty"`
 	DnsSearch             []string              `protobuf:"bytes,18,rep,name=dnsSearch" json:"dnsSearch,omitempty"`
+	InitContainers        []*UserContainer      `protobuf:"bytes,19,rep,name=initContainers" json:"initContainers,omitempty"`
+	// for the job pods, remove the pod once it finishes, or the seconds
+	// after it finishes if ttlAfterFinished is set
+	AutoRemove       bool            `protobuf:"varint,20,opt,name=autoRemove,proto3" json:"autoRemove,omitempty"`
+	TtlAfterFinished int32           `protobuf:"varint,21,opt,name=ttlAfterFinished,proto3" json:"ttlAfterFinished,omitempty"`
+	Runtime          *UserPodRuntime `protobuf:"bytes,22,opt,name=runtime" json:"runtime,omitempty"`
+	// the pods with lower priority are evicted first when the host is under
+	// memory or disk pressure, the sh.hyper.pod.priority label is used if
+	// it is not set
+	Priority      int32          `protobuf:"varint,23,opt,name=priority,proto3" json:"priority,omitempty"`
+	NetworkPolicy *NetworkPolicy `protobuf:"bytes,24,opt,name=networkPolicy" json:"networkPolicy,omitempty"`
+	// the entries added to /etc/hosts of the containers
+	HostAliases []*HostAlias `protobuf:"bytes,25,rep,name=hostAliases" json:"hostAliases,omitempty"`
 }
 
 func (m *UserPod) Reset()                    { *m = UserPod{} }
 func (m *UserPod) String() string            { return proto.CompactTextString(m) }
 func (*UserPod) ProtoMessage()               {}
-func (*UserPod) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{62} }
+func (*UserPod) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{71} }
 
 func (m *UserPod) GetId() string {
 	if m != nil {
@@ -2993,6 +3558,218 @@ func (m *UserPod) GetDnsSearch() []string {
 	return nil
 }
 
+func (m *UserPod) GetInitContainers() []*UserContainer {
+	if m != nil {
+		return m.InitContainers
+	}
+	return nil
+}
+
+func (m *UserPod) GetAutoRemove() bool {
+	if m != nil {
+		return m.AutoRemove
+	}
+	return false
+}
+
+func (m *UserPod) GetTtlAfterFinished() int32 {
+	if m != nil {
+		return m.TtlAfterFinished
+	}
+	return 0
+}
+
+func (m *UserPod) GetRuntime() *UserPodRuntime {
+	if m != nil {
+		return m.Runtime
+	}
+	return nil
+}
+
+func (m *UserPod) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *UserPod) GetNetworkPolicy() *NetworkPolicy {
+	if m != nil {
+		return m.NetworkPolicy
+	}
+	return nil
+}
+
+func (m *UserPod) GetHostAliases() []*HostAlias {
+	if m != nil {
+		return m.HostAliases
+	}
+	return nil
+}
+
+type HostAlias struct {
+	Ip        string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Hostnames []string `protobuf:"bytes,2,rep,name=hostnames" json:"hostnames,omitempty"`
+}
+
+func (m *HostAlias) Reset()                    { *m = HostAlias{} }
+func (m *HostAlias) String() string            { return proto.CompactTextString(m) }
+func (*HostAlias) ProtoMessage()               {}
+func (*HostAlias) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{72} }
+
+func (m *HostAlias) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *HostAlias) GetHostnames() []string {
+	if m != nil {
+		return m.Hostnames
+	}
+	return nil
+}
+
+// NetworkPolicy restricts the traffic of the pod interfaces, the traffic is
+// only allowed by the rules in the isolated directions. The policies are
+// enforced on the forwarded traffic, so the traffic between the pod and the
+// host itself is not restricted, including the connections of the port
+// forwarding and of the userland proxies of the mapped ports, which are
+// made from the host.
+type NetworkPolicy struct {
+	// "Ingress" and/or "Egress", by default the ingress is isolated, and the
+	// egress is isolated if there are egress rules
+	PolicyTypes []string             `protobuf:"bytes,1,rep,name=policyTypes" json:"policyTypes,omitempty"`
+	Ingress     []*NetworkPolicyRule `protobuf:"bytes,2,rep,name=ingress" json:"ingress,omitempty"`
+	Egress      []*NetworkPolicyRule `protobuf:"bytes,3,rep,name=egress" json:"egress,omitempty"`
+}
+
+func (m *NetworkPolicy) Reset()                    { *m = NetworkPolicy{} }
+func (m *NetworkPolicy) String() string            { return proto.CompactTextString(m) }
+func (*NetworkPolicy) ProtoMessage()               {}
+func (*NetworkPolicy) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{73} }
+
+func (m *NetworkPolicy) GetPolicyTypes() []string {
+	if m != nil {
+		return m.PolicyTypes
+	}
+	return nil
+}
+
+func (m *NetworkPolicy) GetIngress() []*NetworkPolicyRule {
+	if m != nil {
+		return m.Ingress
+	}
+	return nil
+}
+
+func (m *NetworkPolicy) GetEgress() []*NetworkPolicyRule {
+	if m != nil {
+		return m.Egress
+	}
+	return nil
+}
+
+// NetworkPolicyRule allows the traffic from (ingress) or to (egress) any of
+// the peers on any of the ports, a rule without peers or ports allows all
+// of them.
+type NetworkPolicyRule struct {
+	Peers []*NetworkPolicyPeer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+	Ports []*NetworkPolicyPort `protobuf:"bytes,2,rep,name=ports" json:"ports,omitempty"`
+}
+
+func (m *NetworkPolicyRule) Reset()                    { *m = NetworkPolicyRule{} }
+func (m *NetworkPolicyRule) String() string            { return proto.CompactTextString(m) }
+func (*NetworkPolicyRule) ProtoMessage()               {}
+func (*NetworkPolicyRule) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{74} }
+
+func (m *NetworkPolicyRule) GetPeers() []*NetworkPolicyPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *NetworkPolicyRule) GetPorts() []*NetworkPolicyPort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+// NetworkPolicyPeer is either a CIDR, or the pods having all the labels of
+// the podSelector.
+type NetworkPolicyPeer struct {
+	Cidr        string            `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	PodSelector map[string]string `protobuf:"bytes,2,rep,name=podSelector" json:"podSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *NetworkPolicyPeer) Reset()                    { *m = NetworkPolicyPeer{} }
+func (m *NetworkPolicyPeer) String() string            { return proto.CompactTextString(m) }
+func (*NetworkPolicyPeer) ProtoMessage()               {}
+func (*NetworkPolicyPeer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{75} }
+
+func (m *NetworkPolicyPeer) GetCidr() string {
+	if m != nil {
+		return m.Cidr
+	}
+	return ""
+}
+
+func (m *NetworkPolicyPeer) GetPodSelector() map[string]string {
+	if m != nil {
+		return m.PodSelector
+	}
+	return nil
+}
+
+type NetworkPolicyPort struct {
+	// tcp or udp, both if empty
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// all the ports if 0
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (m *NetworkPolicyPort) Reset()                    { *m = NetworkPolicyPort{} }
+func (m *NetworkPolicyPort) String() string            { return proto.CompactTextString(m) }
+func (*NetworkPolicyPort) ProtoMessage()               {}
+func (*NetworkPolicyPort) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{76} }
+
+func (m *NetworkPolicyPort) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *NetworkPolicyPort) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+// UserPodRuntime selects the hypervisor, kernel and initrd booting the
+// sandbox of the pod.
+type UserPodRuntime struct {
+	// the name of a [Runtime.<profile>] section of the hyperd config file,
+	// the default runtime is used if it is empty
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (m *UserPodRuntime) Reset()                    { *m = UserPodRuntime{} }
+func (m *UserPodRuntime) String() string            { return proto.CompactTextString(m) }
+func (*UserPodRuntime) ProtoMessage()               {}
+func (*UserPodRuntime) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{77} }
+
+func (m *UserPodRuntime) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
 type PodCreateRequest struct {
 	PodSpec *UserPod `protobuf:"bytes,1,opt,name=podSpec" json:"podSpec,omitempty"`
 	PodID   string   `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -3001,7 +3778,7 @@ type PodCreateRequest struct {
 func (m *PodCreateRequest) Reset()                    { *m = PodCreateRequest{} }
 func (m *PodCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodCreateRequest) ProtoMessage()               {}
-func (*PodCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{63} }
+func (*PodCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{78} }
 
 func (m *PodCreateRequest) GetPodSpec() *UserPod {
 	if m != nil {
@@ -3024,7 +3801,7 @@ type PodCreateResponse struct {
 func (m *PodCreateResponse) Reset()                    { *m = PodCreateResponse{} }
 func (m *PodCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodCreateResponse) ProtoMessage()               {}
-func (*PodCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{64} }
+func (*PodCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{79} }
 
 func (m *PodCreateResponse) GetPodID() string {
 	if m != nil {
@@ -3040,7 +3817,7 @@ type PodRemoveRequest struct {
 func (m *PodRemoveRequest) Reset()                    { *m = PodRemoveRequest{} }
 func (m *PodRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodRemoveRequest) ProtoMessage()               {}
-func (*PodRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{65} }
+func (*PodRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{80} }
 
 func (m *PodRemoveRequest) GetPodID() string {
 	if m != nil {
@@ -3057,7 +3834,7 @@ type PodRemoveResponse struct {
 func (m *PodRemoveResponse) Reset()                    { *m = PodRemoveResponse{} }
 func (m *PodRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodRemoveResponse) ProtoMessage()               {}
-func (*PodRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{66} }
+func (*PodRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{81} }
 
 func (m *PodRemoveResponse) GetCode() int32 {
 	if m != nil {
@@ -3086,7 +3863,7 @@ type ContainerLogsRequest struct {
 func (m *ContainerLogsRequest) Reset()                    { *m = ContainerLogsRequest{} }
 func (m *ContainerLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerLogsRequest) ProtoMessage()               {}
-func (*ContainerLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{67} }
+func (*ContainerLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{82} }
 
 func (m *ContainerLogsRequest) GetContainer() string {
 	if m != nil {
@@ -3144,7 +3921,7 @@ type ContainerLogsResponse struct {
 func (m *ContainerLogsResponse) Reset()                    { *m = ContainerLogsResponse{} }
 func (m *ContainerLogsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerLogsResponse) ProtoMessage()               {}
-func (*ContainerLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{68} }
+func (*ContainerLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{83} }
 
 func (m *ContainerLogsResponse) GetLog() []byte {
 	if m != nil {
@@ -3161,7 +3938,7 @@ type DriverStatus struct {
 func (m *DriverStatus) Reset()                    { *m = DriverStatus{} }
 func (m *DriverStatus) String() string            { return proto.CompactTextString(m) }
 func (*DriverStatus) ProtoMessage()               {}
-func (*DriverStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{69} }
+func (*DriverStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{84} }
 
 func (m *DriverStatus) GetName() string {
 	if m != nil {
@@ -3183,7 +3960,7 @@ type InfoRequest struct {
 func (m *InfoRequest) Reset()                    { *m = InfoRequest{} }
 func (m *InfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*InfoRequest) ProtoMessage()               {}
-func (*InfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{70} }
+func (*InfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{85} }
 
 type InfoResponse struct {
 	ID                 string          `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -3198,12 +3975,19 @@ type InfoResponse struct {
 	Pods               int64           `protobuf:"varint,10,opt,name=pods,proto3" json:"pods,omitempty"`
 	OperatingSystem    string          `protobuf:"bytes,11,opt,name=operatingSystem,proto3" json:"operatingSystem,omitempty"`
 	Name               string          `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity           *HostCapacity   `protobuf:"bytes,13,opt,name=capacity" json:"capacity,omitempty"`
+	// the addresses found allocated to more than one interface on the
+	// restore of the pods
+	IpConflicts []*IPConflict `protobuf:"bytes,14,rep,name=ipConflicts" json:"ipConflicts,omitempty"`
+	// the addresses allocated from the named networks which were not
+	// restored, they are not reserved
+	MissingNetworks []*IPAllocation `protobuf:"bytes,15,rep,name=missingNetworks" json:"missingNetworks,omitempty"`
 }
 
 func (m *InfoResponse) Reset()                    { *m = InfoResponse{} }
 func (m *InfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*InfoResponse) ProtoMessage()               {}
-func (*InfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{71} }
+func (*InfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{86} }
 
 func (m *InfoResponse) GetID() string {
 	if m != nil {
@@ -3289,100 +4073,254 @@ func (m *InfoResponse) GetName() string {
 	return ""
 }
 
-type ExecCreateRequest struct {
-	ContainerID string   `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	Command     []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
-	Tty         bool     `protobuf:"varint,3,opt,name=tty,proto3" json:"tty,omitempty"`
-}
-
-func (m *ExecCreateRequest) Reset()                    { *m = ExecCreateRequest{} }
-func (m *ExecCreateRequest) String() string            { return proto.CompactTextString(m) }
-func (*ExecCreateRequest) ProtoMessage()               {}
-func (*ExecCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{72} }
-
-func (m *ExecCreateRequest) GetContainerID() string {
-	if m != nil {
-		return m.ContainerID
-	}
-	return ""
-}
-
-func (m *ExecCreateRequest) GetCommand() []string {
+func (m *InfoResponse) GetCapacity() *HostCapacity {
 	if m != nil {
-		return m.Command
+		return m.Capacity
 	}
 	return nil
 }
 
-func (m *ExecCreateRequest) GetTty() bool {
+func (m *InfoResponse) GetIpConflicts() []*IPConflict {
 	if m != nil {
-		return m.Tty
+		return m.IpConflicts
 	}
-	return false
-}
-
-type ExecCreateResponse struct {
-	ExecID string `protobuf:"bytes,1,opt,name=execID,proto3" json:"execID,omitempty"`
+	return nil
 }
 
-func (m *ExecCreateResponse) Reset()                    { *m = ExecCreateResponse{} }
-func (m *ExecCreateResponse) String() string            { return proto.CompactTextString(m) }
-func (*ExecCreateResponse) ProtoMessage()               {}
-func (*ExecCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{73} }
-
-func (m *ExecCreateResponse) GetExecID() string {
+func (m *InfoResponse) GetMissingNetworks() []*IPAllocation {
 	if m != nil {
-		return m.ExecID
+		return m.MissingNetworks
 	}
-	return ""
+	return nil
 }
 
-type ExecStartRequest struct {
-	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	ExecID      string `protobuf:"bytes,2,opt,name=execID,proto3" json:"execID,omitempty"`
-	Stdin       []byte `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+type IPConflict struct {
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	// empty for the default bridge
+	Network string   `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Pods    []string `protobuf:"bytes,3,rep,name=pods" json:"pods,omitempty"`
 }
 
-func (m *ExecStartRequest) Reset()                    { *m = ExecStartRequest{} }
-func (m *ExecStartRequest) String() string            { return proto.CompactTextString(m) }
-func (*ExecStartRequest) ProtoMessage()               {}
-func (*ExecStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{74} }
+func (m *IPConflict) Reset()                    { *m = IPConflict{} }
+func (m *IPConflict) String() string            { return proto.CompactTextString(m) }
+func (*IPConflict) ProtoMessage()               {}
+func (*IPConflict) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{87} }
 
-func (m *ExecStartRequest) GetContainerID() string {
+func (m *IPConflict) GetIp() string {
 	if m != nil {
-		return m.ContainerID
+		return m.Ip
 	}
 	return ""
 }
 
-func (m *ExecStartRequest) GetExecID() string {
+func (m *IPConflict) GetNetwork() string {
 	if m != nil {
-		return m.ExecID
+		return m.Network
 	}
 	return ""
 }
 
-func (m *ExecStartRequest) GetStdin() []byte {
+func (m *IPConflict) GetPods() []string {
 	if m != nil {
-		return m.Stdin
+		return m.Pods
 	}
 	return nil
 }
 
-type ExecStartResponse struct {
-	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+// IPAllocation is an address allocated to an interface of a pod.
+type IPAllocation struct {
+	Ip        string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Network   string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Pod       string `protobuf:"bytes,3,opt,name=pod,proto3" json:"pod,omitempty"`
+	Interface string `protobuf:"bytes,4,opt,name=interface,proto3" json:"interface,omitempty"`
 }
 
-func (m *ExecStartResponse) Reset()                    { *m = ExecStartResponse{} }
-func (m *ExecStartResponse) String() string            { return proto.CompactTextString(m) }
-func (*ExecStartResponse) ProtoMessage()               {}
-func (*ExecStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{75} }
+func (m *IPAllocation) Reset()                    { *m = IPAllocation{} }
+func (m *IPAllocation) String() string            { return proto.CompactTextString(m) }
+func (*IPAllocation) ProtoMessage()               {}
+func (*IPAllocation) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{88} }
 
-func (m *ExecStartResponse) GetStdout() []byte {
+func (m *IPAllocation) GetIp() string {
 	if m != nil {
-		return m.Stdout
+		return m.Ip
 	}
-	return nil
+	return ""
+}
+
+func (m *IPAllocation) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *IPAllocation) GetPod() string {
+	if m != nil {
+		return m.Pod
+	}
+	return ""
+}
+
+func (m *IPAllocation) GetInterface() string {
+	if m != nil {
+		return m.Interface
+	}
+	return ""
+}
+
+// HostCapacity is the vcpus and memory (MB) allocatable to the pods, with
+// the overcommit ratios applied, and the parts committed by the pods with
+// a sandbox. The capacity and remaining are -1 if the resource is unlimited.
+type HostCapacity struct {
+	Cpu             int32 `protobuf:"varint,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	CpuCommitted    int32 `protobuf:"varint,2,opt,name=cpuCommitted,proto3" json:"cpuCommitted,omitempty"`
+	CpuRemaining    int32 `protobuf:"varint,3,opt,name=cpuRemaining,proto3" json:"cpuRemaining,omitempty"`
+	Memory          int64 `protobuf:"varint,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	MemoryCommitted int64 `protobuf:"varint,5,opt,name=memoryCommitted,proto3" json:"memoryCommitted,omitempty"`
+	MemoryRemaining int64 `protobuf:"varint,6,opt,name=memoryRemaining,proto3" json:"memoryRemaining,omitempty"`
+}
+
+func (m *HostCapacity) Reset()                    { *m = HostCapacity{} }
+func (m *HostCapacity) String() string            { return proto.CompactTextString(m) }
+func (*HostCapacity) ProtoMessage()               {}
+func (*HostCapacity) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{89} }
+
+func (m *HostCapacity) GetCpu() int32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *HostCapacity) GetCpuCommitted() int32 {
+	if m != nil {
+		return m.CpuCommitted
+	}
+	return 0
+}
+
+func (m *HostCapacity) GetCpuRemaining() int32 {
+	if m != nil {
+		return m.CpuRemaining
+	}
+	return 0
+}
+
+func (m *HostCapacity) GetMemory() int64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *HostCapacity) GetMemoryCommitted() int64 {
+	if m != nil {
+		return m.MemoryCommitted
+	}
+	return 0
+}
+
+func (m *HostCapacity) GetMemoryRemaining() int64 {
+	if m != nil {
+		return m.MemoryRemaining
+	}
+	return 0
+}
+
+type ExecCreateRequest struct {
+	ContainerID string   `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Command     []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
+	Tty         bool     `protobuf:"varint,3,opt,name=tty,proto3" json:"tty,omitempty"`
+}
+
+func (m *ExecCreateRequest) Reset()                    { *m = ExecCreateRequest{} }
+func (m *ExecCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecCreateRequest) ProtoMessage()               {}
+func (*ExecCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{90} }
+
+func (m *ExecCreateRequest) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *ExecCreateRequest) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *ExecCreateRequest) GetTty() bool {
+	if m != nil {
+		return m.Tty
+	}
+	return false
+}
+
+type ExecCreateResponse struct {
+	ExecID string `protobuf:"bytes,1,opt,name=execID,proto3" json:"execID,omitempty"`
+}
+
+func (m *ExecCreateResponse) Reset()                    { *m = ExecCreateResponse{} }
+func (m *ExecCreateResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecCreateResponse) ProtoMessage()               {}
+func (*ExecCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{91} }
+
+func (m *ExecCreateResponse) GetExecID() string {
+	if m != nil {
+		return m.ExecID
+	}
+	return ""
+}
+
+type ExecStartRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	ExecID      string `protobuf:"bytes,2,opt,name=execID,proto3" json:"execID,omitempty"`
+	Stdin       []byte `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+}
+
+func (m *ExecStartRequest) Reset()                    { *m = ExecStartRequest{} }
+func (m *ExecStartRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecStartRequest) ProtoMessage()               {}
+func (*ExecStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{92} }
+
+func (m *ExecStartRequest) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *ExecStartRequest) GetExecID() string {
+	if m != nil {
+		return m.ExecID
+	}
+	return ""
+}
+
+func (m *ExecStartRequest) GetStdin() []byte {
+	if m != nil {
+		return m.Stdin
+	}
+	return nil
+}
+
+type ExecStartResponse struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+}
+
+func (m *ExecStartResponse) Reset()                    { *m = ExecStartResponse{} }
+func (m *ExecStartResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecStartResponse) ProtoMessage()               {}
+func (*ExecStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{93} }
+
+func (m *ExecStartResponse) GetStdout() []byte {
+	if m != nil {
+		return m.Stdout
+	}
+	return nil
 }
 
 type ExecVMRequest struct {
@@ -3394,7 +4332,7 @@ type ExecVMRequest struct {
 func (m *ExecVMRequest) Reset()                    { *m = ExecVMRequest{} }
 func (m *ExecVMRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecVMRequest) ProtoMessage()               {}
-func (*ExecVMRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{76} }
+func (*ExecVMRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{94} }
 
 func (m *ExecVMRequest) GetPodID() string {
 	if m != nil {
@@ -3425,7 +4363,7 @@ type ExecVMResponse struct {
 func (m *ExecVMResponse) Reset()                    { *m = ExecVMResponse{} }
 func (m *ExecVMResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecVMResponse) ProtoMessage()               {}
-func (*ExecVMResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{77} }
+func (*ExecVMResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{95} }
 
 func (m *ExecVMResponse) GetStdout() []byte {
 	if m != nil {
@@ -3450,7 +4388,7 @@ type ExecSignalRequest struct {
 func (m *ExecSignalRequest) Reset()                    { *m = ExecSignalRequest{} }
 func (m *ExecSignalRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecSignalRequest) ProtoMessage()               {}
-func (*ExecSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{78} }
+func (*ExecSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{96} }
 
 func (m *ExecSignalRequest) GetContainerID() string {
 	if m != nil {
@@ -3479,7 +4417,7 @@ type ExecSignalResponse struct {
 func (m *ExecSignalResponse) Reset()                    { *m = ExecSignalResponse{} }
 func (m *ExecSignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecSignalResponse) ProtoMessage()               {}
-func (*ExecSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{79} }
+func (*ExecSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{97} }
 
 type PodStartRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -3488,7 +4426,7 @@ type PodStartRequest struct {
 func (m *PodStartRequest) Reset()                    { *m = PodStartRequest{} }
 func (m *PodStartRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodStartRequest) ProtoMessage()               {}
-func (*PodStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{80} }
+func (*PodStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{98} }
 
 func (m *PodStartRequest) GetPodID() string {
 	if m != nil {
@@ -3503,7 +4441,7 @@ type PodStartResponse struct {
 func (m *PodStartResponse) Reset()                    { *m = PodStartResponse{} }
 func (m *PodStartResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodStartResponse) ProtoMessage()               {}
-func (*PodStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{81} }
+func (*PodStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{99} }
 
 type WaitRequest struct {
 	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
@@ -3514,7 +4452,7 @@ type WaitRequest struct {
 func (m *WaitRequest) Reset()                    { *m = WaitRequest{} }
 func (m *WaitRequest) String() string            { return proto.CompactTextString(m) }
 func (*WaitRequest) ProtoMessage()               {}
-func (*WaitRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{82} }
+func (*WaitRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{100} }
 
 func (m *WaitRequest) GetContainer() string {
 	if m != nil {
@@ -3544,7 +4482,7 @@ type WaitResponse struct {
 func (m *WaitResponse) Reset()                    { *m = WaitResponse{} }
 func (m *WaitResponse) String() string            { return proto.CompactTextString(m) }
 func (*WaitResponse) ProtoMessage()               {}
-func (*WaitResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{83} }
+func (*WaitResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{101} }
 
 func (m *WaitResponse) GetExitCode() int32 {
 	if m != nil {
@@ -3561,7 +4499,7 @@ type AttachMessage struct {
 func (m *AttachMessage) Reset()                    { *m = AttachMessage{} }
 func (m *AttachMessage) String() string            { return proto.CompactTextString(m) }
 func (*AttachMessage) ProtoMessage()               {}
-func (*AttachMessage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{84} }
+func (*AttachMessage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{102} }
 
 func (m *AttachMessage) GetContainerID() string {
 	if m != nil {
@@ -3577,6 +4515,40 @@ func (m *AttachMessage) GetData() []byte {
 	return nil
 }
 
+// PortForwardMessage carries the data of a TCP connection to a port of the
+// pod, the first message from the client sets the podID and the port.
+type PortForwardMessage struct {
+	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Port  int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Data  []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PortForwardMessage) Reset()                    { *m = PortForwardMessage{} }
+func (m *PortForwardMessage) String() string            { return proto.CompactTextString(m) }
+func (*PortForwardMessage) ProtoMessage()               {}
+func (*PortForwardMessage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{103} }
+
+func (m *PortForwardMessage) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PortForwardMessage) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *PortForwardMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type ContainerCreateRequest struct {
 	ContainerSpec *UserContainer `protobuf:"bytes,1,opt,name=containerSpec" json:"containerSpec,omitempty"`
 	PodID         string         `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -3585,7 +4557,7 @@ type ContainerCreateRequest struct {
 func (m *ContainerCreateRequest) Reset()                    { *m = ContainerCreateRequest{} }
 func (m *ContainerCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerCreateRequest) ProtoMessage()               {}
-func (*ContainerCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{85} }
+func (*ContainerCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{104} }
 
 func (m *ContainerCreateRequest) GetContainerSpec() *UserContainer {
 	if m != nil {
@@ -3608,7 +4580,7 @@ type ContainerCreateResponse struct {
 func (m *ContainerCreateResponse) Reset()                    { *m = ContainerCreateResponse{} }
 func (m *ContainerCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerCreateResponse) ProtoMessage()               {}
-func (*ContainerCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{86} }
+func (*ContainerCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{105} }
 
 func (m *ContainerCreateResponse) GetContainerID() string {
 	if m != nil {
@@ -3625,7 +4597,7 @@ type ContainerStartRequest struct {
 func (m *ContainerStartRequest) Reset()                    { *m = ContainerStartRequest{} }
 func (m *ContainerStartRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStartRequest) ProtoMessage()               {}
-func (*ContainerStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{87} }
+func (*ContainerStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{106} }
 
 func (m *ContainerStartRequest) GetContainerId() string {
 	if m != nil {
@@ -3640,7 +4612,7 @@ type ContainerStartResponse struct {
 func (m *ContainerStartResponse) Reset()                    { *m = ContainerStartResponse{} }
 func (m *ContainerStartResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStartResponse) ProtoMessage()               {}
-func (*ContainerStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{88} }
+func (*ContainerStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{107} }
 
 type ContainerRenameRequest struct {
 	OldContainerName string `protobuf:"bytes,1,opt,name=oldContainerName,proto3" json:"oldContainerName,omitempty"`
@@ -3650,7 +4622,7 @@ type ContainerRenameRequest struct {
 func (m *ContainerRenameRequest) Reset()                    { *m = ContainerRenameRequest{} }
 func (m *ContainerRenameRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRenameRequest) ProtoMessage()               {}
-func (*ContainerRenameRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{89} }
+func (*ContainerRenameRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{108} }
 
 func (m *ContainerRenameRequest) GetOldContainerName() string {
 	if m != nil {
@@ -3672,7 +4644,7 @@ type ContainerRenameResponse struct {
 func (m *ContainerRenameResponse) Reset()                    { *m = ContainerRenameResponse{} }
 func (m *ContainerRenameResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRenameResponse) ProtoMessage()               {}
-func (*ContainerRenameResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{90} }
+func (*ContainerRenameResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{109} }
 
 type ContainerRemoveRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
@@ -3681,7 +4653,7 @@ type ContainerRemoveRequest struct {
 func (m *ContainerRemoveRequest) Reset()                    { *m = ContainerRemoveRequest{} }
 func (m *ContainerRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRemoveRequest) ProtoMessage()               {}
-func (*ContainerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{91} }
+func (*ContainerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{110} }
 
 func (m *ContainerRemoveRequest) GetContainerId() string {
 	if m != nil {
@@ -3696,7 +4668,7 @@ type ContainerRemoveResponse struct {
 func (m *ContainerRemoveResponse) Reset()                    { *m = ContainerRemoveResponse{} }
 func (m *ContainerRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRemoveResponse) ProtoMessage()               {}
-func (*ContainerRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{92} }
+func (*ContainerRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{111} }
 
 type AuthConfig struct {
 	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -3710,7 +4682,7 @@ type AuthConfig struct {
 func (m *AuthConfig) Reset()                    { *m = AuthConfig{} }
 func (m *AuthConfig) String() string            { return proto.CompactTextString(m) }
 func (*AuthConfig) ProtoMessage()               {}
-func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{93} }
+func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{112} }
 
 func (m *AuthConfig) GetUsername() string {
 	if m != nil {
@@ -3763,7 +4735,7 @@ type ImagePullRequest struct {
 func (m *ImagePullRequest) Reset()                    { *m = ImagePullRequest{} }
 func (m *ImagePullRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImagePullRequest) ProtoMessage()               {}
-func (*ImagePullRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{94} }
+func (*ImagePullRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{113} }
 
 func (m *ImagePullRequest) GetImage() string {
 	if m != nil {
@@ -3793,7 +4765,7 @@ type ImagePullResponse struct {
 func (m *ImagePullResponse) Reset()                    { *m = ImagePullResponse{} }
 func (m *ImagePullResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImagePullResponse) ProtoMessage()               {}
-func (*ImagePullResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{95} }
+func (*ImagePullResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{114} }
 
 func (m *ImagePullResponse) GetData() []byte {
 	if m != nil {
@@ -3811,7 +4783,7 @@ type ImagePushRequest struct {
 func (m *ImagePushRequest) Reset()                    { *m = ImagePushRequest{} }
 func (m *ImagePushRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImagePushRequest) ProtoMessage()               {}
-func (*ImagePushRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{96} }
+func (*ImagePushRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{115} }
 
 func (m *ImagePushRequest) GetRepo() string {
 	if m != nil {
@@ -3841,7 +4813,7 @@ type ImagePushResponse struct {
 func (m *ImagePushResponse) Reset()                    { *m = ImagePushResponse{} }
 func (m *ImagePushResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImagePushResponse) ProtoMessage()               {}
-func (*ImagePushResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{97} }
+func (*ImagePushResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{116} }
 
 func (m *ImagePushResponse) GetData() []byte {
 	if m != nil {
@@ -3859,7 +4831,7 @@ type ImageRemoveRequest struct {
 func (m *ImageRemoveRequest) Reset()                    { *m = ImageRemoveRequest{} }
 func (m *ImageRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImageRemoveRequest) ProtoMessage()               {}
-func (*ImageRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{98} }
+func (*ImageRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{117} }
 
 func (m *ImageRemoveRequest) GetImage() string {
 	if m != nil {
@@ -3890,7 +4862,7 @@ type ImageDelete struct {
 func (m *ImageDelete) Reset()                    { *m = ImageDelete{} }
 func (m *ImageDelete) String() string            { return proto.CompactTextString(m) }
 func (*ImageDelete) ProtoMessage()               {}
-func (*ImageDelete) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{99} }
+func (*ImageDelete) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{118} }
 
 func (m *ImageDelete) GetUntaged() string {
 	if m != nil {
@@ -3913,7 +4885,7 @@ type ImageRemoveResponse struct {
 func (m *ImageRemoveResponse) Reset()                    { *m = ImageRemoveResponse{} }
 func (m *ImageRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImageRemoveResponse) ProtoMessage()               {}
-func (*ImageRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{100} }
+func (*ImageRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{119} }
 
 func (m *ImageRemoveResponse) GetImages() []*ImageDelete {
 	if m != nil {
@@ -3922,263 +4894,979 @@ func (m *ImageRemoveResponse) GetImages() []*ImageDelete {
 	return nil
 }
 
-type ContainerStopRequest struct {
-	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	Timeout     int64  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+type ImageGCRequest struct {
+	// dryRun only reports the images which would be removed
+	DryRun bool `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// force removes all unused images even if disk usage is below
+	// the high threshold
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (m *ContainerStopRequest) Reset()                    { *m = ContainerStopRequest{} }
-func (m *ContainerStopRequest) String() string            { return proto.CompactTextString(m) }
-func (*ContainerStopRequest) ProtoMessage()               {}
-func (*ContainerStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{101} }
+func (m *ImageGCRequest) Reset()                    { *m = ImageGCRequest{} }
+func (m *ImageGCRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageGCRequest) ProtoMessage()               {}
+func (*ImageGCRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{120} }
 
-func (m *ContainerStopRequest) GetContainerID() string {
+func (m *ImageGCRequest) GetDryRun() bool {
 	if m != nil {
-		return m.ContainerID
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImageGCRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type ImageGCCandidate struct {
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepoTags    []string `protobuf:"bytes,2,rep,name=repoTags" json:"repoTags,omitempty"`
+	VirtualSize int64    `protobuf:"varint,3,opt,name=virtualSize,proto3" json:"virtualSize,omitempty"`
+	LastUsed    int64    `protobuf:"varint,4,opt,name=lastUsed,proto3" json:"lastUsed,omitempty"`
+}
+
+func (m *ImageGCCandidate) Reset()                    { *m = ImageGCCandidate{} }
+func (m *ImageGCCandidate) String() string            { return proto.CompactTextString(m) }
+func (*ImageGCCandidate) ProtoMessage()               {}
+func (*ImageGCCandidate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{121} }
+
+func (m *ImageGCCandidate) GetId() string {
+	if m != nil {
+		return m.Id
 	}
 	return ""
 }
 
-func (m *ContainerStopRequest) GetTimeout() int64 {
+func (m *ImageGCCandidate) GetRepoTags() []string {
 	if m != nil {
-		return m.Timeout
+		return m.RepoTags
 	}
-	return 0
+	return nil
 }
 
-type ContainerStopResponse struct {
+func (m *ImageGCCandidate) GetVirtualSize() int64 {
+	if m != nil {
+		return m.VirtualSize
+	}
+	return 0
 }
 
-func (m *ContainerStopResponse) Reset()                    { *m = ContainerStopResponse{} }
-func (m *ContainerStopResponse) String() string            { return proto.CompactTextString(m) }
-func (*ContainerStopResponse) ProtoMessage()               {}
-func (*ContainerStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{102} }
+func (m *ImageGCCandidate) GetLastUsed() int64 {
+	if m != nil {
+		return m.LastUsed
+	}
+	return 0
+}
 
-type VersionRequest struct {
+type ImageGCStats struct {
+	Runs           int64 `protobuf:"varint,1,opt,name=runs,proto3" json:"runs,omitempty"`
+	RemovedImages  int64 `protobuf:"varint,2,opt,name=removedImages,proto3" json:"removedImages,omitempty"`
+	ReclaimedBytes int64 `protobuf:"varint,3,opt,name=reclaimedBytes,proto3" json:"reclaimedBytes,omitempty"`
+	LastRun        int64 `protobuf:"varint,4,opt,name=lastRun,proto3" json:"lastRun,omitempty"`
 }
 
-func (m *VersionRequest) Reset()                    { *m = VersionRequest{} }
-func (m *VersionRequest) String() string            { return proto.CompactTextString(m) }
-func (*VersionRequest) ProtoMessage()               {}
-func (*VersionRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{103} }
+func (m *ImageGCStats) Reset()                    { *m = ImageGCStats{} }
+func (m *ImageGCStats) String() string            { return proto.CompactTextString(m) }
+func (*ImageGCStats) ProtoMessage()               {}
+func (*ImageGCStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{122} }
 
-type VersionResponse struct {
-	// Version is the version of hyperd
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// ApiVersion is the version of gRPC API
-	ApiVersion string `protobuf:"bytes,2,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+func (m *ImageGCStats) GetRuns() int64 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
 }
 
-func (m *VersionResponse) Reset()                    { *m = VersionResponse{} }
-func (m *VersionResponse) String() string            { return proto.CompactTextString(m) }
-func (*VersionResponse) ProtoMessage()               {}
-func (*VersionResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{104} }
+func (m *ImageGCStats) GetRemovedImages() int64 {
+	if m != nil {
+		return m.RemovedImages
+	}
+	return 0
+}
 
-func (m *VersionResponse) GetVersion() string {
+func (m *ImageGCStats) GetReclaimedBytes() int64 {
 	if m != nil {
-		return m.Version
+		return m.ReclaimedBytes
 	}
-	return ""
+	return 0
 }
 
-func (m *VersionResponse) GetApiVersion() string {
+func (m *ImageGCStats) GetLastRun() int64 {
 	if m != nil {
-		return m.ApiVersion
+		return m.LastRun
 	}
-	return ""
+	return 0
 }
 
-type ServiceListResponse struct {
-	Services []*UserService `protobuf:"bytes,1,rep,name=services" json:"services,omitempty"`
+type ImageGCResponse struct {
+	Images         []*ImageGCCandidate `protobuf:"bytes,1,rep,name=images" json:"images,omitempty"`
+	ReclaimedBytes int64               `protobuf:"varint,2,opt,name=reclaimedBytes,proto3" json:"reclaimedBytes,omitempty"`
+	UsedBytes      int64               `protobuf:"varint,3,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
+	TotalBytes     int64               `protobuf:"varint,4,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	Stats          *ImageGCStats       `protobuf:"bytes,5,opt,name=stats" json:"stats,omitempty"`
 }
 
-func (m *ServiceListResponse) Reset()                    { *m = ServiceListResponse{} }
-func (m *ServiceListResponse) String() string            { return proto.CompactTextString(m) }
-func (*ServiceListResponse) ProtoMessage()               {}
-func (*ServiceListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{105} }
+func (m *ImageGCResponse) Reset()                    { *m = ImageGCResponse{} }
+func (m *ImageGCResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImageGCResponse) ProtoMessage()               {}
+func (*ImageGCResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{123} }
 
-func (m *ServiceListResponse) GetServices() []*UserService {
+func (m *ImageGCResponse) GetImages() []*ImageGCCandidate {
 	if m != nil {
-		return m.Services
+		return m.Images
 	}
 	return nil
 }
 
-type ServiceListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+func (m *ImageGCResponse) GetReclaimedBytes() int64 {
+	if m != nil {
+		return m.ReclaimedBytes
+	}
+	return 0
 }
 
-func (m *ServiceListRequest) Reset()                    { *m = ServiceListRequest{} }
-func (m *ServiceListRequest) String() string            { return proto.CompactTextString(m) }
-func (*ServiceListRequest) ProtoMessage()               {}
-func (*ServiceListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{106} }
+func (m *ImageGCResponse) GetUsedBytes() int64 {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return 0
+}
 
-func (m *ServiceListRequest) GetPodID() string {
+func (m *ImageGCResponse) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *ImageGCResponse) GetStats() *ImageGCStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type ContainerStopRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Timeout     int64  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *ContainerStopRequest) Reset()                    { *m = ContainerStopRequest{} }
+func (m *ContainerStopRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStopRequest) ProtoMessage()               {}
+func (*ContainerStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{124} }
+
+func (m *ContainerStopRequest) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *ContainerStopRequest) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type ContainerStopResponse struct {
+}
+
+func (m *ContainerStopResponse) Reset()                    { *m = ContainerStopResponse{} }
+func (m *ContainerStopResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStopResponse) ProtoMessage()               {}
+func (*ContainerStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{125} }
+
+type ReloadConfigRequest struct {
+}
+
+func (m *ReloadConfigRequest) Reset()                    { *m = ReloadConfigRequest{} }
+func (m *ReloadConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReloadConfigRequest) ProtoMessage()               {}
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{126} }
+
+type ReloadConfigRejected struct {
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ReloadConfigRejected) Reset()                    { *m = ReloadConfigRejected{} }
+func (m *ReloadConfigRejected) String() string            { return proto.CompactTextString(m) }
+func (*ReloadConfigRejected) ProtoMessage()               {}
+func (*ReloadConfigRejected) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{127} }
+
+func (m *ReloadConfigRejected) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReloadConfigRejected) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ReloadConfigResponse struct {
+	// applied is the changed config keys which took effect
+	Applied []string `protobuf:"bytes,1,rep,name=applied" json:"applied,omitempty"`
+	// rejected is the changed config keys which were not applied
+	Rejected []*ReloadConfigRejected `protobuf:"bytes,2,rep,name=rejected" json:"rejected,omitempty"`
+}
+
+func (m *ReloadConfigResponse) Reset()                    { *m = ReloadConfigResponse{} }
+func (m *ReloadConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReloadConfigResponse) ProtoMessage()               {}
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{128} }
+
+func (m *ReloadConfigResponse) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *ReloadConfigResponse) GetRejected() []*ReloadConfigRejected {
+	if m != nil {
+		return m.Rejected
+	}
+	return nil
+}
+
+type VMFactoryProfile struct {
+	Cpu    int32 `protobuf:"varint,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory int32 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// cache is the number of VMs kept booted for the profile
+	Cache    int32 `protobuf:"varint,3,opt,name=cache,proto3" json:"cache,omitempty"`
+	Template bool  `protobuf:"varint,4,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (m *VMFactoryProfile) Reset()                    { *m = VMFactoryProfile{} }
+func (m *VMFactoryProfile) String() string            { return proto.CompactTextString(m) }
+func (*VMFactoryProfile) ProtoMessage()               {}
+func (*VMFactoryProfile) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{129} }
+
+func (m *VMFactoryProfile) GetCpu() int32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *VMFactoryProfile) GetMemory() int32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *VMFactoryProfile) GetCache() int32 {
+	if m != nil {
+		return m.Cache
+	}
+	return 0
+}
+
+func (m *VMFactoryProfile) GetTemplate() bool {
+	if m != nil {
+		return m.Template
+	}
+	return false
+}
+
+type VMFactoryProfileStatus struct {
+	Profile *VMFactoryProfile `protobuf:"bytes,1,opt,name=profile" json:"profile,omitempty"`
+	// cached is the number of VMs ready in the cache
+	Cached int32 `protobuf:"varint,2,opt,name=cached,proto3" json:"cached,omitempty"`
+	// booting is the number of VMs being booted for the cache
+	Booting int32 `protobuf:"varint,3,opt,name=booting,proto3" json:"booting,omitempty"`
+	// hits is the number of VMs served from the cache
+	Hits int64 `protobuf:"varint,4,opt,name=hits,proto3" json:"hits,omitempty"`
+	// misses is the number of VMs booted on demand as the cache was empty
+	Misses int64 `protobuf:"varint,5,opt,name=misses,proto3" json:"misses,omitempty"`
+	// errors is the number of VMs failed to boot for the cache
+	Errors int64 `protobuf:"varint,6,opt,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *VMFactoryProfileStatus) Reset()                    { *m = VMFactoryProfileStatus{} }
+func (m *VMFactoryProfileStatus) String() string            { return proto.CompactTextString(m) }
+func (*VMFactoryProfileStatus) ProtoMessage()               {}
+func (*VMFactoryProfileStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{130} }
+
+func (m *VMFactoryProfileStatus) GetProfile() *VMFactoryProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *VMFactoryProfileStatus) GetCached() int32 {
+	if m != nil {
+		return m.Cached
+	}
+	return 0
+}
+
+func (m *VMFactoryProfileStatus) GetBooting() int32 {
+	if m != nil {
+		return m.Booting
+	}
+	return 0
+}
+
+func (m *VMFactoryProfileStatus) GetHits() int64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *VMFactoryProfileStatus) GetMisses() int64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *VMFactoryProfileStatus) GetErrors() int64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+type VMFactoryStatusRequest struct {
+	// runtime is the runtime profile of the factory, the default runtime if
+	// empty
+	Runtime string `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
+}
+
+func (m *VMFactoryStatusRequest) Reset()                    { *m = VMFactoryStatusRequest{} }
+func (m *VMFactoryStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*VMFactoryStatusRequest) ProtoMessage()               {}
+func (*VMFactoryStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{131} }
+
+func (m *VMFactoryStatusRequest) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+type VMFactoryStatusResponse struct {
+	Policy   string                    `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Profiles []*VMFactoryProfileStatus `protobuf:"bytes,2,rep,name=profiles" json:"profiles,omitempty"`
+	// fallbacks is the number of VMs requested without a matching profile
+	Fallbacks int64 `protobuf:"varint,3,opt,name=fallbacks,proto3" json:"fallbacks,omitempty"`
+}
+
+func (m *VMFactoryStatusResponse) Reset()                    { *m = VMFactoryStatusResponse{} }
+func (m *VMFactoryStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*VMFactoryStatusResponse) ProtoMessage()               {}
+func (*VMFactoryStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{132} }
+
+func (m *VMFactoryStatusResponse) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *VMFactoryStatusResponse) GetProfiles() []*VMFactoryProfileStatus {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+func (m *VMFactoryStatusResponse) GetFallbacks() int64 {
+	if m != nil {
+		return m.Fallbacks
+	}
+	return 0
+}
+
+type VMFactoryUpdateRequest struct {
+	// action is one of add, resize, drop and warmup
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// profile is identified by cpu and memory, the cache is the new size
+	// for add and resize
+	Profile *VMFactoryProfile `protobuf:"bytes,2,opt,name=profile" json:"profile,omitempty"`
+	// count is the number of VMs to boot for warmup
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// runtime is the runtime profile of the factory, the default runtime if
+	// empty
+	Runtime string `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+}
+
+func (m *VMFactoryUpdateRequest) Reset()                    { *m = VMFactoryUpdateRequest{} }
+func (m *VMFactoryUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*VMFactoryUpdateRequest) ProtoMessage()               {}
+func (*VMFactoryUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{133} }
+
+func (m *VMFactoryUpdateRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *VMFactoryUpdateRequest) GetProfile() *VMFactoryProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *VMFactoryUpdateRequest) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *VMFactoryUpdateRequest) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+type VMFactoryUpdateResponse struct {
+	Profiles []*VMFactoryProfileStatus `protobuf:"bytes,1,rep,name=profiles" json:"profiles,omitempty"`
+}
+
+func (m *VMFactoryUpdateResponse) Reset()                    { *m = VMFactoryUpdateResponse{} }
+func (m *VMFactoryUpdateResponse) String() string            { return proto.CompactTextString(m) }
+func (*VMFactoryUpdateResponse) ProtoMessage()               {}
+func (*VMFactoryUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{134} }
+
+func (m *VMFactoryUpdateResponse) GetProfiles() []*VMFactoryProfileStatus {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+type VersionRequest struct {
+}
+
+func (m *VersionRequest) Reset()                    { *m = VersionRequest{} }
+func (m *VersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*VersionRequest) ProtoMessage()               {}
+func (*VersionRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{135} }
+
+type VersionResponse struct {
+	// Version is the version of hyperd
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// ApiVersion is the version of gRPC API
+	ApiVersion string `protobuf:"bytes,2,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+}
+
+func (m *VersionResponse) Reset()                    { *m = VersionResponse{} }
+func (m *VersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*VersionResponse) ProtoMessage()               {}
+func (*VersionResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{136} }
+
+func (m *VersionResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *VersionResponse) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+type ServiceListResponse struct {
+	Services []*UserService `protobuf:"bytes,1,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *ServiceListResponse) Reset()                    { *m = ServiceListResponse{} }
+func (m *ServiceListResponse) String() string            { return proto.CompactTextString(m) }
+func (*ServiceListResponse) ProtoMessage()               {}
+func (*ServiceListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{137} }
+
+func (m *ServiceListResponse) GetServices() []*UserService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type ServiceListRequest struct {
+	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+}
+
+func (m *ServiceListRequest) Reset()                    { *m = ServiceListRequest{} }
+func (m *ServiceListRequest) String() string            { return proto.CompactTextString(m) }
+func (*ServiceListRequest) ProtoMessage()               {}
+func (*ServiceListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{138} }
+
+func (m *ServiceListRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+type ServiceAddRequest struct {
+	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *ServiceAddRequest) Reset()                    { *m = ServiceAddRequest{} }
+func (m *ServiceAddRequest) String() string            { return proto.CompactTextString(m) }
+func (*ServiceAddRequest) ProtoMessage()               {}
+func (*ServiceAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{139} }
+
+func (m *ServiceAddRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *ServiceAddRequest) GetServices() []*UserService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type ServiceAddResponse struct {
+}
+
+func (m *ServiceAddResponse) Reset()                    { *m = ServiceAddResponse{} }
+func (m *ServiceAddResponse) String() string            { return proto.CompactTextString(m) }
+func (*ServiceAddResponse) ProtoMessage()               {}
+func (*ServiceAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{140} }
+
+type ServiceDelRequest struct {
+	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *ServiceDelRequest) Reset()                    { *m = ServiceDelRequest{} }
+func (m *ServiceDelRequest) String() string            { return proto.CompactTextString(m) }
+func (*ServiceDelRequest) ProtoMessage()               {}
+func (*ServiceDelRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{141} }
+
+func (m *ServiceDelRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *ServiceDelRequest) GetServices() []*UserService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type ServiceDelResponse struct {
+}
+
+func (m *ServiceDelResponse) Reset()                    { *m = ServiceDelResponse{} }
+func (m *ServiceDelResponse) String() string            { return proto.CompactTextString(m) }
+func (*ServiceDelResponse) ProtoMessage()               {}
+func (*ServiceDelResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{142} }
+
+type ServiceUpdateRequest struct {
+	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *ServiceUpdateRequest) Reset()                    { *m = ServiceUpdateRequest{} }
+func (m *ServiceUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*ServiceUpdateRequest) ProtoMessage()               {}
+func (*ServiceUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{143} }
+
+func (m *ServiceUpdateRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *ServiceUpdateRequest) GetServices() []*UserService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type ServiceUpdateResponse struct {
+}
+
+func (m *ServiceUpdateResponse) Reset()                    { *m = ServiceUpdateResponse{} }
+func (m *ServiceUpdateResponse) String() string            { return proto.CompactTextString(m) }
+func (*ServiceUpdateResponse) ProtoMessage()               {}
+func (*ServiceUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{144} }
+
+type PortMappingListRequest struct {
+	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+}
+
+func (m *PortMappingListRequest) Reset()                    { *m = PortMappingListRequest{} }
+func (m *PortMappingListRequest) String() string            { return proto.CompactTextString(m) }
+func (*PortMappingListRequest) ProtoMessage()               {}
+func (*PortMappingListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{145} }
+
+func (m *PortMappingListRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+type PortMappingListResponse struct {
+	PortMappings []*PortMapping `protobuf:"bytes,1,rep,name=portMappings" json:"portMappings,omitempty"`
+}
+
+func (m *PortMappingListResponse) Reset()                    { *m = PortMappingListResponse{} }
+func (m *PortMappingListResponse) String() string            { return proto.CompactTextString(m) }
+func (*PortMappingListResponse) ProtoMessage()               {}
+func (*PortMappingListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{146} }
+
+func (m *PortMappingListResponse) GetPortMappings() []*PortMapping {
+	if m != nil {
+		return m.PortMappings
+	}
+	return nil
+}
+
+type PortMappingModifyRequest struct {
+	PodID        string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	PortMappings []*PortMapping `protobuf:"bytes,2,rep,name=portMappings" json:"portMappings,omitempty"`
+}
+
+func (m *PortMappingModifyRequest) Reset()                    { *m = PortMappingModifyRequest{} }
+func (m *PortMappingModifyRequest) String() string            { return proto.CompactTextString(m) }
+func (*PortMappingModifyRequest) ProtoMessage()               {}
+func (*PortMappingModifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{147} }
+
+func (m *PortMappingModifyRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PortMappingModifyRequest) GetPortMappings() []*PortMapping {
+	if m != nil {
+		return m.PortMappings
+	}
+	return nil
+}
+
+type PortMappingModifyResponse struct {
+}
+
+func (m *PortMappingModifyResponse) Reset()         { *m = PortMappingModifyResponse{} }
+func (m *PortMappingModifyResponse) String() string { return proto.CompactTextString(m) }
+func (*PortMappingModifyResponse) ProtoMessage()    {}
+func (*PortMappingModifyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{148}
+}
+
+type PodSetBandwidthRequest struct {
+	PodID       string              `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	InterfaceID string              `protobuf:"bytes,2,opt,name=interfaceID,proto3" json:"interfaceID,omitempty"`
+	Bandwidth   *InterfaceBandwidth `protobuf:"bytes,3,opt,name=bandwidth" json:"bandwidth,omitempty"`
+}
+
+func (m *PodSetBandwidthRequest) Reset()                    { *m = PodSetBandwidthRequest{} }
+func (m *PodSetBandwidthRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodSetBandwidthRequest) ProtoMessage()               {}
+func (*PodSetBandwidthRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{149} }
+
+func (m *PodSetBandwidthRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodSetBandwidthRequest) GetInterfaceID() string {
+	if m != nil {
+		return m.InterfaceID
+	}
+	return ""
+}
+
+func (m *PodSetBandwidthRequest) GetBandwidth() *InterfaceBandwidth {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return nil
+}
+
+type PodSetBandwidthResponse struct {
+}
+
+func (m *PodSetBandwidthResponse) Reset()                    { *m = PodSetBandwidthResponse{} }
+func (m *PodSetBandwidthResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodSetBandwidthResponse) ProtoMessage()               {}
+func (*PodSetBandwidthResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{150} }
+
+// Network is a named network, with its own bridge and subnet
+type Network struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the subnet in CIDR format, e.g. 10.10.0.0/24
+	Subnet string `protobuf:"bytes,2,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// the address of the bridge, the first address of the subnet by default
+	Gateway string `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// the bridge device, "hy-" followed by the name by default
+	Bridge string `protobuf:"bytes,4,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	Mtu    uint64 `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// masquerade the traffic leaving the network through the host
+	Masquerade bool              `protobuf:"varint,6,opt,name=masquerade,proto3" json:"masquerade,omitempty"`
+	Labels     map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Created    int64             `protobuf:"varint,8,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (m *Network) Reset()                    { *m = Network{} }
+func (m *Network) String() string            { return proto.CompactTextString(m) }
+func (*Network) ProtoMessage()               {}
+func (*Network) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{151} }
+
+func (m *Network) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Network) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *Network) GetGateway() string {
+	if m != nil {
+		return m.Gateway
+	}
+	return ""
+}
+
+func (m *Network) GetBridge() string {
+	if m != nil {
+		return m.Bridge
+	}
+	return ""
+}
+
+func (m *Network) GetMtu() uint64 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+func (m *Network) GetMasquerade() bool {
+	if m != nil {
+		return m.Masquerade
+	}
+	return false
+}
+
+func (m *Network) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Network) GetCreated() int64 {
 	if m != nil {
-		return m.PodID
+		return m.Created
 	}
-	return ""
+	return 0
 }
 
-type ServiceAddRequest struct {
-	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+// NetworkEndpoint is an address allocated to a pod in a network
+type NetworkEndpoint struct {
+	PodID       string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	InterfaceID string `protobuf:"bytes,2,opt,name=interfaceID,proto3" json:"interfaceID,omitempty"`
+	Ip          string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 }
 
-func (m *ServiceAddRequest) Reset()                    { *m = ServiceAddRequest{} }
-func (m *ServiceAddRequest) String() string            { return proto.CompactTextString(m) }
-func (*ServiceAddRequest) ProtoMessage()               {}
-func (*ServiceAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{107} }
+func (m *NetworkEndpoint) Reset()                    { *m = NetworkEndpoint{} }
+func (m *NetworkEndpoint) String() string            { return proto.CompactTextString(m) }
+func (*NetworkEndpoint) ProtoMessage()               {}
+func (*NetworkEndpoint) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{152} }
 
-func (m *ServiceAddRequest) GetPodID() string {
+func (m *NetworkEndpoint) GetPodID() string {
 	if m != nil {
 		return m.PodID
 	}
 	return ""
 }
 
-func (m *ServiceAddRequest) GetServices() []*UserService {
+func (m *NetworkEndpoint) GetInterfaceID() string {
 	if m != nil {
-		return m.Services
+		return m.InterfaceID
 	}
-	return nil
+	return ""
 }
 
-type ServiceAddResponse struct {
+func (m *NetworkEndpoint) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
 }
 
-func (m *ServiceAddResponse) Reset()                    { *m = ServiceAddResponse{} }
-func (m *ServiceAddResponse) String() string            { return proto.CompactTextString(m) }
-func (*ServiceAddResponse) ProtoMessage()               {}
-func (*ServiceAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{108} }
-
-type ServiceDelRequest struct {
-	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+type NetworkInfo struct {
+	Network   *Network           `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
+	Endpoints []*NetworkEndpoint `protobuf:"bytes,2,rep,name=endpoints" json:"endpoints,omitempty"`
 }
 
-func (m *ServiceDelRequest) Reset()                    { *m = ServiceDelRequest{} }
-func (m *ServiceDelRequest) String() string            { return proto.CompactTextString(m) }
-func (*ServiceDelRequest) ProtoMessage()               {}
-func (*ServiceDelRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{109} }
+func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()               {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{153} }
 
-func (m *ServiceDelRequest) GetPodID() string {
+func (m *NetworkInfo) GetNetwork() *Network {
 	if m != nil {
-		return m.PodID
+		return m.Network
 	}
-	return ""
+	return nil
 }
 
-func (m *ServiceDelRequest) GetServices() []*UserService {
+func (m *NetworkInfo) GetEndpoints() []*NetworkEndpoint {
 	if m != nil {
-		return m.Services
+		return m.Endpoints
 	}
 	return nil
 }
 
-type ServiceDelResponse struct {
-}
-
-func (m *ServiceDelResponse) Reset()                    { *m = ServiceDelResponse{} }
-func (m *ServiceDelResponse) String() string            { return proto.CompactTextString(m) }
-func (*ServiceDelResponse) ProtoMessage()               {}
-func (*ServiceDelResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{110} }
-
-type ServiceUpdateRequest struct {
-	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Services []*UserService `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+type NetworkCreateRequest struct {
+	Network *Network `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
 }
 
-func (m *ServiceUpdateRequest) Reset()                    { *m = ServiceUpdateRequest{} }
-func (m *ServiceUpdateRequest) String() string            { return proto.CompactTextString(m) }
-func (*ServiceUpdateRequest) ProtoMessage()               {}
-func (*ServiceUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{111} }
+func (m *NetworkCreateRequest) Reset()                    { *m = NetworkCreateRequest{} }
+func (m *NetworkCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkCreateRequest) ProtoMessage()               {}
+func (*NetworkCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{154} }
 
-func (m *ServiceUpdateRequest) GetPodID() string {
+func (m *NetworkCreateRequest) GetNetwork() *Network {
 	if m != nil {
-		return m.PodID
+		return m.Network
 	}
-	return ""
+	return nil
 }
 
-func (m *ServiceUpdateRequest) GetServices() []*UserService {
+type NetworkCreateResponse struct {
+	Network *Network `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
+}
+
+func (m *NetworkCreateResponse) Reset()                    { *m = NetworkCreateResponse{} }
+func (m *NetworkCreateResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkCreateResponse) ProtoMessage()               {}
+func (*NetworkCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{155} }
+
+func (m *NetworkCreateResponse) GetNetwork() *Network {
 	if m != nil {
-		return m.Services
+		return m.Network
 	}
 	return nil
 }
 
-type ServiceUpdateResponse struct {
+type NetworkListRequest struct {
 }
 
-func (m *ServiceUpdateResponse) Reset()                    { *m = ServiceUpdateResponse{} }
-func (m *ServiceUpdateResponse) String() string            { return proto.CompactTextString(m) }
-func (*ServiceUpdateResponse) ProtoMessage()               {}
-func (*ServiceUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{112} }
+func (m *NetworkListRequest) Reset()                    { *m = NetworkListRequest{} }
+func (m *NetworkListRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkListRequest) ProtoMessage()               {}
+func (*NetworkListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{156} }
 
-type PortMappingListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+type NetworkListResponse struct {
+	Networks []*Network `protobuf:"bytes,1,rep,name=networks" json:"networks,omitempty"`
 }
 
-func (m *PortMappingListRequest) Reset()                    { *m = PortMappingListRequest{} }
-func (m *PortMappingListRequest) String() string            { return proto.CompactTextString(m) }
-func (*PortMappingListRequest) ProtoMessage()               {}
-func (*PortMappingListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{113} }
+func (m *NetworkListResponse) Reset()                    { *m = NetworkListResponse{} }
+func (m *NetworkListResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkListResponse) ProtoMessage()               {}
+func (*NetworkListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{157} }
 
-func (m *PortMappingListRequest) GetPodID() string {
+func (m *NetworkListResponse) GetNetworks() []*Network {
 	if m != nil {
-		return m.PodID
+		return m.Networks
 	}
-	return ""
+	return nil
 }
 
-type PortMappingListResponse struct {
-	PortMappings []*PortMapping `protobuf:"bytes,1,rep,name=portMappings" json:"portMappings,omitempty"`
+type NetworkInspectRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *PortMappingListResponse) Reset()                    { *m = PortMappingListResponse{} }
-func (m *PortMappingListResponse) String() string            { return proto.CompactTextString(m) }
-func (*PortMappingListResponse) ProtoMessage()               {}
-func (*PortMappingListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{114} }
+func (m *NetworkInspectRequest) Reset()                    { *m = NetworkInspectRequest{} }
+func (m *NetworkInspectRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInspectRequest) ProtoMessage()               {}
+func (*NetworkInspectRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{158} }
 
-func (m *PortMappingListResponse) GetPortMappings() []*PortMapping {
+func (m *NetworkInspectRequest) GetName() string {
 	if m != nil {
-		return m.PortMappings
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type PortMappingModifyRequest struct {
-	PodID        string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	PortMappings []*PortMapping `protobuf:"bytes,2,rep,name=portMappings" json:"portMappings,omitempty"`
+type NetworkInspectResponse struct {
+	Network *NetworkInfo `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
 }
 
-func (m *PortMappingModifyRequest) Reset()                    { *m = PortMappingModifyRequest{} }
-func (m *PortMappingModifyRequest) String() string            { return proto.CompactTextString(m) }
-func (*PortMappingModifyRequest) ProtoMessage()               {}
-func (*PortMappingModifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{115} }
+func (m *NetworkInspectResponse) Reset()                    { *m = NetworkInspectResponse{} }
+func (m *NetworkInspectResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInspectResponse) ProtoMessage()               {}
+func (*NetworkInspectResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{159} }
 
-func (m *PortMappingModifyRequest) GetPodID() string {
+func (m *NetworkInspectResponse) GetNetwork() *NetworkInfo {
 	if m != nil {
-		return m.PodID
+		return m.Network
 	}
-	return ""
+	return nil
 }
 
-func (m *PortMappingModifyRequest) GetPortMappings() []*PortMapping {
+type NetworkRemoveRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *NetworkRemoveRequest) Reset()                    { *m = NetworkRemoveRequest{} }
+func (m *NetworkRemoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkRemoveRequest) ProtoMessage()               {}
+func (*NetworkRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{160} }
+
+func (m *NetworkRemoveRequest) GetName() string {
 	if m != nil {
-		return m.PortMappings
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type PortMappingModifyResponse struct {
+type NetworkRemoveResponse struct {
 }
 
-func (m *PortMappingModifyResponse) Reset()                    { *m = PortMappingModifyResponse{} }
-func (m *PortMappingModifyResponse) String() string            { return proto.CompactTextString(m) }
-func (*PortMappingModifyResponse) ProtoMessage()               {}
-func (*PortMappingModifyResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{116} }
+func (m *NetworkRemoveResponse) Reset()                    { *m = NetworkRemoveResponse{} }
+func (m *NetworkRemoveResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkRemoveResponse) ProtoMessage()               {}
+func (*NetworkRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{161} }
 
 type PodStopRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4187,7 +5875,7 @@ type PodStopRequest struct {
 func (m *PodStopRequest) Reset()                    { *m = PodStopRequest{} }
 func (m *PodStopRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodStopRequest) ProtoMessage()               {}
-func (*PodStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{117} }
+func (*PodStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{162} }
 
 func (m *PodStopRequest) GetPodID() string {
 	if m != nil {
@@ -4204,7 +5892,7 @@ type PodStopResponse struct {
 func (m *PodStopResponse) Reset()                    { *m = PodStopResponse{} }
 func (m *PodStopResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodStopResponse) ProtoMessage()               {}
-func (*PodStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{118} }
+func (*PodStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{163} }
 
 func (m *PodStopResponse) GetCode() int32 {
 	if m != nil {
@@ -4228,7 +5916,7 @@ type PodSignalRequest struct {
 func (m *PodSignalRequest) Reset()                    { *m = PodSignalRequest{} }
 func (m *PodSignalRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodSignalRequest) ProtoMessage()               {}
-func (*PodSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{119} }
+func (*PodSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{164} }
 
 func (m *PodSignalRequest) GetPodID() string {
 	if m != nil {
@@ -4250,7 +5938,7 @@ type PodSignalResponse struct {
 func (m *PodSignalResponse) Reset()                    { *m = PodSignalResponse{} }
 func (m *PodSignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodSignalResponse) ProtoMessage()               {}
-func (*PodSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{120} }
+func (*PodSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{165} }
 
 type PodPauseRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4259,7 +5947,7 @@ type PodPauseRequest struct {
 func (m *PodPauseRequest) Reset()                    { *m = PodPauseRequest{} }
 func (m *PodPauseRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodPauseRequest) ProtoMessage()               {}
-func (*PodPauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{121} }
+func (*PodPauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{166} }
 
 func (m *PodPauseRequest) GetPodID() string {
 	if m != nil {
@@ -4274,7 +5962,7 @@ type PodPauseResponse struct {
 func (m *PodPauseResponse) Reset()                    { *m = PodPauseResponse{} }
 func (m *PodPauseResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodPauseResponse) ProtoMessage()               {}
-func (*PodPauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{122} }
+func (*PodPauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{167} }
 
 type PodUnpauseRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4283,7 +5971,7 @@ type PodUnpauseRequest struct {
 func (m *PodUnpauseRequest) Reset()                    { *m = PodUnpauseRequest{} }
 func (m *PodUnpauseRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodUnpauseRequest) ProtoMessage()               {}
-func (*PodUnpauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{123} }
+func (*PodUnpauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{168} }
 
 func (m *PodUnpauseRequest) GetPodID() string {
 	if m != nil {
@@ -4298,7 +5986,202 @@ type PodUnpauseResponse struct {
 func (m *PodUnpauseResponse) Reset()                    { *m = PodUnpauseResponse{} }
 func (m *PodUnpauseResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodUnpauseResponse) ProtoMessage()               {}
-func (*PodUnpauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{124} }
+func (*PodUnpauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{169} }
+
+type PodUpdateRequest struct {
+	PodID   string   `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	PodSpec *UserPod `protobuf:"bytes,2,opt,name=podSpec" json:"podSpec,omitempty"`
+	// only report the changes, without applying them
+	DryRun bool `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (m *PodUpdateRequest) Reset()                    { *m = PodUpdateRequest{} }
+func (m *PodUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodUpdateRequest) ProtoMessage()               {}
+func (*PodUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{170} }
+
+func (m *PodUpdateRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodUpdateRequest) GetPodSpec() *UserPod {
+	if m != nil {
+		return m.PodSpec
+	}
+	return nil
+}
+
+func (m *PodUpdateRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// PodUpdateChange is a change applied to the pod by PodUpdate
+type PodUpdateChange struct {
+	// container, interface, label, service, portmapping or pod
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// add, remove, replace or update
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *PodUpdateChange) Reset()                    { *m = PodUpdateChange{} }
+func (m *PodUpdateChange) String() string            { return proto.CompactTextString(m) }
+func (*PodUpdateChange) ProtoMessage()               {}
+func (*PodUpdateChange) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{171} }
+
+func (m *PodUpdateChange) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *PodUpdateChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PodUpdateChange) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type PodUpdateResponse struct {
+	Changes []*PodUpdateChange `protobuf:"bytes,1,rep,name=changes" json:"changes,omitempty"`
+}
+
+func (m *PodUpdateResponse) Reset()                    { *m = PodUpdateResponse{} }
+func (m *PodUpdateResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodUpdateResponse) ProtoMessage()               {}
+func (*PodUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{172} }
+
+func (m *PodUpdateResponse) GetChanges() []*PodUpdateChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type PodApplyRequest struct {
+	Pods []*UserPod `protobuf:"bytes,1,rep,name=pods" json:"pods,omitempty"`
+	// only report the actions, without applying them
+	DryRun bool `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// remove the pods managed by managedBy which are not in pods
+	Prune bool `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+	// recreate the pods which could not be updated in place
+	Recreate bool `protobuf:"varint,4,opt,name=recreate,proto3" json:"recreate,omitempty"`
+	// the value of the sh.hyper.apply.managed-by label, "hyperctl" by default
+	ManagedBy string `protobuf:"bytes,5,opt,name=managedBy,proto3" json:"managedBy,omitempty"`
+}
+
+func (m *PodApplyRequest) Reset()                    { *m = PodApplyRequest{} }
+func (m *PodApplyRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodApplyRequest) ProtoMessage()               {}
+func (*PodApplyRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{173} }
+
+func (m *PodApplyRequest) GetPods() []*UserPod {
+	if m != nil {
+		return m.Pods
+	}
+	return nil
+}
+
+func (m *PodApplyRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PodApplyRequest) GetPrune() bool {
+	if m != nil {
+		return m.Prune
+	}
+	return false
+}
+
+func (m *PodApplyRequest) GetRecreate() bool {
+	if m != nil {
+		return m.Recreate
+	}
+	return false
+}
+
+func (m *PodApplyRequest) GetManagedBy() string {
+	if m != nil {
+		return m.ManagedBy
+	}
+	return ""
+}
+
+// PodApplyResult is the action taken on a pod by PodApply
+type PodApplyResult struct {
+	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	// create, update, recreate, remove or unchanged
+	Action  string             `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Changes []*PodUpdateChange `protobuf:"bytes,3,rep,name=changes" json:"changes,omitempty"`
+	// the pod failed to be applied, the other pods are applied anyway
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PodApplyResult) Reset()                    { *m = PodApplyResult{} }
+func (m *PodApplyResult) String() string            { return proto.CompactTextString(m) }
+func (*PodApplyResult) ProtoMessage()               {}
+func (*PodApplyResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{174} }
+
+func (m *PodApplyResult) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodApplyResult) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PodApplyResult) GetChanges() []*PodUpdateChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *PodApplyResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type PodApplyResponse struct {
+	Results []*PodApplyResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *PodApplyResponse) Reset()                    { *m = PodApplyResponse{} }
+func (m *PodApplyResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodApplyResponse) ProtoMessage()               {}
+func (*PodApplyResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{175} }
+
+func (m *PodApplyResponse) GetResults() []*PodApplyResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
 
 type PodLabelsRequest struct {
 	PodID    string            `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4309,7 +6192,7 @@ type PodLabelsRequest struct {
 func (m *PodLabelsRequest) Reset()                    { *m = PodLabelsRequest{} }
 func (m *PodLabelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodLabelsRequest) ProtoMessage()               {}
-func (*PodLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{125} }
+func (*PodLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{176} }
 
 func (m *PodLabelsRequest) GetPodID() string {
 	if m != nil {
@@ -4338,7 +6221,7 @@ type PodLabelsResponse struct {
 func (m *PodLabelsResponse) Reset()                    { *m = PodLabelsResponse{} }
 func (m *PodLabelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodLabelsResponse) ProtoMessage()               {}
-func (*PodLabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{126} }
+func (*PodLabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{177} }
 
 type PodStatsRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4347,7 +6230,7 @@ type PodStatsRequest struct {
 func (m *PodStatsRequest) Reset()                    { *m = PodStatsRequest{} }
 func (m *PodStatsRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodStatsRequest) ProtoMessage()               {}
-func (*PodStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{127} }
+func (*PodStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{178} }
 
 func (m *PodStatsRequest) GetPodID() string {
 	if m != nil {
@@ -4363,7 +6246,7 @@ type PodStatsResponse struct {
 func (m *PodStatsResponse) Reset()                    { *m = PodStatsResponse{} }
 func (m *PodStatsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodStatsResponse) ProtoMessage()               {}
-func (*PodStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{128} }
+func (*PodStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{179} }
 
 func (m *PodStatsResponse) GetPodStats() *PodStats {
 	if m != nil {
@@ -4378,7 +6261,7 @@ type PingRequest struct {
 func (m *PingRequest) Reset()                    { *m = PingRequest{} }
 func (m *PingRequest) String() string            { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()               {}
-func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{129} }
+func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{180} }
 
 type PingResponse struct {
 	HyperdStats string `protobuf:"bytes,1,opt,name=hyperdStats,proto3" json:"hyperdStats,omitempty"`
@@ -4387,7 +6270,7 @@ type PingResponse struct {
 func (m *PingResponse) Reset()                    { *m = PingResponse{} }
 func (m *PingResponse) String() string            { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()               {}
-func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{130} }
+func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{181} }
 
 func (m *PingResponse) GetHyperdStats() string {
 	if m != nil {
@@ -4405,7 +6288,7 @@ type ContainerSignalRequest struct {
 func (m *ContainerSignalRequest) Reset()                    { *m = ContainerSignalRequest{} }
 func (m *ContainerSignalRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerSignalRequest) ProtoMessage()               {}
-func (*ContainerSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{131} }
+func (*ContainerSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{182} }
 
 func (m *ContainerSignalRequest) GetPodID() string {
 	if m != nil {
@@ -4434,7 +6317,7 @@ type ContainerSignalResponse struct {
 func (m *ContainerSignalResponse) Reset()                    { *m = ContainerSignalResponse{} }
 func (m *ContainerSignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerSignalResponse) ProtoMessage()               {}
-func (*ContainerSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{132} }
+func (*ContainerSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{183} }
 
 type TTYResizeRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
@@ -4446,7 +6329,7 @@ type TTYResizeRequest struct {
 func (m *TTYResizeRequest) Reset()                    { *m = TTYResizeRequest{} }
 func (m *TTYResizeRequest) String() string            { return proto.CompactTextString(m) }
 func (*TTYResizeRequest) ProtoMessage()               {}
-func (*TTYResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{133} }
+func (*TTYResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{184} }
 
 func (m *TTYResizeRequest) GetContainerID() string {
 	if m != nil {
@@ -4482,7 +6365,7 @@ type TTYResizeResponse struct {
 func (m *TTYResizeResponse) Reset()                    { *m = TTYResizeResponse{} }
 func (m *TTYResizeResponse) String() string            { return proto.CompactTextString(m) }
 func (*TTYResizeResponse) ProtoMessage()               {}
-func (*TTYResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{134} }
+func (*TTYResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{185} }
 
 func init() {
 	proto.RegisterType((*ContainerPort)(nil), "types.ContainerPort")
@@ -4492,12 +6375,14 @@ func init() {
 	proto.RegisterType((*RunningStatus)(nil), "types.RunningStatus")
 	proto.RegisterType((*TermStatus)(nil), "types.TermStatus")
 	proto.RegisterType((*ContainerStatus)(nil), "types.ContainerStatus")
+	proto.RegisterType((*ContainerEvent)(nil), "types.ContainerEvent")
 	proto.RegisterType((*ContainerInfo)(nil), "types.ContainerInfo")
 	proto.RegisterType((*Container)(nil), "types.Container")
 	proto.RegisterType((*RBDVolumeSource)(nil), "types.RBDVolumeSource")
 	proto.RegisterType((*PodVolume)(nil), "types.PodVolume")
 	proto.RegisterType((*PodSpec)(nil), "types.PodSpec")
 	proto.RegisterType((*PodStatus)(nil), "types.PodStatus")
+	proto.RegisterType((*PodInterfaceStatus)(nil), "types.PodInterfaceStatus")
 	proto.RegisterType((*PodInfo)(nil), "types.PodInfo")
 	proto.RegisterType((*ImageInfo)(nil), "types.ImageInfo")
 	proto.RegisterType((*PodStats)(nil), "types.PodStats")
@@ -4537,17 +6422,30 @@ func init() {
 	proto.RegisterType((*UserUser)(nil), "types.UserUser")
 	proto.RegisterType((*Ulimit)(nil), "types.Ulimit")
 	proto.RegisterType((*UserContainer)(nil), "types.UserContainer")
+	proto.RegisterType((*UserContainerResources)(nil), "types.UserContainerResources")
+	proto.RegisterType((*UserContainerHook)(nil), "types.UserContainerHook")
+	proto.RegisterType((*UserContainerHTTPHook)(nil), "types.UserContainerHTTPHook")
+	proto.RegisterType((*UserContainerDependency)(nil), "types.UserContainerDependency")
+	proto.RegisterType((*UserContainerHealthCheck)(nil), "types.UserContainerHealthCheck")
 	proto.RegisterType((*UserResource)(nil), "types.UserResource")
 	proto.RegisterType((*UserFile)(nil), "types.UserFile")
 	proto.RegisterType((*UserVolumeOption)(nil), "types.UserVolumeOption")
 	proto.RegisterType((*UserVolume)(nil), "types.UserVolume")
 	proto.RegisterType((*UserInterface)(nil), "types.UserInterface")
+	proto.RegisterType((*InterfaceBandwidth)(nil), "types.InterfaceBandwidth")
 	proto.RegisterType((*UserServiceBackend)(nil), "types.UserServiceBackend")
+	proto.RegisterType((*ServiceHealthCheck)(nil), "types.ServiceHealthCheck")
 	proto.RegisterType((*UserService)(nil), "types.UserService")
 	proto.RegisterType((*PodLogConfig)(nil), "types.PodLogConfig")
 	proto.RegisterType((*PortMapping)(nil), "types.PortMapping")
 	proto.RegisterType((*PortmappingWhiteList)(nil), "types.PortmappingWhiteList")
 	proto.RegisterType((*UserPod)(nil), "types.UserPod")
+	proto.RegisterType((*HostAlias)(nil), "types.HostAlias")
+	proto.RegisterType((*NetworkPolicy)(nil), "types.NetworkPolicy")
+	proto.RegisterType((*NetworkPolicyRule)(nil), "types.NetworkPolicyRule")
+	proto.RegisterType((*NetworkPolicyPeer)(nil), "types.NetworkPolicyPeer")
+	proto.RegisterType((*NetworkPolicyPort)(nil), "types.NetworkPolicyPort")
+	proto.RegisterType((*UserPodRuntime)(nil), "types.UserPodRuntime")
 	proto.RegisterType((*PodCreateRequest)(nil), "types.PodCreateRequest")
 	proto.RegisterType((*PodCreateResponse)(nil), "types.PodCreateResponse")
 	proto.RegisterType((*PodRemoveRequest)(nil), "types.PodRemoveRequest")
@@ -4557,6 +6455,9 @@ func init() {
 	proto.RegisterType((*DriverStatus)(nil), "types.DriverStatus")
 	proto.RegisterType((*InfoRequest)(nil), "types.InfoRequest")
 	proto.RegisterType((*InfoResponse)(nil), "types.InfoResponse")
+	proto.RegisterType((*IPConflict)(nil), "types.IPConflict")
+	proto.RegisterType((*IPAllocation)(nil), "types.IPAllocation")
+	proto.RegisterType((*HostCapacity)(nil), "types.HostCapacity")
 	proto.RegisterType((*ExecCreateRequest)(nil), "types.ExecCreateRequest")
 	proto.RegisterType((*ExecCreateResponse)(nil), "types.ExecCreateResponse")
 	proto.RegisterType((*ExecStartRequest)(nil), "types.ExecStartRequest")
@@ -4570,6 +6471,7 @@ func init() {
 	proto.RegisterType((*WaitRequest)(nil), "types.WaitRequest")
 	proto.RegisterType((*WaitResponse)(nil), "types.WaitResponse")
 	proto.RegisterType((*AttachMessage)(nil), "types.AttachMessage")
+	proto.RegisterType((*PortForwardMessage)(nil), "types.PortForwardMessage")
 	proto.RegisterType((*ContainerCreateRequest)(nil), "types.ContainerCreateRequest")
 	proto.RegisterType((*ContainerCreateResponse)(nil), "types.ContainerCreateResponse")
 	proto.RegisterType((*ContainerStartRequest)(nil), "types.ContainerStartRequest")
@@ -4586,8 +6488,21 @@ func init() {
 	proto.RegisterType((*ImageRemoveRequest)(nil), "types.ImageRemoveRequest")
 	proto.RegisterType((*ImageDelete)(nil), "types.ImageDelete")
 	proto.RegisterType((*ImageRemoveResponse)(nil), "types.ImageRemoveResponse")
+	proto.RegisterType((*ImageGCRequest)(nil), "types.ImageGCRequest")
+	proto.RegisterType((*ImageGCCandidate)(nil), "types.ImageGCCandidate")
+	proto.RegisterType((*ImageGCStats)(nil), "types.ImageGCStats")
+	proto.RegisterType((*ImageGCResponse)(nil), "types.ImageGCResponse")
 	proto.RegisterType((*ContainerStopRequest)(nil), "types.ContainerStopRequest")
 	proto.RegisterType((*ContainerStopResponse)(nil), "types.ContainerStopResponse")
+	proto.RegisterType((*ReloadConfigRequest)(nil), "types.ReloadConfigRequest")
+	proto.RegisterType((*ReloadConfigRejected)(nil), "types.ReloadConfigRejected")
+	proto.RegisterType((*ReloadConfigResponse)(nil), "types.ReloadConfigResponse")
+	proto.RegisterType((*VMFactoryProfile)(nil), "types.VMFactoryProfile")
+	proto.RegisterType((*VMFactoryProfileStatus)(nil), "types.VMFactoryProfileStatus")
+	proto.RegisterType((*VMFactoryStatusRequest)(nil), "types.VMFactoryStatusRequest")
+	proto.RegisterType((*VMFactoryStatusResponse)(nil), "types.VMFactoryStatusResponse")
+	proto.RegisterType((*VMFactoryUpdateRequest)(nil), "types.VMFactoryUpdateRequest")
+	proto.RegisterType((*VMFactoryUpdateResponse)(nil), "types.VMFactoryUpdateResponse")
 	proto.RegisterType((*VersionRequest)(nil), "types.VersionRequest")
 	proto.RegisterType((*VersionResponse)(nil), "types.VersionResponse")
 	proto.RegisterType((*ServiceListResponse)(nil), "types.ServiceListResponse")
@@ -4602,6 +6517,19 @@ func init() {
 	proto.RegisterType((*PortMappingListResponse)(nil), "types.PortMappingListResponse")
 	proto.RegisterType((*PortMappingModifyRequest)(nil), "types.PortMappingModifyRequest")
 	proto.RegisterType((*PortMappingModifyResponse)(nil), "types.PortMappingModifyResponse")
+	proto.RegisterType((*PodSetBandwidthRequest)(nil), "types.PodSetBandwidthRequest")
+	proto.RegisterType((*PodSetBandwidthResponse)(nil), "types.PodSetBandwidthResponse")
+	proto.RegisterType((*Network)(nil), "types.Network")
+	proto.RegisterType((*NetworkEndpoint)(nil), "types.NetworkEndpoint")
+	proto.RegisterType((*NetworkInfo)(nil), "types.NetworkInfo")
+	proto.RegisterType((*NetworkCreateRequest)(nil), "types.NetworkCreateRequest")
+	proto.RegisterType((*NetworkCreateResponse)(nil), "types.NetworkCreateResponse")
+	proto.RegisterType((*NetworkListRequest)(nil), "types.NetworkListRequest")
+	proto.RegisterType((*NetworkListResponse)(nil), "types.NetworkListResponse")
+	proto.RegisterType((*NetworkInspectRequest)(nil), "types.NetworkInspectRequest")
+	proto.RegisterType((*NetworkInspectResponse)(nil), "types.NetworkInspectResponse")
+	proto.RegisterType((*NetworkRemoveRequest)(nil), "types.NetworkRemoveRequest")
+	proto.RegisterType((*NetworkRemoveResponse)(nil), "types.NetworkRemoveResponse")
 	proto.RegisterType((*PodStopRequest)(nil), "types.PodStopRequest")
 	proto.RegisterType((*PodStopResponse)(nil), "types.PodStopResponse")
 	proto.RegisterType((*PodSignalRequest)(nil), "types.PodSignalRequest")
@@ -4610,6 +6538,12 @@ func init() {
 	proto.RegisterType((*PodPauseResponse)(nil), "types.PodPauseResponse")
 	proto.RegisterType((*PodUnpauseRequest)(nil), "types.PodUnpauseRequest")
 	proto.RegisterType((*PodUnpauseResponse)(nil), "types.PodUnpauseResponse")
+	proto.RegisterType((*PodUpdateRequest)(nil), "types.PodUpdateRequest")
+	proto.RegisterType((*PodUpdateChange)(nil), "types.PodUpdateChange")
+	proto.RegisterType((*PodUpdateResponse)(nil), "types.PodUpdateResponse")
+	proto.RegisterType((*PodApplyRequest)(nil), "types.PodApplyRequest")
+	proto.RegisterType((*PodApplyResult)(nil), "types.PodApplyResult")
+	proto.RegisterType((*PodApplyResponse)(nil), "types.PodApplyResponse")
 	proto.RegisterType((*PodLabelsRequest)(nil), "types.PodLabelsRequest")
 	proto.RegisterType((*PodLabelsResponse)(nil), "types.PodLabelsResponse")
 	proto.RegisterType((*PodStatsRequest)(nil), "types.PodStatsRequest")
@@ -4651,6 +6585,10 @@ type PublicAPIClient interface {
 	PodPause(ctx context.Context, in *PodPauseRequest, opts ...grpc.CallOption) (*PodPauseResponse, error)
 	// PodUnpause unpauses a pod
 	PodUnpause(ctx context.Context, in *PodUnpauseRequest, opts ...grpc.CallOption) (*PodUnpauseResponse, error)
+	// PodUpdate applies a new spec to a running pod in its sandbox
+	PodUpdate(ctx context.Context, in *PodUpdateRequest, opts ...grpc.CallOption) (*PodUpdateResponse, error)
+	// PodApply creates, updates or removes the pods to match the specs
+	PodApply(ctx context.Context, in *PodApplyRequest, opts ...grpc.CallOption) (*PodApplyResponse, error)
 	// ExecVM executes a command outside of any containers.
 	ExecVM(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecVMClient, error)
 	// ContainerList gets a list of containers
@@ -4689,6 +6627,8 @@ type PublicAPIClient interface {
 	ExecSignal(ctx context.Context, in *ExecSignalRequest, opts ...grpc.CallOption) (*ExecSignalResponse, error)
 	// Attach attaches to the specified container
 	Attach(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_AttachClient, error)
+	// PortForward tunnels a TCP connection to a port of the specified pod
+	PortForward(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_PortForwardClient, error)
 	// Wait gets the exit code of the specified container
 	Wait(ctx context.Context, in *WaitRequest, opts ...grpc.CallOption) (*WaitResponse, error)
 	// TTYResize resizes the tty of the specified container
@@ -4707,18 +6647,36 @@ type PublicAPIClient interface {
 	PortMappingAdd(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error)
 	// PortMappingDel remove a list of PortMapping rules from a Pod
 	PortMappingDel(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error)
+	// PodSetBandwidth changes the rate limits of an interface of a Pod
+	PodSetBandwidth(ctx context.Context, in *PodSetBandwidthRequest, opts ...grpc.CallOption) (*PodSetBandwidthResponse, error)
+	// NetworkCreate creates a named network
+	NetworkCreate(ctx context.Context, in *NetworkCreateRequest, opts ...grpc.CallOption) (*NetworkCreateResponse, error)
+	// NetworkList gets a list of the named networks
+	NetworkList(ctx context.Context, in *NetworkListRequest, opts ...grpc.CallOption) (*NetworkListResponse, error)
+	// NetworkInspect gets a named network and its endpoints
+	NetworkInspect(ctx context.Context, in *NetworkInspectRequest, opts ...grpc.CallOption) (*NetworkInspectResponse, error)
+	// NetworkRemove removes a named network without endpoints
+	NetworkRemove(ctx context.Context, in *NetworkRemoveRequest, opts ...grpc.CallOption) (*NetworkRemoveResponse, error)
 	// ImagePull pulls a image from registry
 	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (PublicAPI_ImagePullClient, error)
 	// ImagePush pushes a local image to registry
 	ImagePush(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (PublicAPI_ImagePushClient, error)
 	// ImageRemove deletes a image from hyperd
 	ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error)
+	// ImageGC removes unused images to reclaim disk space
+	ImageGC(ctx context.Context, in *ImageGCRequest, opts ...grpc.CallOption) (*ImageGCResponse, error)
 	// Ping checks if hyperd is running (returns 'OK' on success)
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	// Info gets the info of hyperd
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
 	// Version gets the version and apiVersion of hyperd
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// VMFactoryStatus gets the status of the VM factory of the runtime
+	VMFactoryStatus(ctx context.Context, in *VMFactoryStatusRequest, opts ...grpc.CallOption) (*VMFactoryStatusResponse, error)
+	// VMFactoryUpdate adds, resizes, drops or warms up a VM factory profile of the runtime
+	VMFactoryUpdate(ctx context.Context, in *VMFactoryUpdateRequest, opts ...grpc.CallOption) (*VMFactoryUpdateResponse, error)
+	// ReloadConfig reloads the config file of hyperd
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
 }
 
 type publicAPIClient struct {
@@ -4810,6 +6768,24 @@ func (c *publicAPIClient) PodUnpause(ctx context.Context, in *PodUnpauseRequest,
 	return out, nil
 }
 
+func (c *publicAPIClient) PodUpdate(ctx context.Context, in *PodUpdateRequest, opts ...grpc.CallOption) (*PodUpdateResponse, error) {
+	out := new(PodUpdateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodUpdate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodApply(ctx context.Context, in *PodApplyRequest, opts ...grpc.CallOption) (*PodApplyResponse, error) {
+	out := new(PodApplyResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodApply", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicAPIClient) ExecVM(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecVMClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[0], c.cc, "/types.PublicAPI/ExecVM", opts...)
 	if err != nil {
@@ -5027,34 +7003,65 @@ func (c *publicAPIClient) ExecSignal(ctx context.Context, in *ExecSignalRequest,
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *publicAPIClient) Attach(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_AttachClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[3], c.cc, "/types.PublicAPI/Attach", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIAttachClient{stream}
+	return x, nil
+}
+
+type PublicAPI_AttachClient interface {
+	Send(*AttachMessage) error
+	Recv() (*AttachMessage, error)
+	grpc.ClientStream
+}
+
+type publicAPIAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIAttachClient) Send(m *AttachMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publicAPIAttachClient) Recv() (*AttachMessage, error) {
+	m := new(AttachMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (c *publicAPIClient) Attach(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_AttachClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[3], c.cc, "/types.PublicAPI/Attach", opts...)
+func (c *publicAPIClient) PortForward(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_PortForwardClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[4], c.cc, "/types.PublicAPI/PortForward", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &publicAPIAttachClient{stream}
+	x := &publicAPIPortForwardClient{stream}
 	return x, nil
 }
 
-type PublicAPI_AttachClient interface {
-	Send(*AttachMessage) error
-	Recv() (*AttachMessage, error)
+type PublicAPI_PortForwardClient interface {
+	Send(*PortForwardMessage) error
+	Recv() (*PortForwardMessage, error)
 	grpc.ClientStream
 }
 
-type publicAPIAttachClient struct {
+type publicAPIPortForwardClient struct {
 	grpc.ClientStream
 }
 
-func (x *publicAPIAttachClient) Send(m *AttachMessage) error {
+func (x *publicAPIPortForwardClient) Send(m *PortForwardMessage) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *publicAPIAttachClient) Recv() (*AttachMessage, error) {
-	m := new(AttachMessage)
+func (x *publicAPIPortForwardClient) Recv() (*PortForwardMessage, error) {
+	m := new(PortForwardMessage)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -5142,8 +7149,53 @@ func (c *publicAPIClient) PortMappingDel(ctx context.Context, in *PortMappingMod
 	return out, nil
 }
 
+func (c *publicAPIClient) PodSetBandwidth(ctx context.Context, in *PodSetBandwidthRequest, opts ...grpc.CallOption) (*PodSetBandwidthResponse, error) {
+	out := new(PodSetBandwidthResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodSetBandwidth", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) NetworkCreate(ctx context.Context, in *NetworkCreateRequest, opts ...grpc.CallOption) (*NetworkCreateResponse, error) {
+	out := new(NetworkCreateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/NetworkCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) NetworkList(ctx context.Context, in *NetworkListRequest, opts ...grpc.CallOption) (*NetworkListResponse, error) {
+	out := new(NetworkListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/NetworkList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) NetworkInspect(ctx context.Context, in *NetworkInspectRequest, opts ...grpc.CallOption) (*NetworkInspectResponse, error) {
+	out := new(NetworkInspectResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/NetworkInspect", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) NetworkRemove(ctx context.Context, in *NetworkRemoveRequest, opts ...grpc.CallOption) (*NetworkRemoveResponse, error) {
+	out := new(NetworkRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/NetworkRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicAPIClient) ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (PublicAPI_ImagePullClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[4], c.cc, "/types.PublicAPI/ImagePull", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[5], c.cc, "/types.PublicAPI/ImagePull", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5175,7 +7227,7 @@ func (x *publicAPIImagePullClient) Recv() (*ImagePullResponse, error) {
 }
 
 func (c *publicAPIClient) ImagePush(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (PublicAPI_ImagePushClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[5], c.cc, "/types.PublicAPI/ImagePush", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[6], c.cc, "/types.PublicAPI/ImagePush", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5215,6 +7267,15 @@ func (c *publicAPIClient) ImageRemove(ctx context.Context, in *ImageRemoveReques
 	return out, nil
 }
 
+func (c *publicAPIClient) ImageGC(ctx context.Context, in *ImageGCRequest, opts ...grpc.CallOption) (*ImageGCResponse, error) {
+	out := new(ImageGCResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageGC", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicAPIClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
 	out := new(PingResponse)
 	err := grpc.Invoke(ctx, "/types.PublicAPI/Ping", in, out, c.cc, opts...)
@@ -5242,6 +7303,33 @@ func (c *publicAPIClient) Version(ctx context.Context, in *VersionRequest, opts
 	return out, nil
 }
 
+func (c *publicAPIClient) VMFactoryStatus(ctx context.Context, in *VMFactoryStatusRequest, opts ...grpc.CallOption) (*VMFactoryStatusResponse, error) {
+	out := new(VMFactoryStatusResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VMFactoryStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VMFactoryUpdate(ctx context.Context, in *VMFactoryUpdateRequest, opts ...grpc.CallOption) (*VMFactoryUpdateResponse, error) {
+	out := new(VMFactoryUpdateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VMFactoryUpdate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ReloadConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PublicAPI service
 
 type PublicAPIServer interface {
@@ -5263,6 +7351,10 @@ type PublicAPIServer interface {
 	PodPause(context.Context, *PodPauseRequest) (*PodPauseResponse, error)
 	// PodUnpause unpauses a pod
 	PodUnpause(context.Context, *PodUnpauseRequest) (*PodUnpauseResponse, error)
+	// PodUpdate applies a new spec to a running pod in its sandbox
+	PodUpdate(context.Context, *PodUpdateRequest) (*PodUpdateResponse, error)
+	// PodApply creates, updates or removes the pods to match the specs
+	PodApply(context.Context, *PodApplyRequest) (*PodApplyResponse, error)
 	// ExecVM executes a command outside of any containers.
 	ExecVM(PublicAPI_ExecVMServer) error
 	// ContainerList gets a list of containers
@@ -5301,6 +7393,8 @@ type PublicAPIServer interface {
 	ExecSignal(context.Context, *ExecSignalRequest) (*ExecSignalResponse, error)
 	// Attach attaches to the specified container
 	Attach(PublicAPI_AttachServer) error
+	// PortForward tunnels a TCP connection to a port of the specified pod
+	PortForward(PublicAPI_PortForwardServer) error
 	// Wait gets the exit code of the specified container
 	Wait(context.Context, *WaitRequest) (*WaitResponse, error)
 	// TTYResize resizes the tty of the specified container
@@ -5319,18 +7413,36 @@ type PublicAPIServer interface {
 	PortMappingAdd(context.Context, *PortMappingModifyRequest) (*PortMappingModifyResponse, error)
 	// PortMappingDel remove a list of PortMapping rules from a Pod
 	PortMappingDel(context.Context, *PortMappingModifyRequest) (*PortMappingModifyResponse, error)
+	// PodSetBandwidth changes the rate limits of an interface of a Pod
+	PodSetBandwidth(context.Context, *PodSetBandwidthRequest) (*PodSetBandwidthResponse, error)
+	// NetworkCreate creates a named network
+	NetworkCreate(context.Context, *NetworkCreateRequest) (*NetworkCreateResponse, error)
+	// NetworkList gets a list of the named networks
+	NetworkList(context.Context, *NetworkListRequest) (*NetworkListResponse, error)
+	// NetworkInspect gets a named network and its endpoints
+	NetworkInspect(context.Context, *NetworkInspectRequest) (*NetworkInspectResponse, error)
+	// NetworkRemove removes a named network without endpoints
+	NetworkRemove(context.Context, *NetworkRemoveRequest) (*NetworkRemoveResponse, error)
 	// ImagePull pulls a image from registry
 	ImagePull(*ImagePullRequest, PublicAPI_ImagePullServer) error
 	// ImagePush pushes a local image to registry
 	ImagePush(*ImagePushRequest, PublicAPI_ImagePushServer) error
 	// ImageRemove deletes a image from hyperd
 	ImageRemove(context.Context, *ImageRemoveRequest) (*ImageRemoveResponse, error)
+	// ImageGC removes unused images to reclaim disk space
+	ImageGC(context.Context, *ImageGCRequest) (*ImageGCResponse, error)
 	// Ping checks if hyperd is running (returns 'OK' on success)
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	// Info gets the info of hyperd
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
 	// Version gets the version and apiVersion of hyperd
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// VMFactoryStatus gets the status of the VM factory of the runtime
+	VMFactoryStatus(context.Context, *VMFactoryStatusRequest) (*VMFactoryStatusResponse, error)
+	// VMFactoryUpdate adds, resizes, drops or warms up a VM factory profile of the runtime
+	VMFactoryUpdate(context.Context, *VMFactoryUpdateRequest) (*VMFactoryUpdateResponse, error)
+	// ReloadConfig reloads the config file of hyperd
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
 }
 
 func RegisterPublicAPIServer(s *grpc.Server, srv PublicAPIServer) {
@@ -5499,6 +7611,42 @@ func _PublicAPI_PodUnpause_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_PodUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodUpdate(ctx, req.(*PodUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_PodApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodApply(ctx, req.(*PodApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicAPI_ExecVM_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(PublicAPIServer).ExecVM(&publicAPIExecVMServer{stream})
 }
@@ -5850,6 +7998,32 @@ func (x *publicAPIAttachServer) Recv() (*AttachMessage, error) {
 	return m, nil
 }
 
+func _PublicAPI_PortForward_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublicAPIServer).PortForward(&publicAPIPortForwardServer{stream})
+}
+
+type PublicAPI_PortForwardServer interface {
+	Send(*PortForwardMessage) error
+	Recv() (*PortForwardMessage, error)
+	grpc.ServerStream
+}
+
+type publicAPIPortForwardServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIPortForwardServer) Send(m *PortForwardMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publicAPIPortForwardServer) Recv() (*PortForwardMessage, error) {
+	m := new(PortForwardMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _PublicAPI_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WaitRequest)
 	if err := dec(in); err != nil {
@@ -6012,6 +8186,96 @@ func _PublicAPI_PortMappingDel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_PodSetBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodSetBandwidthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodSetBandwidth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodSetBandwidth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodSetBandwidth(ctx, req.(*PodSetBandwidthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_NetworkCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).NetworkCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/NetworkCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).NetworkCreate(ctx, req.(*NetworkCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_NetworkList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).NetworkList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/NetworkList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).NetworkList(ctx, req.(*NetworkListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_NetworkInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkInspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).NetworkInspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/NetworkInspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).NetworkInspect(ctx, req.(*NetworkInspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_NetworkRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).NetworkRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/NetworkRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).NetworkRemove(ctx, req.(*NetworkRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicAPI_ImagePull_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ImagePullRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -6072,6 +8336,24 @@ func _PublicAPI_ImageRemove_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_ImageGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ImageGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ImageGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ImageGC(ctx, req.(*ImageGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicAPI_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PingRequest)
 	if err := dec(in); err != nil {
@@ -6126,6 +8408,60 @@ func _PublicAPI_Version_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_VMFactoryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMFactoryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).VMFactoryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/VMFactoryStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).VMFactoryStatus(ctx, req.(*VMFactoryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_VMFactoryUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMFactoryUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).VMFactoryUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/VMFactoryUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).VMFactoryUpdate(ctx, req.(*VMFactoryUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.PublicAPI",
 	HandlerType: (*PublicAPIServer)(nil),
@@ -6166,6 +8502,14 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PodUnpause",
 			Handler:    _PublicAPI_PodUnpause_Handler,
 		},
+		{
+			MethodName: "PodUpdate",
+			Handler:    _PublicAPI_PodUpdate_Handler,
+		},
+		{
+			MethodName: "PodApply",
+			Handler:    _PublicAPI_PodApply_Handler,
+		},
 		{
 			MethodName: "ContainerList",
 			Handler:    _PublicAPI_ContainerList_Handler,
@@ -6258,10 +8602,34 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PortMappingDel",
 			Handler:    _PublicAPI_PortMappingDel_Handler,
 		},
+		{
+			MethodName: "PodSetBandwidth",
+			Handler:    _PublicAPI_PodSetBandwidth_Handler,
+		},
+		{
+			MethodName: "NetworkCreate",
+			Handler:    _PublicAPI_NetworkCreate_Handler,
+		},
+		{
+			MethodName: "NetworkList",
+			Handler:    _PublicAPI_NetworkList_Handler,
+		},
+		{
+			MethodName: "NetworkInspect",
+			Handler:    _PublicAPI_NetworkInspect_Handler,
+		},
+		{
+			MethodName: "NetworkRemove",
+			Handler:    _PublicAPI_NetworkRemove_Handler,
+		},
 		{
 			MethodName: "ImageRemove",
 			Handler:    _PublicAPI_ImageRemove_Handler,
 		},
+		{
+			MethodName: "ImageGC",
+			Handler:    _PublicAPI_ImageGC_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _PublicAPI_Ping_Handler,
@@ -6274,6 +8642,18 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _PublicAPI_Version_Handler,
 		},
+		{
+			MethodName: "VMFactoryStatus",
+			Handler:    _PublicAPI_VMFactoryStatus_Handler,
+		},
+		{
+			MethodName: "VMFactoryUpdate",
+			Handler:    _PublicAPI_VMFactoryUpdate_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _PublicAPI_ReloadConfig_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -6299,6 +8679,12 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PortForward",
+			Handler:       _PublicAPI_PortForward_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "ImagePull",
 			Handler:       _PublicAPI_ImagePull_Handler,
@@ -6316,341 +8702,471 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("types.proto", fileDescriptorTypes) }
 
 var fileDescriptorTypes = []byte{
-	// 5368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
-	0x76, 0x9a, 0x2f, 0x0e, 0xe7, 0xf1, 0x53, 0x25, 0x8a, 0x1a, 0xd1, 0x5a, 0x5b, 0xdb, 0x1b, 0x5b,
-	0xb2, 0x1c, 0xd3, 0xb6, 0xd6, 0xb1, 0xbd, 0x72, 0x8c, 0x35, 0x4d, 0xca, 0x6b, 0x22, 0xa2, 0x4d,
-	0x37, 0x25, 0x19, 0x46, 0x16, 0xd8, 0xb4, 0xa6, 0x8b, 0xc3, 0x36, 0x7b, 0xba, 0x27, 0xdd, 0x3d,
-	0x94, 0xe8, 0x5b, 0x72, 0x5a, 0xc0, 0x87, 0x1c, 0x16, 0x08, 0x92, 0x00, 0xb9, 0x24, 0x97, 0x20,
-	0x97, 0x1c, 0x72, 0xca, 0x22, 0x97, 0x5c, 0x72, 0xca, 0x1f, 0x49, 0xf6, 0x92, 0x5b, 0x6e, 0x41,
-	0xf0, 0x5e, 0xbd, 0xaa, 0xae, 0xea, 0x69, 0x0e, 0x29, 0x5b, 0x39, 0x10, 0xea, 0xf7, 0xea, 0x55,
-	0xd5, 0xab, 0x57, 0xaf, 0xde, 0x57, 0xd5, 0x08, 0x16, 0x8a, 0xd3, 0xb1, 0xcc, 0x37, 0xc7, 0x59,
-	0x5a, 0xa4, 0xa2, 0x43, 0x80, 0xf7, 0xd7, 0x0d, 0x58, 0xda, 0x4e, 0x93, 0x22, 0x88, 0x12, 0x99,
-	0xed, 0xa7, 0x59, 0x21, 0x04, 0xb4, 0x93, 0x60, 0x24, 0xfb, 0x8d, 0x9b, 0x8d, 0xdb, 0x3d, 0x9f,
-	0xbe, 0xc5, 0x06, 0xcc, 0x1f, 0xa5, 0x79, 0x81, 0xed, 0xfd, 0xe6, 0xcd, 0xc6, 0xed, 0x8e, 0x6f,
-	0x60, 0xf1, 0x7b, 0xb0, 0x34, 0xb0, 0x07, 0xe8, 0xb7, 0x88, 0xc0, 0x45, 0xe2, 0x08, 0x34, 0xef,
-	0x20, 0x8d, 0xfb, 0x6d, 0x1a, 0xd9, 0xc0, 0x62, 0x1d, 0xe6, 0x70, 0xb4, 0xdd, 0xfd, 0x7e, 0x87,
-	0x5a, 0x18, 0xf2, 0x3e, 0x80, 0xe5, 0xfb, 0xc9, 0x49, 0x94, 0xa5, 0xc9, 0x48, 0x26, 0xc5, 0xe3,
-	0x20, 0x13, 0xab, 0xd0, 0x92, 0xc9, 0x09, 0xb3, 0x86, 0x9f, 0x62, 0x0d, 0x3a, 0x27, 0x41, 0x3c,
-	0x91, 0xc4, 0x56, 0xcf, 0x57, 0x80, 0xf7, 0xc7, 0xb0, 0xf0, 0x38, 0x8d, 0x27, 0x23, 0xb9, 0x97,
-	0x4e, 0x92, 0xfa, 0x25, 0xdd, 0x80, 0xde, 0x08, 0x1b, 0xf7, 0x83, 0xe2, 0x88, 0x3b, 0x97, 0x08,
-	0x64, 0x37, 0x93, 0x41, 0xf8, 0x45, 0x12, 0x9f, 0xd2, 0x7a, 0xe6, 0x7d, 0x03, 0x7b, 0xb7, 0x60,
-	0xe9, 0xab, 0x20, 0x2a, 0xa2, 0x64, 0x78, 0x50, 0x04, 0xc5, 0x24, 0x47, 0xfe, 0x33, 0x19, 0xe4,
-	0x69, 0xc2, 0x13, 0x30, 0xe4, 0xbd, 0x09, 0x4b, 0xfe, 0x24, 0x49, 0x4a, 0xc2, 0x1b, 0xd0, 0xcb,
-	0x8b, 0x20, 0x2b, 0x64, 0xb8, 0x55, 0x30, 0x6d, 0x89, 0xf0, 0xfe, 0xaa, 0x01, 0xf0, 0x50, 0x66,
-	0x23, 0x26, 0xde, 0x80, 0x79, 0xf9, 0x2c, 0x2a, 0xb6, 0xd3, 0x50, 0x31, 0xde, 0xf1, 0x0d, 0x6c,
-	0xcd, 0xd8, 0xb4, 0x67, 0x14, 0x7d, 0xe8, 0x8e, 0x64, 0x9e, 0x07, 0x43, 0x49, 0x5c, 0xf7, 0x7c,
-	0x0d, 0xba, 0x53, 0xb7, 0x2b, 0x53, 0x8b, 0x97, 0x01, 0x0e, 0xa3, 0x24, 0xca, 0x8f, 0xa8, 0x59,
-	0xed, 0x82, 0x85, 0xf1, 0xfe, 0xbb, 0x01, 0x2b, 0x46, 0x4b, 0x98, 0xbf, 0x3a, 0xa1, 0xde, 0x84,
-	0x05, 0xb3, 0xed, 0xbb, 0x3b, 0xcc, 0x9c, 0x8d, 0xc2, 0xfd, 0x1a, 0x1f, 0x05, 0xb9, 0xe6, 0x4f,
-	0x01, 0x62, 0x13, 0xba, 0x4f, 0x95, 0x48, 0x89, 0xb7, 0x85, 0xbb, 0x6b, 0x9b, 0x4a, 0x57, 0x1d,
-	0x41, 0xfb, 0x9a, 0x08, 0xe9, 0x33, 0x25, 0x59, 0x62, 0xb6, 0xa4, 0x77, 0xe4, 0xed, 0x6b, 0x22,
-	0xf1, 0x0e, 0x40, 0x21, 0xb3, 0x51, 0x94, 0x04, 0x85, 0x0c, 0xfb, 0x73, 0xd4, 0xe5, 0x32, 0x77,
-	0x29, 0x45, 0xee, 0x5b, 0x44, 0xde, 0xdf, 0xdb, 0x07, 0x63, 0x37, 0x39, 0x4c, 0xc5, 0x26, 0xf4,
-	0xcc, 0x4a, 0x68, 0xd5, 0x0b, 0x77, 0x57, 0x79, 0x0c, 0x43, 0xe8, 0x97, 0x24, 0x28, 0xf2, 0x41,
-	0x26, 0x03, 0x25, 0x72, 0x14, 0x45, 0xcb, 0x2f, 0x11, 0x24, 0x88, 0x34, 0xdc, 0xdd, 0x31, 0x82,
-	0x40, 0x40, 0x6c, 0xc2, 0x5c, 0x4e, 0xbc, 0xb0, 0x1c, 0xd6, 0xab, 0x13, 0x30, 0xa7, 0x4c, 0xe5,
-	0xfd, 0x45, 0x1b, 0x7a, 0xa6, 0xed, 0xfb, 0x6f, 0x49, 0x34, 0x2a, 0x55, 0x46, 0x01, 0xa8, 0x4a,
-	0xf4, 0xb1, 0xbb, 0xc3, 0xea, 0xa2, 0x41, 0x71, 0x1b, 0x56, 0xe8, 0x73, 0x7f, 0x12, 0xc7, 0xfb,
-	0x69, 0x1c, 0x0d, 0x4e, 0x59, 0x63, 0xaa, 0x68, 0x54, 0xab, 0xa7, 0x69, 0x76, 0x1c, 0x25, 0xc3,
-	0x9d, 0x28, 0x23, 0xb1, 0xf7, 0x7c, 0x0b, 0x83, 0xfc, 0x4e, 0x72, 0x99, 0xf5, 0xbb, 0x8a, 0x5f,
-	0xfc, 0xc6, 0x23, 0x5e, 0x14, 0xa7, 0xfd, 0x79, 0x3a, 0x74, 0xf8, 0x89, 0x07, 0x61, 0x90, 0x8e,
-	0x46, 0x41, 0x12, 0xe6, 0xfd, 0xde, 0xcd, 0x16, 0x9a, 0x0e, 0x0d, 0xe3, 0x08, 0x41, 0x36, 0xcc,
-	0xfb, 0x40, 0x78, 0xfa, 0x16, 0x77, 0x50, 0xb2, 0x59, 0x91, 0xf7, 0x17, 0x6e, 0xb6, 0x2c, 0xd5,
-	0x70, 0xac, 0x9c, 0xaf, 0x48, 0xc4, 0x2d, 0x65, 0x50, 0x16, 0x89, 0xf2, 0x2a, 0x53, 0xba, 0x46,
-	0x47, 0xd9, 0x99, 0xf7, 0x60, 0xf1, 0xa4, 0xb4, 0x28, 0x79, 0x7f, 0x89, 0x7a, 0x08, 0xee, 0x61,
-	0x19, 0x1b, 0xdf, 0xa1, 0x13, 0xef, 0xc2, 0x5c, 0x1c, 0x3c, 0x91, 0x71, 0xde, 0x5f, 0xa6, 0x1e,
-	0x37, 0xaa, 0xdc, 0x6c, 0x3e, 0xa0, 0xe6, 0xfb, 0x49, 0x91, 0x9d, 0xfa, 0x4c, 0xbb, 0xf1, 0x33,
-	0x58, 0xb0, 0xd0, 0x28, 0x93, 0x63, 0x79, 0xaa, 0xcd, 0xde, 0xb1, 0x3c, 0xad, 0x37, 0x7b, 0xf7,
-	0x9a, 0x1f, 0x34, 0xbc, 0x7f, 0x69, 0xc0, 0x8a, 0xff, 0xc9, 0x8e, 0xe2, 0xe8, 0x20, 0x9d, 0x64,
-	0x03, 0x32, 0xdf, 0xa3, 0x34, 0x89, 0x8a, 0x34, 0xcb, 0xfb, 0x0d, 0x25, 0x41, 0x0d, 0x97, 0xbb,
-	0xdf, 0xb4, 0x77, 0x7f, 0x1d, 0xe6, 0x0e, 0xf3, 0x87, 0xa7, 0x63, 0xad, 0x14, 0x0c, 0xa1, 0xbc,
-	0xc7, 0xa9, 0x31, 0xe1, 0xf4, 0x6d, 0x76, 0xb1, 0x63, 0xed, 0x62, 0x1f, 0xba, 0xc7, 0xf2, 0x34,
-	0xc3, 0x03, 0xaa, 0xb6, 0x5d, 0x83, 0x8e, 0x65, 0xed, 0x56, 0x2c, 0xeb, 0x29, 0xf4, 0xf6, 0xd3,
-	0x50, 0xb1, 0x5e, 0xab, 0xcc, 0xeb, 0x30, 0x97, 0xd3, 0x92, 0xb4, 0xdd, 0x53, 0x10, 0xe2, 0xc3,
-	0x2c, 0x3a, 0x91, 0x99, 0x66, 0x57, 0x41, 0xe2, 0x36, 0xb4, 0xb2, 0x27, 0x61, 0xe5, 0x2c, 0x55,
-	0xa4, 0xe3, 0x23, 0x89, 0xf7, 0xe7, 0x4d, 0xe8, 0xee, 0xa7, 0xe1, 0xc1, 0x58, 0x0e, 0xc4, 0x1d,
-	0xe8, 0xaa, 0x3d, 0x54, 0xd2, 0x2a, 0x8f, 0xb9, 0x61, 0xce, 0xd7, 0x04, 0xe2, 0x6d, 0x00, 0x73,
-	0x96, 0xf2, 0x7e, 0xd3, 0x21, 0x2f, 0xad, 0x82, 0x45, 0x23, 0xee, 0x1a, 0x8d, 0x68, 0x11, 0xf5,
-	0x46, 0x39, 0x38, 0xce, 0x5e, 0xa7, 0x0f, 0x28, 0x8b, 0x93, 0xc1, 0x78, 0x42, 0x0b, 0xe9, 0xf8,
-	0xf4, 0x8d, 0x6b, 0x1e, 0xc9, 0x51, 0x9a, 0xa9, 0xd3, 0xd7, 0xf1, 0x19, 0xfa, 0x21, 0xba, 0xf3,
-	0x67, 0x4d, 0xda, 0x00, 0x36, 0xf0, 0xc6, 0x54, 0x37, 0x6c, 0x53, 0x6d, 0xb9, 0x98, 0xa6, 0xeb,
-	0x62, 0x4a, 0xa7, 0xd4, 0x72, 0x9c, 0x52, 0xe9, 0xde, 0xdb, 0xb6, 0x7b, 0xd7, 0x16, 0x10, 0xbd,
-	0x7e, 0x4b, 0x5b, 0xc0, 0x7d, 0xe3, 0xa8, 0x1e, 0x46, 0x23, 0xc9, 0xba, 0x53, 0x22, 0xc4, 0xc7,
-	0xb0, 0x32, 0x70, 0x4d, 0x61, 0xbf, 0x4b, 0x52, 0x3c, 0xcb, 0x50, 0x56, 0xc9, 0x4b, 0x57, 0x47,
-	0x13, 0xcc, 0xdb, 0xae, 0x0e, 0x31, 0xde, 0x7f, 0x36, 0x48, 0x11, 0xc8, 0xe2, 0x1b, 0x1b, 0xdd,
-	0xb0, 0x6d, 0xb4, 0x80, 0xf6, 0x71, 0x94, 0x84, 0xbc, 0x7c, 0xfa, 0xc6, 0x51, 0x83, 0x71, 0xf4,
-	0x58, 0x66, 0x79, 0x64, 0xd6, 0x6f, 0x61, 0xc4, 0x32, 0x34, 0x4f, 0x46, 0xbc, 0xfe, 0xe6, 0xc9,
-	0xc8, 0xf5, 0x0d, 0x9d, 0xaa, 0x6f, 0xf0, 0xa0, 0x9d, 0x8f, 0xe5, 0x80, 0x1d, 0xd5, 0xb2, 0xab,
-	0x20, 0x3e, 0xb5, 0x89, 0xdb, 0xc6, 0x53, 0x74, 0x1d, 0x57, 0x64, 0xf6, 0x4f, 0xfb, 0x08, 0xdc,
-	0xb1, 0x71, 0x1a, 0x7e, 0x1e, 0x98, 0xe5, 0x6a, 0xd0, 0xfb, 0xbb, 0x26, 0xf4, 0x76, 0xc9, 0xaa,
-	0xe3, 0x6a, 0x97, 0xa1, 0x19, 0x85, 0xbc, 0xd4, 0x66, 0x14, 0x52, 0xc8, 0x16, 0x64, 0x32, 0x29,
-	0x8c, 0xdb, 0x30, 0xb0, 0x3a, 0xc5, 0xe3, 0xf4, 0x61, 0x30, 0x54, 0x6a, 0xdc, 0xf3, 0x0d, 0x8c,
-	0x1e, 0x07, 0xbf, 0x77, 0xa2, 0xa1, 0xcc, 0x0b, 0x74, 0x64, 0xd8, 0x6c, 0xa3, 0x90, 0x23, 0x5e,
-	0x2c, 0xaf, 0x5d, 0x83, 0xd8, 0xf7, 0x24, 0xca, 0x8a, 0x49, 0x10, 0x1f, 0x44, 0xdf, 0xaa, 0xfd,
-	0x6f, 0xf9, 0x36, 0xca, 0x32, 0xa8, 0x5d, 0xc7, 0xa0, 0x9a, 0x75, 0xbc, 0x68, 0x83, 0xfa, 0x6f,
-	0x4d, 0x98, 0x67, 0xa1, 0xe6, 0xe2, 0xc7, 0xd0, 0xc2, 0x73, 0xa8, 0xbc, 0xff, 0x8a, 0xd6, 0xb9,
-	0xf1, 0x84, 0x5a, 0x7d, 0x6c, 0x13, 0xb7, 0xa0, 0xf3, 0x24, 0x4e, 0x07, 0xc7, 0x34, 0x52, 0x19,
-	0x66, 0x7c, 0x12, 0x1f, 0x47, 0xa9, 0x22, 0x53, 0xed, 0xe2, 0x8e, 0x39, 0xc0, 0x2d, 0xa2, 0xd4,
-	0xce, 0x64, 0x8f, 0x90, 0x8a, 0x94, 0x29, 0xc4, 0x9b, 0xd0, 0x4d, 0x64, 0x81, 0xae, 0x93, 0x8d,
-	0xd9, 0x15, 0x26, 0xfe, 0x5c, 0x61, 0x15, 0xb5, 0xa6, 0x11, 0x9b, 0xa8, 0xe4, 0xb1, 0xcc, 0x4f,
-	0xf3, 0x42, 0x8e, 0xe8, 0x7c, 0x95, 0x6a, 0xf4, 0x69, 0xae, 0x88, 0x2d, 0x0a, 0x54, 0xc7, 0x22,
-	0x1a, 0xc9, 0xbc, 0x08, 0x46, 0x63, 0x16, 0x7a, 0x89, 0x70, 0x0e, 0x9d, 0xea, 0x7c, 0xd6, 0xa1,
-	0xe3, 0xa1, 0xab, 0xe4, 0xde, 0x01, 0xcc, 0x6b, 0x21, 0x89, 0x57, 0xa1, 0x33, 0x21, 0xf3, 0x31,
-	0x25, 0xc4, 0x47, 0x88, 0xf6, 0x55, 0x2b, 0x6a, 0xc2, 0x83, 0x34, 0x08, 0xb7, 0x4e, 0x64, 0xa6,
-	0x6d, 0x4d, 0xc7, 0xb7, 0x51, 0x5e, 0x48, 0x83, 0x52, 0x27, 0xdc, 0xbe, 0x22, 0x2d, 0x82, 0x98,
-	0x06, 0x6d, 0xfb, 0x0a, 0x40, 0xcb, 0x33, 0x96, 0xd9, 0xf6, 0x78, 0x42, 0x86, 0xb9, 0xed, 0x33,
-	0x64, 0x3c, 0x56, 0x8b, 0x88, 0x95, 0xc7, 0x42, 0xd7, 0xa2, 0xc4, 0xd5, 0x26, 0x2c, 0x43, 0xde,
-	0x7f, 0xb4, 0x01, 0xca, 0xbd, 0x13, 0x5f, 0xc0, 0xb5, 0x28, 0x3d, 0x90, 0xd9, 0x49, 0x34, 0x90,
-	0x9f, 0x9c, 0x16, 0x32, 0xf7, 0xe5, 0x60, 0x92, 0xe5, 0xd1, 0x89, 0x64, 0x5f, 0x71, 0xb5, 0xba,
-	0xdf, 0x4a, 0x11, 0xcf, 0xea, 0x25, 0x7e, 0x01, 0x57, 0x4c, 0x53, 0x58, 0x0e, 0xd6, 0x9c, 0x35,
-	0x58, 0x5d, 0x0f, 0xb1, 0x0d, 0x97, 0xa3, 0xf4, 0xcb, 0x89, 0x9c, 0xd8, 0xc3, 0xb4, 0x66, 0x0d,
-	0x33, 0x4d, 0x2f, 0xf6, 0x60, 0xdd, 0x8c, 0x8d, 0xe6, 0xb0, 0x1c, 0xa9, 0x3d, 0x6b, 0xa4, 0x33,
-	0x3a, 0xa9, 0xc5, 0x61, 0x0c, 0xef, 0x8e, 0xd5, 0x39, 0x67, 0x71, 0x53, 0x3d, 0xd4, 0xe2, 0xf6,
-	0x64, 0x36, 0xb4, 0x17, 0x37, 0x77, 0xce, 0xe2, 0x2a, 0xf4, 0xe2, 0xe7, 0xb0, 0x12, 0xa5, 0x2e,
-	0x27, 0xdd, 0x59, 0x43, 0x54, 0xa9, 0xc5, 0x16, 0xac, 0xe6, 0x72, 0x80, 0x61, 0x53, 0x39, 0xc2,
-	0xfc, 0xac, 0x11, 0xa6, 0xc8, 0xbd, 0xff, 0x6a, 0xc0, 0xb2, 0x4b, 0x54, 0x1b, 0xe8, 0x08, 0x68,
-	0xe3, 0x80, 0xda, 0xc7, 0xe0, 0xb7, 0x15, 0xfc, 0xb4, 0x9c, 0xe0, 0x67, 0x0d, 0x3a, 0xa3, 0xe0,
-	0x9b, 0x34, 0x63, 0xc5, 0x55, 0x00, 0x61, 0xa3, 0x24, 0x55, 0x61, 0x19, 0x62, 0x11, 0x10, 0x3f,
-	0x85, 0x36, 0x7a, 0x05, 0x16, 0xdd, 0x2b, 0xb5, 0x5c, 0x6f, 0x96, 0xfc, 0x13, 0xf1, 0xc6, 0xfb,
-	0xd0, 0x2b, 0xb9, 0x3d, 0xc7, 0x74, 0xb6, 0x6d, 0xd3, 0xf9, 0xbb, 0x06, 0x2c, 0x58, 0xd6, 0x0c,
-	0x29, 0xcb, 0xa3, 0xdf, 0xd6, 0x27, 0xbd, 0xcc, 0x12, 0x0e, 0x64, 0xc1, 0x83, 0x58, 0x18, 0xf4,
-	0x16, 0x87, 0x41, 0x14, 0x0f, 0x92, 0x82, 0x0f, 0xac, 0x06, 0xc5, 0x27, 0x56, 0xe9, 0x61, 0x27,
-	0x28, 0x02, 0xb6, 0x8d, 0x37, 0xa6, 0x0d, 0xa9, 0xfa, 0x44, 0x1a, 0xdf, 0xed, 0x22, 0x3e, 0x83,
-	0xd5, 0xa3, 0x48, 0x66, 0x41, 0x36, 0x38, 0x8a, 0x06, 0x41, 0x4c, 0xc3, 0x74, 0x2e, 0x30, 0xcc,
-	0x54, 0x2f, 0xef, 0x4b, 0xb8, 0x5a, 0x4b, 0x4a, 0x0e, 0x78, 0x78, 0x18, 0x4c, 0xe2, 0x82, 0x17,
-	0xae, 0x41, 0x5c, 0xfa, 0x78, 0x38, 0x0a, 0xbe, 0x51, 0x8d, 0xbc, 0xf4, 0x12, 0xe3, 0x7d, 0xd7,
-	0x80, 0x45, 0xdb, 0xc2, 0x8b, 0x3f, 0x00, 0x88, 0x92, 0x42, 0x66, 0x87, 0xc1, 0xc0, 0x44, 0xa7,
-	0x5a, 0xf7, 0x76, 0x75, 0x03, 0xdb, 0xf7, 0x92, 0x50, 0xdc, 0x84, 0x56, 0x31, 0x18, 0xb3, 0x47,
-	0xd2, 0x8e, 0xe0, 0xe1, 0x60, 0x8c, 0x94, 0x3e, 0x36, 0x61, 0xc8, 0x51, 0x0c, 0xc6, 0xef, 0xb1,
-	0x2b, 0xaa, 0x92, 0x50, 0x9b, 0xf7, 0xcf, 0x4d, 0xe8, 0x32, 0x06, 0xcd, 0x33, 0x7a, 0x87, 0x27,
-	0x31, 0x95, 0x08, 0x78, 0x5d, 0x36, 0x0a, 0x57, 0x9d, 0x9f, 0x26, 0x07, 0x32, 0xd1, 0x0b, 0xd3,
-	0x20, 0xb7, 0xf8, 0x72, 0x70, 0xa2, 0x37, 0x94, 0x41, 0x0c, 0x2b, 0x0e, 0xa3, 0x04, 0x8f, 0xff,
-	0x3b, 0xac, 0xcd, 0x06, 0xb6, 0xda, 0xee, 0xb2, 0x4e, 0x1b, 0x18, 0xdb, 0xd0, 0x5d, 0x21, 0x40,
-	0xee, 0xab, 0xed, 0x1b, 0x18, 0x95, 0x6e, 0x10, 0xa7, 0xb9, 0xa4, 0x38, 0xa9, 0xed, 0x2b, 0x80,
-	0x02, 0x30, 0xfc, 0xa0, 0x2e, 0xf3, 0xd4, 0x52, 0x22, 0x90, 0xc3, 0x38, 0xc8, 0x8b, 0xad, 0xc1,
-	0x71, 0xbf, 0xa7, 0x38, 0x64, 0x10, 0x0f, 0x61, 0x1c, 0xe5, 0x85, 0x4c, 0xfa, 0xa0, 0xdc, 0x84,
-	0x82, 0x28, 0xa4, 0x89, 0xd3, 0x1c, 0x13, 0x9e, 0x05, 0xd5, 0x83, 0x41, 0xef, 0xd7, 0x4d, 0x58,
-	0x76, 0xb7, 0xa6, 0xf6, 0xc4, 0xf7, 0xa1, 0x9b, 0x3d, 0x23, 0xdf, 0xa0, 0xc5, 0xc5, 0x20, 0xb2,
-	0x9a, 0x3d, 0xdb, 0x0f, 0x06, 0xc7, 0xb2, 0xc8, 0x59, 0x60, 0x25, 0x82, 0x22, 0xb1, 0x67, 0xf7,
-	0xb3, 0x0c, 0x73, 0x3b, 0x16, 0x99, 0x86, 0x55, 0xcf, 0x9d, 0x2c, 0x1d, 0x8f, 0x39, 0xd2, 0xa2,
-	0x9e, 0x8c, 0xc0, 0x19, 0x0b, 0x9e, 0x51, 0xc9, 0x4c, 0x83, 0x14, 0x0e, 0x98, 0x19, 0x95, 0xd8,
-	0x4a, 0x04, 0x09, 0x5b, 0xcf, 0x38, 0xcf, 0xc2, 0xb6, 0x66, 0x2c, 0xcc, 0x8c, 0x3d, 0xdd, 0x93,
-	0x11, 0xde, 0xef, 0x5a, 0xd0, 0xe5, 0xf0, 0x83, 0x52, 0x36, 0x89, 0x1e, 0x43, 0x17, 0xcd, 0x14,
-	0x84, 0xdb, 0x15, 0x47, 0xa3, 0x48, 0x2b, 0x8d, 0x02, 0x4a, 0xcb, 0xd1, 0xb2, 0x2d, 0xc7, 0x0d,
-	0xe8, 0x05, 0x27, 0x41, 0x14, 0x07, 0x4f, 0x62, 0xc9, 0x8b, 0x2f, 0x11, 0xe2, 0x35, 0x58, 0xc6,
-	0xcc, 0x32, 0xdf, 0x4e, 0x47, 0xe3, 0x58, 0x16, 0x46, 0x04, 0x15, 0xac, 0x8a, 0x57, 0x83, 0x30,
-	0x57, 0xee, 0x82, 0x65, 0x61, 0xa3, 0x90, 0xc2, 0x18, 0xf2, 0x20, 0x64, 0x89, 0xd8, 0x28, 0x9d,
-	0xd5, 0x9a, 0x9c, 0xa2, 0xed, 0x1b, 0x58, 0xdc, 0x86, 0x95, 0xa7, 0x59, 0x54, 0x48, 0x8b, 0x11,
-	0x25, 0x99, 0x2a, 0x5a, 0x78, 0xb0, 0xa8, 0x50, 0xcc, 0x8a, 0x52, 0x31, 0x07, 0x87, 0xab, 0xe2,
-	0x89, 0xbf, 0xca, 0xa2, 0x02, 0x15, 0x51, 0xe9, 0x5b, 0x05, 0x8b, 0xb2, 0xa1, 0x7e, 0xc4, 0xd2,
-	0xa2, 0x92, 0x8d, 0x41, 0xe0, 0x4c, 0x51, 0xba, 0x9b, 0xec, 0x67, 0xe9, 0x30, 0x93, 0x79, 0xde,
-	0x5f, 0x52, 0x33, 0xd9, 0x38, 0xdc, 0x21, 0xe5, 0x00, 0xfb, 0xcb, 0x4a, 0xd5, 0x15, 0x84, 0x1c,
-	0x3c, 0x95, 0xd1, 0xf0, 0xa8, 0x90, 0xe1, 0xae, 0x6a, 0x5f, 0x51, 0x1c, 0xb8, 0x58, 0xef, 0x1f,
-	0x9a, 0x56, 0xd1, 0x90, 0x77, 0xbd, 0x52, 0x8d, 0x6a, 0x4c, 0x57, 0xa3, 0x38, 0xc2, 0x6e, 0x5e,
-	0x24, 0xc2, 0x6e, 0x5d, 0x38, 0xc2, 0x6e, 0x3f, 0x4f, 0x84, 0xdd, 0x79, 0xee, 0x08, 0x7b, 0xee,
-	0xf9, 0x22, 0xec, 0x6e, 0x25, 0xc2, 0xf6, 0x5e, 0x83, 0x65, 0xce, 0x39, 0x7d, 0xf9, 0xa7, 0x13,
-	0x99, 0x17, 0xf5, 0xa9, 0xa7, 0xf7, 0x21, 0xac, 0x18, 0xba, 0x7c, 0x9c, 0x26, 0x39, 0x6a, 0x17,
-	0xa6, 0x73, 0x88, 0xe2, 0x80, 0xda, 0x4a, 0x17, 0x89, 0x50, 0x37, 0x7b, 0xf7, 0x68, 0x92, 0x07,
-	0x51, 0x5e, 0xcc, 0x9c, 0x84, 0x8a, 0x0d, 0x23, 0x93, 0xf3, 0xd1, 0xb7, 0xf7, 0xbf, 0x0d, 0x58,
-	0x32, 0x9d, 0x73, 0x74, 0x5d, 0xf5, 0x7d, 0xad, 0x5c, 0xb3, 0xe9, 0xe4, 0x9a, 0x66, 0xd4, 0x56,
-	0x39, 0x2a, 0x45, 0x34, 0x65, 0xb5, 0xb3, 0x67, 0x32, 0xd6, 0xd9, 0xd9, 0xf1, 0x07, 0x26, 0x03,
-	0x54, 0x62, 0xbf, 0x59, 0x2e, 0xb8, 0xe4, 0xef, 0x45, 0x67, 0x81, 0x5b, 0x24, 0x79, 0x1e, 0x5f,
-	0x49, 0x7e, 0x93, 0xd6, 0x8a, 0x28, 0x76, 0xc4, 0x6b, 0x75, 0x8c, 0xf8, 0x9a, 0xc8, 0xfb, 0x18,
-	0xd6, 0xcc, 0x71, 0xf8, 0x7e, 0xbb, 0xf0, 0x5d, 0x03, 0xae, 0x54, 0x86, 0xa0, 0xbd, 0x38, 0xff,
-	0x54, 0xd9, 0x97, 0x34, 0xd6, 0xee, 0xb8, 0xc8, 0x33, 0x6a, 0xd2, 0x67, 0xec, 0x92, 0xf7, 0x35,
-	0x5c, 0xad, 0x32, 0xa3, 0x04, 0xf3, 0xb1, 0x35, 0x99, 0x25, 0x9e, 0x8d, 0x6a, 0xb6, 0x68, 0x09,
-	0xc9, 0xed, 0xe0, 0xbd, 0x6b, 0x89, 0xca, 0x3e, 0x15, 0x37, 0xaa, 0x25, 0xf8, 0x9e, 0x55, 0x70,
-	0xf7, 0x0e, 0x2c, 0x86, 0x9c, 0x33, 0x72, 0xcf, 0x62, 0xc8, 0x3a, 0x29, 0x53, 0x95, 0x61, 0xea,
-	0xe4, 0x92, 0x7a, 0xfb, 0xb0, 0xf8, 0x78, 0xcf, 0x92, 0xb5, 0xde, 0x97, 0x86, 0xa5, 0xc7, 0x46,
-	0x6e, 0xcd, 0x7a, 0xb9, 0xb5, 0x1c, 0xb9, 0xfd, 0x0c, 0x96, 0xf4, 0x88, 0xcf, 0xab, 0x00, 0x1f,
-	0xc1, 0xb2, 0x61, 0x46, 0x2d, 0xed, 0x0d, 0x98, 0x3b, 0x19, 0x59, 0x42, 0xd6, 0x56, 0xcb, 0xe6,
-	0xd9, 0x67, 0x12, 0xef, 0x97, 0xb0, 0x4a, 0x65, 0x12, 0x7b, 0x72, 0xaa, 0x87, 0xc5, 0x85, 0xcc,
-	0xb6, 0xb2, 0x61, 0xce, 0x1c, 0x58, 0x18, 0xaa, 0x04, 0x13, 0xa4, 0x4b, 0xae, 0x0a, 0xc2, 0xc3,
-	0x13, 0xc4, 0x31, 0x5f, 0x8e, 0xe1, 0xa7, 0xb7, 0x0d, 0x97, 0xad, 0xd1, 0xcd, 0x21, 0xe9, 0x45,
-	0x1a, 0x59, 0xa9, 0xa6, 0x9a, 0x8a, 0x8d, 0x5f, 0x92, 0xa0, 0x85, 0x7b, 0xbc, 0xb7, 0x4d, 0x67,
-	0x5d, 0x73, 0xb8, 0x5a, 0xd6, 0x5c, 0x3a, 0xca, 0x01, 0x94, 0xa5, 0xcf, 0xa6, 0x5d, 0xfa, 0xf4,
-	0x5e, 0x83, 0xd5, 0xb2, 0x33, 0x33, 0x50, 0xb3, 0x5f, 0xde, 0xab, 0x38, 0x89, 0x2f, 0x47, 0xe9,
-	0x89, 0x99, 0xa4, 0x8e, 0xec, 0x0f, 0x71, 0x38, 0x4d, 0x56, 0x0e, 0x37, 0x28, 0x6f, 0xe4, 0xe8,
-	0x9b, 0x22, 0xcc, 0x60, 0x92, 0x1b, 0xab, 0x41, 0x80, 0xf7, 0x9b, 0x06, 0x5c, 0x7e, 0x94, 0xcb,
-	0x6c, 0xbb, 0x7a, 0x0f, 0x6a, 0x6e, 0x52, 0x1b, 0xe7, 0xdd, 0xa4, 0x36, 0xeb, 0x6e, 0x52, 0x29,
-	0x18, 0xa1, 0x5c, 0xdb, 0xba, 0x6d, 0xb5, 0x51, 0xb3, 0xee, 0x5a, 0xbd, 0x5f, 0x37, 0xe0, 0x0a,
-	0x72, 0xc5, 0x75, 0x6c, 0x79, 0x28, 0x33, 0x99, 0x0c, 0x54, 0x61, 0x3f, 0x28, 0x8e, 0xf4, 0xfa,
-	0xf1, 0x1b, 0xc5, 0xac, 0xca, 0xdc, 0x7a, 0xeb, 0x15, 0x34, 0xeb, 0x72, 0x54, 0xbc, 0x8e, 0x61,
-	0x5d, 0x11, 0x44, 0x31, 0xbb, 0x5c, 0xed, 0x9c, 0xad, 0x39, 0x99, 0xc0, 0xfb, 0x47, 0x16, 0xd0,
-	0xa7, 0x51, 0x7c, 0x0e, 0x23, 0x14, 0xfa, 0xc7, 0x32, 0x29, 0x0d, 0x97, 0x81, 0x89, 0x5e, 0x66,
-	0x23, 0xed, 0x57, 0xf0, 0xdb, 0xd4, 0x77, 0xda, 0xd6, 0x8d, 0xc4, 0x1a, 0x74, 0x86, 0x59, 0x3a,
-	0x19, 0xf3, 0x35, 0x85, 0x02, 0xc4, 0x2d, 0xc3, 0xee, 0x9c, 0x13, 0x70, 0x18, 0xbe, 0x34, 0xb3,
-	0x7f, 0x02, 0xf3, 0x88, 0xc3, 0xbf, 0xda, 0xf0, 0xdd, 0x0c, 0xdf, 0xb4, 0x87, 0xbf, 0x03, 0xab,
-	0x41, 0x18, 0x46, 0x45, 0x94, 0x26, 0x41, 0xfc, 0x0b, 0x44, 0xe9, 0x72, 0xe9, 0x14, 0xde, 0xdb,
-	0x81, 0xb9, 0x47, 0x2a, 0xd8, 0x15, 0xd0, 0xfe, 0xdc, 0x1a, 0x5f, 0xbb, 0xcf, 0xcf, 0x82, 0x2c,
-	0xe4, 0xa8, 0x98, 0xbe, 0x11, 0x77, 0x90, 0x1e, 0xea, 0xac, 0x98, 0xbe, 0xbd, 0xdf, 0xce, 0xc1,
-	0x92, 0xa3, 0x75, 0x67, 0x71, 0x5b, 0x73, 0xe9, 0xd3, 0x87, 0x2e, 0xc6, 0x36, 0x61, 0xa4, 0xaf,
-	0x51, 0x34, 0x88, 0x9a, 0x99, 0x49, 0xaa, 0xc2, 0xf3, 0x85, 0x9f, 0x92, 0xac, 0x8b, 0xd4, 0x57,
-	0x77, 0x9d, 0xf2, 0xea, 0xee, 0x03, 0x2a, 0xaa, 0x0d, 0x8a, 0xb8, 0xe2, 0xaa, 0x1d, 0x0e, 0x37,
-	0x0f, 0x88, 0x84, 0x5d, 0xb5, 0xa2, 0x17, 0xaf, 0x43, 0x5b, 0x26, 0x27, 0x79, 0xa5, 0x40, 0x53,
-	0xb9, 0x99, 0x23, 0x12, 0x4a, 0xbd, 0xd4, 0x7d, 0x20, 0x15, 0x63, 0x7a, 0xbe, 0x06, 0xd1, 0xb6,
-	0x49, 0x1c, 0x75, 0x9c, 0x46, 0x49, 0xc1, 0x77, 0x87, 0x16, 0x46, 0x6c, 0xea, 0x9b, 0x42, 0xa0,
-	0x59, 0xfa, 0x75, 0xdc, 0xd9, 0xb7, 0x85, 0xef, 0x96, 0x17, 0x43, 0x0b, 0x8e, 0x4b, 0xab, 0x39,
-	0x51, 0xe5, 0x15, 0xd1, 0x26, 0x74, 0x28, 0x10, 0xe4, 0x5b, 0xc6, 0x7e, 0x55, 0xc5, 0x4c, 0x0f,
-	0x45, 0x26, 0x7e, 0xc2, 0xda, 0xbb, 0x34, 0xa5, 0x91, 0xf8, 0xc7, 0xea, 0xfc, 0x41, 0xe5, 0x5e,
-	0xb1, 0x5e, 0xb2, 0x75, 0x77, 0x49, 0xaa, 0xcc, 0xbf, 0x62, 0xca, 0xfc, 0x2f, 0x03, 0x1c, 0x14,
-	0xe9, 0xf8, 0x20, 0x1a, 0x26, 0x41, 0xdc, 0xbf, 0xac, 0x1c, 0x40, 0x89, 0x11, 0xb7, 0xa0, 0x3b,
-	0x21, 0xbd, 0xcc, 0xfb, 0x82, 0xa6, 0x5a, 0xd2, 0x53, 0x11, 0xd6, 0xd7, 0xad, 0x94, 0x34, 0xa7,
-	0x43, 0x7a, 0x4f, 0x71, 0x45, 0xa9, 0x0f, 0x83, 0x8e, 0xc1, 0x58, 0xab, 0x18, 0x0c, 0x32, 0x9e,
-	0x83, 0x23, 0xd9, 0xbf, 0xaa, 0x8d, 0xe7, 0xe0, 0x48, 0x62, 0xa4, 0x66, 0x69, 0xc5, 0xf3, 0x44,
-	0x6a, 0x3f, 0x24, 0xc8, 0xbb, 0x07, 0x8b, 0x24, 0x62, 0xc9, 0x95, 0x35, 0x7d, 0xed, 0xd6, 0xa8,
-	0xbd, 0x76, 0x73, 0x7d, 0xcf, 0xa1, 0x32, 0x10, 0xb8, 0xa3, 0x67, 0x3d, 0xa1, 0x91, 0xc9, 0x20,
-	0x0d, 0xa3, 0x64, 0xa8, 0x6d, 0x98, 0x86, 0x91, 0xc7, 0x49, 0x16, 0xf1, 0xa1, 0xc3, 0x4f, 0xa5,
-	0xd3, 0x49, 0x21, 0x13, 0xfd, 0x58, 0x43, 0x83, 0xe8, 0xc3, 0x4b, 0x6d, 0xfb, 0x62, 0x8c, 0x26,
-	0xc4, 0xd8, 0xbb, 0x46, 0xfd, 0x0d, 0x6c, 0x73, 0xea, 0x06, 0xd6, 0xdc, 0x06, 0xb7, 0xdc, 0xdb,
-	0x60, 0xef, 0x9f, 0x1a, 0x00, 0xe5, 0xf0, 0xcf, 0x7b, 0x07, 0x7b, 0x98, 0x66, 0xa3, 0xa0, 0x30,
-	0x57, 0xc6, 0x04, 0x89, 0xb7, 0x60, 0x2e, 0x25, 0x36, 0xd9, 0x23, 0x5c, 0x9b, 0x3a, 0x33, 0x6a,
-	0x15, 0x3e, 0x93, 0xa9, 0xbb, 0x67, 0xaa, 0x7e, 0x76, 0xf4, 0xdd, 0x33, 0xd5, 0x3f, 0x8d, 0xa6,
-	0xcc, 0x59, 0x9a, 0xe2, 0xfd, 0x6d, 0x43, 0x19, 0x3c, 0x53, 0x62, 0xc1, 0xfe, 0x4f, 0xb2, 0x28,
-	0x1c, 0x9a, 0xca, 0x82, 0x82, 0x48, 0xf1, 0xb5, 0x7d, 0x6e, 0x46, 0x63, 0xca, 0x6f, 0x0f, 0x69,
-	0x79, 0xcc, 0xb0, 0x82, 0x70, 0x37, 0x46, 0xc1, 0x80, 0xe5, 0x8e, 0x9f, 0x84, 0x29, 0x26, 0x5c,
-	0x3e, 0xc0, 0x4f, 0x94, 0xee, 0x30, 0x28, 0xe4, 0xd3, 0xe0, 0x54, 0xdf, 0x6f, 0x33, 0xc8, 0xc7,
-	0x2b, 0xd4, 0xc7, 0xcb, 0xfb, 0x0c, 0x04, 0xb2, 0xa7, 0x8b, 0xff, 0xc1, 0xe0, 0x58, 0x26, 0xa1,
-	0x75, 0x27, 0xda, 0x70, 0xee, 0x44, 0x67, 0x3c, 0xb4, 0xf2, 0xfe, 0xa6, 0x01, 0x0b, 0xd6, 0x50,
-	0x74, 0x53, 0xaa, 0x3e, 0xcd, 0x30, 0x25, 0xc2, 0x09, 0x02, 0x9a, 0x95, 0x07, 0x57, 0xe7, 0x87,
-	0x10, 0x6f, 0x41, 0x07, 0xe7, 0xcd, 0xb9, 0xec, 0x7f, 0xdd, 0xda, 0x33, 0x77, 0x25, 0xbe, 0xa2,
-	0xf3, 0xfe, 0xb2, 0x01, 0x8b, 0x98, 0xf7, 0xa4, 0xc3, 0xed, 0x34, 0x39, 0x8c, 0x86, 0xa6, 0x82,
-	0xdd, 0xb0, 0x2a, 0xd8, 0xef, 0xc3, 0xdc, 0x80, 0x5a, 0xf9, 0x7a, 0xe3, 0x15, 0x2b, 0x61, 0xd2,
-	0x1d, 0x37, 0xd5, 0x3f, 0x6c, 0xb3, 0x14, 0x39, 0x9e, 0x69, 0x0b, 0xfd, 0x5c, 0x67, 0xfa, 0x18,
-	0x16, 0x70, 0x45, 0x7b, 0xc1, 0x78, 0x8c, 0xca, 0x3f, 0x15, 0x63, 0x35, 0x2a, 0x89, 0xd0, 0x54,
-	0x94, 0xc6, 0xc2, 0x33, 0x51, 0x9a, 0x2d, 0xd8, 0x56, 0x25, 0xba, 0x4a, 0x60, 0x0d, 0x69, 0x46,
-	0x6a, 0xb2, 0xaf, 0x8e, 0xa2, 0x82, 0xa2, 0x5a, 0x8c, 0x03, 0xa8, 0x1a, 0x9b, 0x04, 0x31, 0x97,
-	0x13, 0xf4, 0x43, 0x8c, 0x29, 0x3c, 0xd2, 0xca, 0x67, 0x15, 0xda, 0xa6, 0xa2, 0xad, 0xe2, 0xbd,
-	0xdf, 0xcc, 0x41, 0x17, 0xf7, 0x64, 0x3f, 0x0d, 0xeb, 0xae, 0x6f, 0x91, 0x67, 0x3b, 0x68, 0xd2,
-	0xb0, 0xd9, 0x9c, 0x96, 0xb5, 0x39, 0xdf, 0xd7, 0xc7, 0xdf, 0xad, 0xa4, 0xe3, 0xb6, 0x4f, 0xdc,
-	0x4f, 0xc3, 0x5a, 0x1f, 0xf4, 0x16, 0x3a, 0x04, 0xb6, 0x22, 0x5d, 0xa7, 0xda, 0x62, 0xdb, 0x5f,
-	0xdf, 0x10, 0x89, 0x57, 0xa1, 0x15, 0xa7, 0x43, 0x2a, 0xad, 0x95, 0xb4, 0xb6, 0xda, 0xf8, 0xd8,
-	0x8e, 0xdc, 0x85, 0x89, 0x7e, 0x25, 0x84, 0x9f, 0xe2, 0x5d, 0xe7, 0x7d, 0x06, 0x38, 0x79, 0xba,
-	0xe3, 0x2b, 0x9d, 0x37, 0x1a, 0xaf, 0x6a, 0x97, 0xad, 0xdc, 0xfc, 0x54, 0x54, 0xc8, 0x9e, 0xfa,
-	0x8d, 0x32, 0x1e, 0x50, 0xbe, 0xbd, 0x26, 0xda, 0x35, 0x61, 0xc0, 0xbb, 0x4e, 0xe9, 0x7e, 0x69,
-	0x8a, 0x13, 0x63, 0xc0, 0x9c, 0xca, 0xfd, 0x26, 0xcc, 0xf3, 0xb9, 0xd4, 0x9e, 0x5e, 0x4c, 0x9f,
-	0x45, 0xdf, 0xd0, 0x88, 0x2f, 0xe1, 0xea, 0xb8, 0x46, 0x03, 0x73, 0x72, 0xf8, 0x0b, 0x77, 0x5f,
-	0x32, 0xa2, 0x9b, 0xa6, 0xf1, 0xeb, 0x7b, 0x8a, 0xf7, 0x60, 0xd1, 0x6a, 0xc8, 0xfb, 0xab, 0x0e,
-	0x1b, 0xd6, 0xe1, 0xf2, 0x1d, 0x3a, 0x0c, 0x2c, 0xc2, 0x24, 0x57, 0xc6, 0x3d, 0xef, 0x5f, 0x56,
-	0xd1, 0x57, 0x89, 0x41, 0xfb, 0x15, 0x26, 0xf9, 0x81, 0x0c, 0xb2, 0xc1, 0x11, 0x85, 0x16, 0x3d,
-	0xbf, 0x44, 0xfc, 0x10, 0x37, 0xee, 0xc3, 0xea, 0x7e, 0x1a, 0xba, 0x49, 0xa4, 0x2a, 0x93, 0x1d,
-	0x8c, 0xe5, 0xa0, 0x52, 0x26, 0x63, 0x35, 0xf5, 0x75, 0x73, 0x7d, 0x32, 0xef, 0xbd, 0x0e, 0x97,
-	0xad, 0x31, 0x39, 0x19, 0xac, 0x2f, 0xd2, 0xdd, 0xa6, 0xe9, 0xdd, 0xf4, 0xb2, 0x9e, 0xf2, 0x23,
-	0x1a, 0xf4, 0x7b, 0x67, 0x98, 0xff, 0xde, 0xb0, 0x2b, 0x4a, 0xe9, 0x30, 0xbf, 0x50, 0x99, 0x44,
-	0x39, 0xea, 0x38, 0x4e, 0x9f, 0xd2, 0x68, 0xf3, 0x3e, 0x43, 0xb8, 0x5f, 0xa6, 0x22, 0x99, 0x73,
-	0x62, 0x67, 0x61, 0xc8, 0x68, 0xe8, 0xc4, 0x0e, 0x8d, 0x46, 0x10, 0xc5, 0xc8, 0x58, 0x1e, 0x25,
-	0x03, 0xed, 0xaa, 0x15, 0xa0, 0x2a, 0x1f, 0x61, 0x3a, 0x51, 0x97, 0x31, 0xf3, 0x3e, 0x43, 0x8c,
-	0x97, 0x59, 0xc6, 0x2f, 0xbf, 0x18, 0xf2, 0x5e, 0xb7, 0x2b, 0x49, 0xb4, 0x0e, 0x96, 0xc5, 0xaa,
-	0x3a, 0xf6, 0xb8, 0x84, 0x45, 0x3a, 0xe1, 0x18, 0xa2, 0xed, 0xd0, 0xdb, 0xae, 0x19, 0xaf, 0x50,
-	0xcb, 0xc2, 0x4b, 0xd3, 0x29, 0xbc, 0x2c, 0xc1, 0x82, 0x55, 0x4c, 0xf2, 0xbe, 0x6b, 0xc1, 0xa2,
-	0x53, 0x26, 0x5a, 0x86, 0xa6, 0xd9, 0xa1, 0xe6, 0xee, 0x0e, 0x0a, 0xc4, 0x79, 0xdb, 0x85, 0xfb,
-	0x61, 0x5b, 0x09, 0x0c, 0x20, 0x30, 0x71, 0xca, 0xd9, 0x83, 0x32, 0x64, 0xbd, 0x46, 0x6b, 0x3b,
-	0xaf, 0xd1, 0xde, 0x84, 0x6e, 0xc8, 0x8c, 0x75, 0x9c, 0x62, 0x8d, 0xbd, 0x22, 0x5f, 0xd3, 0xa0,
-	0x41, 0x0e, 0xd3, 0xc1, 0xb1, 0xcc, 0xfc, 0x34, 0x2d, 0xca, 0x07, 0x94, 0x2e, 0x52, 0x6c, 0x82,
-	0x88, 0x92, 0x50, 0x3e, 0x43, 0x53, 0x20, 0xb3, 0xad, 0x30, 0xa4, 0x7a, 0xbe, 0x7a, 0x51, 0x59,
-	0xd3, 0x22, 0x6e, 0xc3, 0x8a, 0x7c, 0x26, 0x07, 0x13, 0x3c, 0x83, 0x6a, 0x5e, 0x7e, 0x15, 0x54,
-	0x45, 0x53, 0x9c, 0x28, 0x47, 0x0f, 0xe9, 0x59, 0x45, 0x8f, 0x8a, 0xb0, 0x06, 0x56, 0xef, 0x00,
-	0xc3, 0x9c, 0x6e, 0x28, 0x5a, 0x3e, 0x7d, 0xe3, 0xc8, 0xe9, 0x58, 0x66, 0x01, 0x3d, 0xd8, 0x55,
-	0x75, 0xf1, 0x05, 0x35, 0x72, 0x05, 0x6d, 0x36, 0x6d, 0xb1, 0xdc, 0x34, 0x2f, 0x80, 0xcb, 0xf7,
-	0x9f, 0xc9, 0x81, 0x7b, 0x6a, 0xcf, 0x2f, 0x6c, 0x5a, 0xc9, 0x5f, 0xd3, 0x4d, 0xfe, 0xd8, 0x53,
-	0xb5, 0x8c, 0xa7, 0xf2, 0x7e, 0x1f, 0x84, 0x3d, 0x05, 0xef, 0xfa, 0x3a, 0xcc, 0xe1, 0xca, 0xcd,
-	0xf0, 0x0c, 0x79, 0x4f, 0x60, 0x15, 0xa9, 0x0f, 0xd0, 0xf9, 0x5d, 0x9c, 0x9f, 0x72, 0xb4, 0xa6,
-	0x3d, 0x1a, 0x1d, 0x94, 0x22, 0x8c, 0xd4, 0xdb, 0xb0, 0x45, 0x5f, 0x01, 0xde, 0x1b, 0x6a, 0xd1,
-	0x3c, 0x47, 0xc9, 0x10, 0x9f, 0x1e, 0xa5, 0xf7, 0x0c, 0x79, 0x8f, 0x60, 0x09, 0x89, 0x1f, 0xef,
-	0xcd, 0xae, 0x1b, 0x9e, 0x2d, 0x91, 0x7a, 0x1e, 0x76, 0x60, 0x59, 0x0f, 0x3b, 0x9b, 0x01, 0xe7,
-	0x45, 0x7a, 0xd3, 0x7d, 0x91, 0xee, 0x49, 0x5e, 0x09, 0xe5, 0x8c, 0x3f, 0x5c, 0x5c, 0xc8, 0x82,
-	0x4a, 0x48, 0x5b, 0xa4, 0x61, 0x0c, 0x79, 0x6b, 0x6a, 0x0b, 0xf5, 0x34, 0x8a, 0x61, 0xef, 0x16,
-	0x15, 0xe7, 0x9d, 0x9d, 0xaa, 0x37, 0xb8, 0x82, 0x4c, 0xb3, 0x23, 0x6e, 0x2f, 0x80, 0x85, 0xaf,
-	0x82, 0xa8, 0xb8, 0x98, 0xed, 0xbc, 0x01, 0xbd, 0x71, 0x96, 0x0e, 0x64, 0x9e, 0xef, 0xea, 0x07,
-	0x80, 0x25, 0x02, 0xb9, 0x4e, 0xd2, 0xcf, 0x82, 0x64, 0xc8, 0x5a, 0xc7, 0x90, 0x77, 0x07, 0x16,
-	0xd5, 0x14, 0x2c, 0xe0, 0x19, 0x4f, 0xfb, 0xbd, 0xfb, 0xb0, 0xb4, 0x55, 0x14, 0xc1, 0xe0, 0x68,
-	0x8f, 0x9f, 0x55, 0x9e, 0x2f, 0x44, 0x01, 0xed, 0x30, 0x28, 0x02, 0xe2, 0x67, 0xd1, 0xa7, 0x6f,
-	0xef, 0x1b, 0x58, 0x37, 0x26, 0xd5, 0x3d, 0x53, 0x76, 0x31, 0xdc, 0xf2, 0x87, 0xf5, 0x41, 0x91,
-	0x4b, 0x7a, 0x86, 0x6f, 0xfc, 0x10, 0xae, 0x4d, 0xcd, 0xc5, 0x2b, 0x3d, 0x97, 0x79, 0xef, 0x9e,
-	0x65, 0xfb, 0x9d, 0x1d, 0xfc, 0x31, 0x2c, 0x1a, 0xba, 0x5f, 0x99, 0xc8, 0xd6, 0xea, 0x1b, 0x7a,
-	0x7d, 0x6b, 0x91, 0xee, 0xa6, 0x8e, 0xad, 0x16, 0x9f, 0x0a, 0x85, 0x7a, 0xd8, 0x3b, 0xb0, 0x9a,
-	0xc6, 0xe1, 0xb6, 0x73, 0x19, 0xa2, 0x86, 0x9e, 0xc2, 0x23, 0x6d, 0x22, 0x9f, 0x6e, 0xd7, 0x5c,
-	0x9c, 0x4c, 0xe1, 0xbd, 0xeb, 0x96, 0x10, 0xf4, 0x8c, 0xcc, 0xcc, 0x87, 0x0e, 0x33, 0x76, 0x58,
-	0x70, 0x81, 0x35, 0xba, 0xe3, 0xda, 0x91, 0x82, 0xf7, 0xdb, 0x06, 0xc0, 0xd6, 0xa4, 0x38, 0xe2,
-	0x8c, 0x6b, 0x03, 0xe6, 0x31, 0xf3, 0xb7, 0xdc, 0xa1, 0x81, 0xd5, 0x5b, 0xce, 0x3c, 0x7f, 0x9a,
-	0x66, 0x61, 0xf9, 0x96, 0x53, 0xc1, 0xf4, 0x86, 0x7e, 0x52, 0x1c, 0xe9, 0x64, 0x00, 0xbf, 0x71,
-	0xa3, 0xe5, 0xa8, 0x74, 0xf6, 0x0a, 0x40, 0x8f, 0x94, 0x93, 0x33, 0x09, 0xd8, 0xcd, 0x28, 0xaf,
-	0xef, 0x22, 0x55, 0x22, 0x31, 0x8c, 0xf2, 0x22, 0x3b, 0x2d, 0xd2, 0x63, 0x99, 0x68, 0xbf, 0xe5,
-	0x20, 0xbd, 0x80, 0xef, 0x22, 0xf6, 0x27, 0x71, 0x6c, 0x1d, 0x5a, 0x55, 0x96, 0x6c, 0xd8, 0x65,
-	0x49, 0x34, 0xe4, 0x81, 0xae, 0x62, 0xe0, 0xa7, 0x78, 0xd5, 0xe2, 0xb8, 0x0c, 0xba, 0x4b, 0x51,
-	0xa8, 0x45, 0x78, 0xb7, 0xf8, 0x42, 0x42, 0x4d, 0x51, 0x86, 0x57, 0x74, 0x58, 0x1a, 0xd6, 0x61,
-	0xf9, 0x95, 0xe1, 0x25, 0x3f, 0xb2, 0x2e, 0x04, 0x32, 0x39, 0x4e, 0x75, 0x60, 0x81, 0xdf, 0x2f,
-	0x82, 0x13, 0x9c, 0x60, 0x06, 0x27, 0x8f, 0x41, 0x10, 0xe1, 0x54, 0xf4, 0x58, 0x23, 0x97, 0x35,
-	0xe8, 0x1c, 0xa6, 0xba, 0x0e, 0x33, 0xef, 0x2b, 0x80, 0x8e, 0x68, 0x36, 0x49, 0x24, 0x9b, 0x20,
-	0x05, 0x78, 0x5b, 0xb0, 0x40, 0xe3, 0xee, 0xc8, 0x58, 0x16, 0x54, 0xe9, 0x9d, 0x24, 0x45, 0x30,
-	0x94, 0x5a, 0xe5, 0x34, 0x88, 0x2d, 0xa1, 0x54, 0x8f, 0x14, 0xb8, 0x6c, 0xc4, 0xa0, 0xb7, 0x05,
-	0x57, 0x1c, 0xd6, 0x78, 0x15, 0x77, 0x4c, 0x10, 0xd4, 0x70, 0xf2, 0x02, 0x6b, 0x3a, 0x1d, 0x18,
-	0x79, 0xbe, 0x15, 0xaf, 0x1e, 0x14, 0xe9, 0xf8, 0xb9, 0xdc, 0x3c, 0x46, 0xa2, 0xe8, 0x93, 0xd4,
-	0x2f, 0x69, 0x34, 0xe8, 0x5d, 0x73, 0xec, 0x07, 0x8e, 0xc9, 0xa7, 0x63, 0x15, 0x96, 0xf9, 0xf5,
-	0xb5, 0x0e, 0xf8, 0xfe, 0x08, 0x56, 0x0c, 0x86, 0xb9, 0xef, 0x43, 0xf7, 0x84, 0x1f, 0x6d, 0xb3,
-	0x20, 0x18, 0xac, 0xbc, 0xe8, 0x6e, 0x56, 0x5f, 0x74, 0x7b, 0xf7, 0xe1, 0x0a, 0x67, 0x5f, 0x95,
-	0xfb, 0xae, 0x32, 0x5f, 0x6b, 0x9c, 0x9f, 0xaf, 0x79, 0x77, 0x40, 0x38, 0xc3, 0xcc, 0xf2, 0x5e,
-	0x5f, 0xc3, 0x65, 0xa6, 0xdd, 0x0a, 0xc3, 0xd9, 0x41, 0x80, 0xcd, 0x46, 0xf3, 0x02, 0x6c, 0xac,
-	0x19, 0x36, 0x68, 0x68, 0x16, 0x61, 0x39, 0xe1, 0x8e, 0x8c, 0xff, 0xbf, 0x26, 0xa4, 0xa1, 0x79,
-	0xc2, 0x5f, 0xc2, 0x1a, 0x63, 0x1f, 0x8d, 0x43, 0xcb, 0x67, 0xbd, 0x98, 0x39, 0xaf, 0xc1, 0xd5,
-	0xca, 0xe8, 0x3c, 0xed, 0x26, 0xac, 0x5b, 0x69, 0xec, 0xf9, 0x1b, 0xf1, 0x25, 0x5c, 0x9b, 0xa2,
-	0xe7, 0xfd, 0xe7, 0x64, 0x79, 0x4f, 0x27, 0xcb, 0x8d, 0xd9, 0xc9, 0xb2, 0xa6, 0xf3, 0x8e, 0xa0,
-	0x6f, 0x35, 0xee, 0xa5, 0x61, 0x74, 0x78, 0x3a, 0x7b, 0xf5, 0xd5, 0x99, 0x9a, 0x17, 0x9c, 0xe9,
-	0x25, 0xb8, 0x5e, 0x33, 0x13, 0x4b, 0x42, 0x3d, 0x44, 0xb1, 0xcf, 0xe6, 0xac, 0x87, 0x28, 0xf6,
-	0x79, 0x7b, 0x8e, 0xbc, 0xf5, 0x63, 0x15, 0x85, 0x39, 0xa1, 0x62, 0xfd, 0x1a, 0xcb, 0x30, 0xb0,
-	0xe9, 0x84, 0x81, 0x57, 0x28, 0x71, 0xae, 0x8d, 0x02, 0xf7, 0x71, 0x8a, 0x8b, 0x44, 0x81, 0x4c,
-	0xc8, 0x9d, 0x55, 0x7e, 0xff, 0x28, 0x19, 0x9f, 0xdf, 0x7d, 0x0d, 0x84, 0x4d, 0xca, 0x03, 0xfc,
-	0x6b, 0x83, 0x46, 0x55, 0x35, 0x8b, 0xd9, 0xab, 0xda, 0x80, 0xf9, 0xf4, 0x44, 0x66, 0x59, 0x14,
-	0x6a, 0xdb, 0x6d, 0x60, 0xf1, 0x61, 0xe5, 0xd7, 0x41, 0x3f, 0xb1, 0x6a, 0x5d, 0xf6, 0xd0, 0x2f,
-	0xfa, 0x7d, 0x8b, 0x92, 0xa8, 0x9e, 0xa2, 0x1a, 0x57, 0x17, 0xb3, 0x57, 0xe4, 0xfd, 0x5c, 0xc7,
-	0xd5, 0x45, 0x6e, 0xbd, 0x4c, 0x98, 0x1f, 0x33, 0xae, 0xf2, 0xd4, 0xdf, 0x90, 0x1a, 0x02, 0x4c,
-	0xcd, 0xf7, 0x51, 0x55, 0xd9, 0x52, 0xbf, 0x0d, 0x8b, 0x0a, 0x2c, 0xc3, 0xc8, 0xa3, 0xd3, 0xb1,
-	0xcc, 0xac, 0xe1, 0x7a, 0xbe, 0x8d, 0xf2, 0x8e, 0xec, 0x50, 0xf0, 0x02, 0x9a, 0x75, 0xfe, 0xcf,
-	0x22, 0xcf, 0x4a, 0x41, 0xec, 0x80, 0xac, 0xa2, 0x81, 0xdf, 0xc2, 0xea, 0xc3, 0x87, 0x5f, 0xfb,
-	0x32, 0x8f, 0xbe, 0x95, 0x2f, 0x24, 0x65, 0x7c, 0x1a, 0x85, 0x1c, 0x5c, 0x74, 0x7c, 0x05, 0xd0,
-	0xcd, 0x01, 0xbd, 0xb3, 0xe3, 0x1f, 0x83, 0x31, 0x84, 0x1b, 0x68, 0xcd, 0xad, 0x18, 0xba, 0xfb,
-	0x3f, 0xeb, 0xd0, 0xdb, 0x9f, 0x3c, 0x89, 0xa3, 0xc1, 0xd6, 0xfe, 0xae, 0xb8, 0x47, 0xbf, 0x6c,
-	0xa2, 0x82, 0xf4, 0xd5, 0xea, 0x53, 0x25, 0x62, 0x76, 0x63, 0x7d, 0xea, 0x05, 0x93, 0x5a, 0xd8,
-	0x25, 0xf1, 0x31, 0xfd, 0x32, 0x4c, 0x45, 0xf7, 0xe2, 0x5a, 0x49, 0xe6, 0xe4, 0x16, 0x1b, 0xfd,
-	0xe9, 0x06, 0x33, 0xc2, 0xbd, 0xf2, 0x77, 0x55, 0x57, 0x2b, 0x4f, 0xd4, 0xa6, 0x67, 0xb7, 0xeb,
-	0x32, 0x66, 0x76, 0x15, 0x79, 0xd8, 0xb3, 0x3b, 0x61, 0x92, 0x3d, 0x7b, 0x25, 0x52, 0xbe, 0x24,
-	0x3e, 0xd2, 0x3f, 0xe2, 0xc9, 0x0a, 0xb1, 0xee, 0xe8, 0xa1, 0xc9, 0x38, 0x36, 0xae, 0x4d, 0xe1,
-	0x2b, 0xcc, 0xa3, 0xbd, 0xb3, 0x99, 0xb7, 0xec, 0xe4, 0xc6, 0x7a, 0x15, 0x5d, 0x61, 0x9e, 0x6f,
-	0x53, 0xed, 0x39, 0x6c, 0x35, 0xb5, 0x99, 0xaf, 0x68, 0x95, 0x66, 0x9e, 0x0c, 0x96, 0xcd, 0xbc,
-	0x6d, 0xea, 0x6c, 0xe6, 0x5d, 0xcb, 0x76, 0x49, 0x6c, 0x03, 0x94, 0x06, 0x4b, 0x58, 0x13, 0xb9,
-	0xe6, 0x6e, 0xe3, 0x7a, 0x4d, 0x8b, 0x19, 0xe4, 0x43, 0x98, 0x53, 0x65, 0x02, 0xa1, 0x33, 0x45,
-	0xa7, 0x18, 0xb1, 0x71, 0xb5, 0x82, 0xd5, 0x1d, 0x6f, 0x37, 0xde, 0x6e, 0x88, 0x07, 0xd6, 0x6f,
-	0xa9, 0x49, 0xff, 0x5e, 0xaa, 0x7f, 0x0b, 0xa6, 0x86, 0xba, 0x71, 0xc6, 0x43, 0x31, 0xcd, 0xca,
-	0x83, 0xea, 0x2f, 0xb3, 0x5f, 0xaa, 0x7d, 0xc8, 0x75, 0xd6, 0x68, 0xd3, 0xba, 0x65, 0x9e, 0x2d,
-	0x99, 0xed, 0xa9, 0x3e, 0x93, 0x32, 0xdb, 0x33, 0xf5, 0xc2, 0xc9, 0xbb, 0x24, 0xde, 0x87, 0x39,
-	0xf5, 0xdc, 0xca, 0x88, 0xc6, 0x79, 0xdf, 0x65, 0x44, 0xe3, 0x3e, 0xdd, 0xa2, 0x8d, 0x59, 0x3c,
-	0x90, 0x85, 0xb1, 0xbb, 0xb6, 0x72, 0x38, 0xc6, 0xde, 0x56, 0x8e, 0x8a, 0x89, 0xb6, 0x34, 0xbb,
-	0xc8, 0x2b, 0x9a, 0x6d, 0xac, 0x76, 0x45, 0xb3, 0x0b, 0xbb, 0xfb, 0xe7, 0xf6, 0xd6, 0xa4, 0xc3,
-	0xbc, 0x66, 0x6b, 0xca, 0xca, 0x72, 0xcd, 0xd6, 0x58, 0xe5, 0x5a, 0xef, 0xd2, 0xdb, 0x0d, 0xe1,
-	0x5b, 0x8f, 0x7e, 0xd9, 0x5c, 0xfc, 0xa8, 0xda, 0xc9, 0x35, 0x1a, 0x2f, 0x9f, 0xd5, 0x6c, 0x78,
-	0xfc, 0x02, 0x96, 0xdd, 0x3c, 0x5f, 0xdc, 0xa8, 0xf9, 0xb9, 0x67, 0x79, 0x90, 0x7f, 0x74, 0x46,
-	0xab, 0x19, 0xd0, 0x66, 0x52, 0x25, 0xeb, 0xd3, 0x4c, 0x3a, 0x65, 0x83, 0x69, 0x26, 0x2b, 0x39,
-	0xbe, 0x3b, 0x26, 0x1f, 0xf6, 0x69, 0x3e, 0x9c, 0x23, 0xff, 0xf2, 0x59, 0xcd, 0xb5, 0x9a, 0x4e,
-	0xc6, 0xe7, 0xa5, 0xe9, 0x95, 0x95, 0x26, 0xe8, 0x46, 0x7d, 0xe3, 0x19, 0xab, 0x26, 0x5b, 0x5a,
-	0xb3, 0x6a, 0xdb, 0xa2, 0xbe, 0x7c, 0x56, 0xb3, 0x6d, 0x5b, 0xca, 0x9a, 0xaa, 0xb1, 0x2d, 0x53,
-	0x95, 0x5c, 0x63, 0x5b, 0xa6, 0x0b, 0xb0, 0xde, 0x25, 0xb1, 0x03, 0x3d, 0x53, 0x06, 0x35, 0x87,
-	0xa0, 0x5a, 0x7c, 0xdd, 0xe8, 0x4f, 0x37, 0x38, 0x46, 0x86, 0x59, 0x61, 0xd9, 0x3b, 0xd4, 0x8e,
-	0xd8, 0xaf, 0xd7, 0xb4, 0x58, 0x86, 0x7e, 0x4e, 0x95, 0xdf, 0xcc, 0x59, 0x76, 0xaa, 0x71, 0x1b,
-	0xb5, 0x58, 0x66, 0xe0, 0x1d, 0x68, 0xd3, 0xaf, 0x47, 0x84, 0xf5, 0x9f, 0x57, 0xe8, 0x49, 0xaf,
-	0x38, 0x38, 0xdb, 0xf8, 0x18, 0xaf, 0x6d, 0x56, 0x5e, 0x8d, 0x21, 0xcc, 0xca, 0xa7, 0x1c, 0xbc,
-	0x77, 0x49, 0x7c, 0x0a, 0x0b, 0x56, 0x02, 0x29, 0xf4, 0xe2, 0xa6, 0x93, 0xca, 0x8d, 0x8d, 0xba,
-	0x26, 0x7b, 0x23, 0xcb, 0x0c, 0xd0, 0x48, 0x6f, 0x2a, 0xdf, 0xdc, 0xb8, 0x5e, 0xd3, 0x62, 0x31,
-	0xb3, 0x54, 0x66, 0x75, 0xd2, 0x52, 0x88, 0xa9, 0x34, 0xb2, 0x3a, 0x8e, 0x9d, 0x05, 0x92, 0xde,
-	0x3b, 0x99, 0x9a, 0xd1, 0xfb, 0xba, 0xec, 0xd0, 0xe8, 0x7d, 0x7d, 0x72, 0x47, 0x7a, 0x5f, 0x49,
-	0xd7, 0x8c, 0xde, 0xd7, 0xa7, 0x7d, 0x46, 0xef, 0xcf, 0xc8, 0xf2, 0xbc, 0x4b, 0xe2, 0x11, 0x26,
-	0x4a, 0xa6, 0x11, 0x45, 0xf6, 0xca, 0x74, 0x1f, 0x27, 0x8d, 0xdb, 0xb8, 0x79, 0x36, 0xc1, 0x19,
-	0xc3, 0xee, 0xc8, 0xf8, 0xc5, 0x0c, 0xfb, 0x09, 0xfb, 0xb8, 0xfd, 0x49, 0x1c, 0xbb, 0x3e, 0xce,
-	0x2a, 0xbf, 0xb9, 0x3e, 0xce, 0x2e, 0x9a, 0x91, 0x61, 0x2f, 0xc7, 0xc8, 0x8f, 0xaa, 0x63, 0x98,
-	0xb2, 0x59, 0x75, 0x8c, 0xb2, 0xdc, 0x45, 0x63, 0x7c, 0xca, 0x65, 0x28, 0xb6, 0x3e, 0xd7, 0x6d,
-	0x62, 0xd7, 0xf2, 0x6c, 0xd4, 0x35, 0x99, 0xf5, 0xbc, 0x03, 0x6d, 0xcc, 0x0f, 0xcc, 0x49, 0xb3,
-	0x72, 0x07, 0x73, 0xd2, 0xec, 0x04, 0x42, 0x75, 0xa1, 0x58, 0xc1, 0xd4, 0xa7, 0xac, 0x10, 0xe1,
-	0x8a, 0x83, 0xb3, 0x83, 0x3e, 0xfd, 0xfb, 0x7d, 0xe3, 0xc2, 0x9d, 0x8a, 0x92, 0x09, 0xfa, 0x2a,
-	0x65, 0x25, 0xef, 0xd2, 0x93, 0x39, 0x7a, 0x13, 0xf2, 0xd3, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x15, 0x71, 0xe5, 0x93, 0x74, 0x49, 0x00, 0x00,
+	// 7451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7c, 0x4b, 0x6c, 0x25, 0x47,
+	0x92, 0x98, 0xeb, 0xbd, 0x47, 0xf2, 0xbd, 0xe0, 0xbf, 0xba, 0x49, 0x56, 0x3f, 0x51, 0x3d, 0x3d,
+	0x35, 0xd6, 0xa8, 0xd5, 0x1a, 0x51, 0x52, 0x8f, 0x3c, 0xfa, 0xcf, 0x88, 0x4d, 0xb6, 0x24, 0x7a,
+	0xd4, 0x12, 0x55, 0xec, 0x6e, 0x41, 0xf0, 0x00, 0xe3, 0xea, 0x57, 0x49, 0xbe, 0x52, 0xd7, 0xab,
+	0xaa, 0xa9, 0xaa, 0xc7, 0x6e, 0x0e, 0x7c, 0x36, 0xc6, 0x1e, 0x18, 0x3e, 0xd8, 0xf0, 0x07, 0xb0,
+	0x61, 0x60, 0x0c, 0x18, 0x86, 0x2f, 0x5e, 0x60, 0xf7, 0xb2, 0x8b, 0xb9, 0xcc, 0x65, 0x4f, 0x7b,
+	0xdb, 0x3d, 0xed, 0x5e, 0xf7, 0xb2, 0x3b, 0x97, 0xbd, 0x2f, 0xb0, 0x58, 0x44, 0x7e, 0x23, 0xab,
+	0xea, 0x91, 0x6c, 0x49, 0x7b, 0x20, 0x58, 0x11, 0x19, 0x19, 0x19, 0x19, 0x99, 0x19, 0x19, 0x19,
+	0x91, 0xf9, 0x60, 0xb1, 0x3a, 0xcb, 0x59, 0xb9, 0x93, 0x17, 0x59, 0x95, 0xb9, 0x73, 0x1c, 0xf0,
+	0xff, 0xbb, 0x03, 0xcb, 0x7b, 0x59, 0x5a, 0x85, 0x71, 0xca, 0x8a, 0xc3, 0xac, 0xa8, 0x5c, 0x17,
+	0x7a, 0x69, 0x38, 0x61, 0x9e, 0x73, 0xc3, 0xb9, 0x39, 0x08, 0xf8, 0xb7, 0x3b, 0x84, 0xfe, 0x38,
+	0x2b, 0x2b, 0x2c, 0xf7, 0x3a, 0x37, 0x9c, 0x9b, 0x73, 0x81, 0x86, 0xdd, 0x7f, 0x0e, 0xcb, 0x23,
+	0xca, 0xc0, 0xeb, 0x72, 0x02, 0x1b, 0x89, 0x1c, 0x78, 0xbb, 0xa3, 0x2c, 0xf1, 0x7a, 0x9c, 0xb3,
+	0x86, 0xdd, 0x4d, 0x98, 0x47, 0x6e, 0x07, 0x87, 0xde, 0x1c, 0x2f, 0x91, 0x90, 0xff, 0x16, 0xac,
+	0xdc, 0x4d, 0x4f, 0xe3, 0x22, 0x4b, 0x27, 0x2c, 0xad, 0x1e, 0x86, 0x85, 0xbb, 0x06, 0x5d, 0x96,
+	0x9e, 0x4a, 0xd1, 0xf0, 0xd3, 0xbd, 0x0a, 0x73, 0xa7, 0x61, 0x32, 0x65, 0x5c, 0xac, 0x41, 0x20,
+	0x00, 0xff, 0x5f, 0xc1, 0xe2, 0xc3, 0x2c, 0x99, 0x4e, 0xd8, 0xbd, 0x6c, 0x9a, 0xb6, 0x77, 0x69,
+	0x1b, 0x06, 0x13, 0x2c, 0x3c, 0x0c, 0xab, 0xb1, 0xac, 0x6c, 0x10, 0x28, 0x6e, 0xc1, 0xc2, 0xe8,
+	0xb3, 0x34, 0x39, 0xe3, 0xfd, 0xe9, 0x07, 0x1a, 0xf6, 0x5f, 0x84, 0xe5, 0x2f, 0xc2, 0xb8, 0x8a,
+	0xd3, 0x93, 0xa3, 0x2a, 0xac, 0xa6, 0x25, 0xca, 0x5f, 0xb0, 0xb0, 0xcc, 0x52, 0xd9, 0x80, 0x84,
+	0xfc, 0x57, 0x60, 0x39, 0x98, 0xa6, 0xa9, 0x21, 0xdc, 0x86, 0x41, 0x59, 0x85, 0x45, 0xc5, 0xa2,
+	0xdd, 0x4a, 0xd2, 0x1a, 0x84, 0xff, 0xdf, 0x1c, 0x80, 0xfb, 0xac, 0x98, 0x48, 0xe2, 0x21, 0xf4,
+	0xd9, 0xd3, 0xb8, 0xda, 0xcb, 0x22, 0x21, 0xf8, 0x5c, 0xa0, 0x61, 0xd2, 0x62, 0x87, 0xb6, 0xe8,
+	0x7a, 0xb0, 0x30, 0x61, 0x65, 0x19, 0x9e, 0x30, 0x2e, 0xf5, 0x20, 0x50, 0xa0, 0xdd, 0x74, 0xaf,
+	0xd6, 0xb4, 0x7b, 0x1d, 0xe0, 0x38, 0x4e, 0xe3, 0x72, 0xcc, 0x8b, 0xc5, 0x28, 0x10, 0x8c, 0xff,
+	0xbf, 0x3a, 0xb0, 0xaa, 0x67, 0x89, 0x94, 0xaf, 0x4d, 0xa9, 0x37, 0x60, 0x51, 0x0f, 0xfb, 0xc1,
+	0xbe, 0x14, 0x8e, 0xa2, 0x70, 0xbc, 0xf2, 0x71, 0x58, 0x2a, 0xf9, 0x04, 0xe0, 0xee, 0xc0, 0xc2,
+	0x13, 0xa1, 0x52, 0x2e, 0xdb, 0xe2, 0xed, 0xab, 0x3b, 0x62, 0xae, 0x5a, 0x8a, 0x0e, 0x14, 0x11,
+	0xd2, 0x17, 0x42, 0xb3, 0xde, 0x9c, 0x45, 0x6f, 0xe9, 0x3b, 0x50, 0x44, 0xee, 0xeb, 0x00, 0x15,
+	0x2b, 0x26, 0x71, 0x1a, 0x56, 0x2c, 0xf2, 0xe6, 0x79, 0x95, 0x75, 0x59, 0xc5, 0xa8, 0x3c, 0x20,
+	0x44, 0xee, 0x2b, 0x30, 0xcf, 0x4e, 0x59, 0x5a, 0x95, 0xde, 0xc2, 0x8d, 0xee, 0xcd, 0xc5, 0xdb,
+	0x1b, 0x92, 0x5c, 0xab, 0xe1, 0x2e, 0x96, 0x06, 0x92, 0xc8, 0x7f, 0x08, 0x2b, 0x76, 0x09, 0xea,
+	0xa7, 0x8a, 0x8d, 0x7e, 0xf0, 0xfb, 0xd9, 0xc7, 0xcd, 0xff, 0xdf, 0x74, 0x7d, 0x1e, 0xa4, 0xc7,
+	0x99, 0xbb, 0x03, 0x03, 0xad, 0x50, 0xce, 0x7c, 0xf1, 0xf6, 0x5a, 0x5d, 0xb6, 0xc0, 0x90, 0xe0,
+	0xc8, 0x8f, 0x0a, 0x16, 0x8a, 0x91, 0xc7, 0x66, 0xbb, 0x81, 0x41, 0xf0, 0xf1, 0xc8, 0xa2, 0x83,
+	0x7d, 0x3d, 0x1e, 0x08, 0xb8, 0x3b, 0x30, 0x5f, 0x72, 0x95, 0xc8, 0xe1, 0xd8, 0xac, 0x37, 0x20,
+	0x15, 0x26, 0xa9, 0xfc, 0xff, 0xd8, 0x83, 0x81, 0x2e, 0xfb, 0xfa, 0x33, 0x23, 0x9e, 0x18, 0x0d,
+	0x08, 0x00, 0x35, 0xc3, 0x3f, 0x0e, 0xf6, 0xe5, 0xac, 0x55, 0xa0, 0x7b, 0x13, 0x56, 0xf9, 0xe7,
+	0xe1, 0x34, 0x49, 0x0e, 0xb3, 0x24, 0x1e, 0x9d, 0xc9, 0x89, 0x5b, 0x47, 0xe3, 0xec, 0x7e, 0x92,
+	0x15, 0x8f, 0xe3, 0xf4, 0x64, 0x3f, 0x2e, 0xf8, 0xe8, 0x0f, 0x02, 0x82, 0x41, 0x79, 0xa7, 0x25,
+	0x2b, 0xbc, 0x05, 0x21, 0x2f, 0x7e, 0xa3, 0xa5, 0xa9, 0xaa, 0x33, 0xaf, 0xcf, 0xd7, 0x3e, 0x7e,
+	0xe2, 0x7a, 0x1c, 0x65, 0x93, 0x49, 0x98, 0x46, 0xa5, 0x37, 0xb8, 0xd1, 0x45, 0x0b, 0xa6, 0x60,
+	0xe4, 0x10, 0x16, 0x27, 0xa5, 0x07, 0x1c, 0xcf, 0xbf, 0xdd, 0x5b, 0xa8, 0xd9, 0xa2, 0x2a, 0xbd,
+	0xc5, 0x1b, 0x5d, 0x32, 0x43, 0x2d, 0x63, 0x1b, 0x08, 0x12, 0xf7, 0x45, 0x61, 0xd7, 0x96, 0xac,
+	0x99, 0x66, 0xdb, 0x3e, 0x61, 0xee, 0x7e, 0x04, 0x4b, 0xa7, 0xc6, 0xb0, 0x95, 0xde, 0x32, 0xaf,
+	0xe1, 0xca, 0x1a, 0xc4, 0xe6, 0x05, 0x16, 0x9d, 0xfb, 0x06, 0xcc, 0x27, 0xe1, 0x23, 0x96, 0x94,
+	0xde, 0x0a, 0xaf, 0xb1, 0x5d, 0x97, 0x66, 0xe7, 0x13, 0x5e, 0x7c, 0x37, 0xad, 0x8a, 0xb3, 0x40,
+	0xd2, 0x0e, 0xdf, 0x86, 0x45, 0x82, 0x46, 0x9d, 0x3c, 0x66, 0x67, 0xca, 0xfa, 0x3e, 0x66, 0x67,
+	0xed, 0xd6, 0xf7, 0x9d, 0xce, 0x5b, 0x8e, 0xff, 0xc7, 0x0e, 0xac, 0x06, 0x77, 0xf6, 0x85, 0x44,
+	0x47, 0xd9, 0xb4, 0x18, 0xf1, 0x5d, 0x64, 0x92, 0xa5, 0x71, 0x95, 0x15, 0xa5, 0xe7, 0x08, 0x0d,
+	0x2a, 0xd8, 0x8c, 0x7e, 0x87, 0x8e, 0xfe, 0x26, 0xcc, 0x1f, 0x97, 0xf7, 0xcf, 0x72, 0x35, 0x29,
+	0x24, 0x84, 0xfa, 0xce, 0x33, 0xbd, 0x93, 0xf0, 0x6f, 0x3d, 0x8a, 0x73, 0x64, 0x14, 0x3d, 0x58,
+	0x78, 0xcc, 0xce, 0x0a, 0xb4, 0x13, 0x62, 0xd8, 0x15, 0x68, 0x19, 0xf8, 0x85, 0x9a, 0x81, 0x3f,
+	0x83, 0xc1, 0x61, 0x16, 0x09, 0xd1, 0x5b, 0x27, 0xf3, 0x26, 0xcc, 0x97, 0xbc, 0x4b, 0x6a, 0x19,
+	0x0b, 0x08, 0xf1, 0x51, 0x11, 0x9f, 0xb2, 0x42, 0x89, 0x2b, 0x20, 0xf7, 0x26, 0x74, 0x8b, 0x47,
+	0x51, 0x6d, 0x2d, 0xd5, 0xb4, 0x13, 0x20, 0x89, 0xff, 0xdb, 0x0e, 0x2c, 0x1c, 0x66, 0xd1, 0x51,
+	0xce, 0x46, 0xee, 0x2d, 0x58, 0x10, 0x63, 0x28, 0xb4, 0x65, 0x96, 0xb9, 0x16, 0x2e, 0x50, 0x04,
+	0xee, 0x6b, 0x00, 0x7a, 0x2d, 0x95, 0x5e, 0xc7, 0x22, 0x37, 0x56, 0x81, 0xd0, 0xb8, 0xb7, 0xf5,
+	0x8c, 0xe8, 0x72, 0xea, 0xa1, 0x61, 0x8e, 0xad, 0xb7, 0xcd, 0x07, 0xd4, 0xc5, 0xe9, 0x28, 0x9f,
+	0xf2, 0x8e, 0xcc, 0x05, 0xfc, 0x1b, 0xfb, 0x3c, 0x61, 0x93, 0xac, 0x10, 0xab, 0x6f, 0x2e, 0x90,
+	0x90, 0xfb, 0x16, 0xac, 0xc4, 0x29, 0x6e, 0x57, 0x5a, 0xaa, 0xf9, 0x19, 0x52, 0xd5, 0xe8, 0xbe,
+	0xd1, 0xac, 0xeb, 0xf2, 0xa1, 0x93, 0x3b, 0x94, 0xde, 0x6b, 0x1c, 0xba, 0xd7, 0x10, 0x5b, 0xdb,
+	0xb1, 0xf7, 0x48, 0x63, 0x9d, 0xbb, 0x96, 0x75, 0x36, 0xfe, 0x49, 0x8f, 0xfa, 0x27, 0xca, 0x76,
+	0xa2, 0xdb, 0xd2, 0x55, 0xb6, 0xf3, 0x50, 0xef, 0xb4, 0xf7, 0xd1, 0xf8, 0xcf, 0x93, 0x9d, 0x16,
+	0x11, 0xee, 0x07, 0xb0, 0x3a, 0xb2, 0x8d, 0xa8, 0xdc, 0x5f, 0x66, 0x99, 0xd8, 0x3a, 0xb9, 0xd9,
+	0xab, 0x79, 0x03, 0x7d, 0xba, 0x57, 0xf3, 0x16, 0xde, 0x87, 0x81, 0xf2, 0x13, 0x84, 0xa1, 0x5a,
+	0xbc, 0xfd, 0x1d, 0x32, 0xb6, 0x9c, 0xc9, 0xce, 0x5d, 0x45, 0x21, 0x06, 0xd8, 0xd4, 0x70, 0xdf,
+	0x06, 0x88, 0xd3, 0x8a, 0x15, 0xc7, 0xe1, 0x88, 0x09, 0x83, 0xb6, 0x78, 0xfb, 0x9a, 0xa9, 0x7f,
+	0xa0, 0xca, 0xd4, 0x96, 0x69, 0x88, 0x87, 0xef, 0xc1, 0x8a, 0xcd, 0xf7, 0xa2, 0xb1, 0x9b, 0xa3,
+	0x63, 0xf7, 0x1f, 0x1c, 0x70, 0x9b, 0x0d, 0xb8, 0x2b, 0xd0, 0x89, 0x23, 0xc9, 0xa1, 0x13, 0x47,
+	0x1c, 0xce, 0xe5, 0xc8, 0x75, 0xe2, 0x1c, 0xd5, 0x81, 0xc3, 0xb1, 0xcf, 0x4e, 0xe3, 0x91, 0x32,
+	0x13, 0x04, 0xe3, 0xbe, 0x09, 0x83, 0x47, 0x61, 0x1a, 0x3d, 0x89, 0xa3, 0x6a, 0x2c, 0x57, 0xa0,
+	0xea, 0x8e, 0x6e, 0xea, 0x8e, 0x22, 0x08, 0x0c, 0xad, 0xff, 0x37, 0x0e, 0x5f, 0x8a, 0x7c, 0xcf,
+	0xd5, 0xbb, 0xa4, 0x43, 0x77, 0x49, 0x17, 0x7a, 0x8f, 0xe3, 0x34, 0x92, 0xc2, 0xf0, 0x6f, 0x14,
+	0x27, 0xcc, 0xe3, 0x87, 0xac, 0x28, 0x63, 0x3d, 0x8f, 0x08, 0x06, 0xc5, 0x3f, 0x9d, 0xc8, 0x79,
+	0xd4, 0x39, 0x9d, 0xd8, 0xbb, 0xf3, 0x5c, 0x7d, 0x77, 0xf6, 0xa1, 0x57, 0xe6, 0x6c, 0x24, 0x3d,
+	0x96, 0x15, 0x7b, 0x89, 0x06, 0xbc, 0xcc, 0xbd, 0xa9, 0xf7, 0xea, 0x05, 0xcb, 0x19, 0xd0, 0x83,
+	0xad, 0x76, 0x69, 0x9c, 0xf9, 0x79, 0x16, 0x7d, 0x1a, 0xea, 0x69, 0xa3, 0x40, 0xff, 0x37, 0x1d,
+	0x18, 0x1c, 0xf0, 0x7d, 0x15, 0x7b, 0x5b, 0x57, 0x39, 0xfa, 0xee, 0x61, 0xc1, 0xd2, 0x4a, 0x6f,
+	0xdc, 0x1a, 0x16, 0x76, 0x34, 0xcf, 0xee, 0x87, 0x27, 0xc2, 0x90, 0x0c, 0x02, 0x0d, 0xe3, 0x9e,
+	0x8f, 0xdf, 0xfb, 0xf1, 0x09, 0x2b, 0x2b, 0x74, 0x25, 0xb0, 0x98, 0xa2, 0x50, 0x22, 0xd9, 0x59,
+	0xd9, 0x77, 0x05, 0x62, 0xdd, 0xd3, 0xb8, 0xa8, 0xa6, 0x61, 0x72, 0x14, 0xff, 0x52, 0xac, 0xa3,
+	0x6e, 0x40, 0x51, 0x64, 0x4b, 0x5b, 0xb0, 0xb6, 0x34, 0xdd, 0x8f, 0x6f, 0x7b, 0x4b, 0xfb, 0x5d,
+	0x07, 0xfa, 0x52, 0xa9, 0xa5, 0xfb, 0x5d, 0xe8, 0xa2, 0x25, 0x14, 0xfe, 0xd7, 0xaa, 0x5a, 0xbb,
+	0xf9, 0x94, 0x97, 0x06, 0x58, 0xe6, 0xbe, 0x08, 0x73, 0x8f, 0x92, 0x6c, 0xf4, 0xd8, 0xeb, 0x58,
+	0xfe, 0xe6, 0x9d, 0xe4, 0x71, 0x9c, 0x09, 0x32, 0x51, 0xee, 0xde, 0xd2, 0x26, 0xb4, 0x7b, 0xc3,
+	0x21, 0xdb, 0xf9, 0x3d, 0x8e, 0x14, 0xa4, 0x92, 0xc2, 0x7d, 0x05, 0x16, 0x52, 0x56, 0xa1, 0xf3,
+	0x22, 0x27, 0xf3, 0x15, 0x49, 0xfc, 0xa9, 0xc0, 0x0a, 0x6a, 0x45, 0xe3, 0xee, 0xa0, 0xb1, 0x48,
+	0x58, 0x79, 0x56, 0x56, 0x6c, 0xc2, 0xed, 0x94, 0x99, 0x46, 0x1f, 0x96, 0x82, 0x98, 0x50, 0xe0,
+	0x74, 0x44, 0x47, 0xb5, 0xac, 0xc2, 0x49, 0x2e, 0x95, 0x6e, 0x10, 0x96, 0xf1, 0x12, 0x95, 0x67,
+	0x19, 0x2f, 0xc9, 0xba, 0x4e, 0xee, 0x1f, 0x41, 0x5f, 0x29, 0xc9, 0x7d, 0x01, 0xe6, 0xa6, 0xdc,
+	0x0c, 0x37, 0x94, 0xf8, 0x00, 0xd1, 0x81, 0x28, 0xc5, 0x99, 0xf0, 0x49, 0x16, 0x46, 0xbb, 0xa7,
+	0xac, 0x50, 0x36, 0x7b, 0x2e, 0xa0, 0x28, 0x3f, 0x82, 0xbe, 0xaa, 0x84, 0xc3, 0x57, 0x65, 0x55,
+	0x98, 0x70, 0xa6, 0xbd, 0x40, 0x00, 0x68, 0xc1, 0x73, 0x56, 0xec, 0xe5, 0x53, 0xbe, 0x35, 0xf6,
+	0x02, 0x09, 0x69, 0x9f, 0xa1, 0xcb, 0x89, 0xf9, 0x37, 0xd2, 0x4a, 0x75, 0xf5, 0x38, 0x56, 0x42,
+	0xfe, 0x9f, 0xf5, 0x00, 0xcc, 0xd8, 0xb9, 0x9f, 0xc1, 0x56, 0x9c, 0x1d, 0xb1, 0x02, 0x8d, 0xcc,
+	0x9d, 0xb3, 0x8a, 0x95, 0x01, 0x1b, 0x4d, 0x8b, 0x32, 0x3e, 0x65, 0x9e, 0x63, 0xb9, 0x71, 0xba,
+	0x8e, 0x98, 0x88, 0xb3, 0x6a, 0xb9, 0x1f, 0xc1, 0x15, 0x5d, 0x14, 0x19, 0x66, 0x9d, 0xf3, 0x98,
+	0xb5, 0xd5, 0x70, 0xf7, 0x60, 0x3d, 0xce, 0x3e, 0x9f, 0xb2, 0x29, 0x65, 0xd3, 0x3d, 0x8f, 0x4d,
+	0x93, 0xde, 0xbd, 0x07, 0x9b, 0x9a, 0x37, 0x6e, 0x2b, 0x86, 0x53, 0xef, 0x3c, 0x4e, 0x33, 0x2a,
+	0x89, 0xce, 0xe1, 0x61, 0xce, 0xe6, 0x35, 0x77, 0x41, 0xe7, 0x1a, 0x35, 0x44, 0xe7, 0xee, 0xb1,
+	0xe2, 0x84, 0x76, 0x6e, 0xfe, 0x82, 0xce, 0xd5, 0xe8, 0xdd, 0x9f, 0xc0, 0x6a, 0x9c, 0xd9, 0x92,
+	0x2c, 0x9c, 0xc7, 0xa2, 0x4e, 0xed, 0xee, 0xc2, 0x5a, 0xc9, 0x46, 0x55, 0x56, 0x90, 0x51, 0xef,
+	0x9f, 0xc7, 0xa1, 0x41, 0xee, 0xff, 0xad, 0x03, 0x2b, 0x36, 0x51, 0xab, 0xab, 0x89, 0xa7, 0xc8,
+	0xb3, 0x5c, 0x4c, 0x7b, 0x3c, 0x45, 0xa2, 0xf7, 0x6b, 0xdc, 0xcf, 0xae, 0xe5, 0x7e, 0x5e, 0x85,
+	0xb9, 0x49, 0xf8, 0x55, 0x56, 0xc8, 0x89, 0x2b, 0x00, 0x8e, 0x8d, 0xd3, 0x4c, 0x38, 0xc6, 0xbd,
+	0x40, 0x00, 0xee, 0x0f, 0xa1, 0x87, 0xbb, 0x82, 0x37, 0x6f, 0x39, 0x08, 0xb6, 0x40, 0x3b, 0x46,
+	0x7e, 0x4e, 0x3c, 0x7c, 0x13, 0x06, 0x46, 0xda, 0x0b, 0x4c, 0x67, 0x8f, 0x9a, 0xce, 0xdf, 0x3b,
+	0xb0, 0x48, 0xac, 0x19, 0x52, 0x9a, 0xa5, 0xdf, 0x53, 0x2b, 0xdd, 0x9c, 0xd3, 0x8e, 0x58, 0x25,
+	0x99, 0x10, 0x0c, 0xee, 0x16, 0xc7, 0x61, 0x9c, 0x8c, 0xd2, 0x4a, 0x2e, 0x58, 0x05, 0xba, 0x77,
+	0x48, 0x0c, 0x6a, 0x3f, 0xac, 0x42, 0x69, 0x1b, 0xb7, 0x9b, 0x86, 0x54, 0x7c, 0x22, 0x4d, 0x60,
+	0x57, 0x71, 0x3f, 0x86, 0xb5, 0x71, 0xcc, 0x8a, 0xb0, 0x18, 0x8d, 0xe3, 0x51, 0x98, 0x70, 0x36,
+	0x73, 0x97, 0x60, 0xd3, 0xa8, 0xe5, 0x7f, 0x0e, 0x1b, 0xad, 0xa4, 0x7c, 0x03, 0x3e, 0x39, 0x0e,
+	0xa7, 0x49, 0x25, 0x3b, 0xae, 0x40, 0xec, 0x7a, 0x7e, 0x32, 0x09, 0xbf, 0x12, 0x85, 0xb2, 0xeb,
+	0x06, 0xe3, 0xff, 0xda, 0x81, 0x25, 0x6a, 0xe1, 0xdd, 0x7f, 0x61, 0xb9, 0x69, 0xb6, 0xc5, 0xb1,
+	0x5c, 0x28, 0xcb, 0x45, 0x73, 0x6f, 0x40, 0xb7, 0x1a, 0xe5, 0x72, 0x47, 0x52, 0x1b, 0xc1, 0xfd,
+	0x51, 0x8e, 0x94, 0x01, 0x16, 0xa1, 0xcb, 0x51, 0x8d, 0xf2, 0x1f, 0x79, 0xdd, 0x56, 0x12, 0x5e,
+	0xe6, 0xff, 0x61, 0x07, 0x16, 0x24, 0x06, 0xcd, 0x33, 0xee, 0x0e, 0x8f, 0x12, 0x1e, 0x2b, 0x92,
+	0xfd, 0xa2, 0x28, 0xec, 0x75, 0x79, 0x96, 0x1e, 0xb1, 0x54, 0x75, 0x4c, 0x81, 0xb2, 0x24, 0x60,
+	0xa3, 0x53, 0x35, 0xa0, 0x12, 0x44, 0xb7, 0xe2, 0x38, 0x4e, 0x71, 0xf9, 0xbf, 0x2e, 0x67, 0xb3,
+	0x86, 0x49, 0xd9, 0x6d, 0x39, 0xa7, 0x35, 0x8c, 0x65, 0xb8, 0x5d, 0x21, 0xc0, 0xb7, 0xaf, 0x5e,
+	0xa0, 0x61, 0x9c, 0x74, 0xa3, 0x24, 0x2b, 0x19, 0xf7, 0x93, 0x7a, 0x81, 0x00, 0xb8, 0x03, 0x86,
+	0x1f, 0xbc, 0x4a, 0x9f, 0x97, 0x18, 0x04, 0x4a, 0x98, 0x84, 0x65, 0xb5, 0x3b, 0x7a, 0xec, 0x0d,
+	0x84, 0x84, 0x12, 0xc4, 0x45, 0x98, 0xc4, 0x65, 0xc5, 0x52, 0x0f, 0xc4, 0x36, 0x21, 0x20, 0xac,
+	0x81, 0xd5, 0xf1, 0xc8, 0xb9, 0x28, 0x6a, 0x48, 0xd0, 0xff, 0x55, 0x07, 0x56, 0xec, 0xa1, 0x69,
+	0x5d, 0xf1, 0x1e, 0x2c, 0x14, 0x4f, 0xf9, 0xde, 0xa0, 0xd4, 0x25, 0x41, 0x14, 0xb5, 0x78, 0x7a,
+	0x18, 0x8e, 0x1e, 0xb3, 0xaa, 0x94, 0x0a, 0x33, 0x08, 0xee, 0x89, 0x3d, 0xbd, 0x5b, 0x14, 0x78,
+	0xba, 0x96, 0x2a, 0x53, 0xb0, 0xa8, 0xb9, 0x5f, 0x64, 0x79, 0x2e, 0x3d, 0xad, 0x5e, 0x60, 0x10,
+	0xd8, 0x62, 0x25, 0x5b, 0x14, 0x3a, 0x53, 0x20, 0xd6, 0xab, 0x74, 0x8b, 0x42, 0x6d, 0x83, 0x8a,
+	0xb6, 0x58, 0xa9, 0x16, 0xfb, 0x52, 0xd9, 0xa4, 0xc5, 0x4a, 0xb7, 0x38, 0x50, 0x35, 0x25, 0xc2,
+	0xff, 0x7d, 0x17, 0x16, 0xa4, 0xfb, 0xc1, 0x0f, 0xcd, 0xc2, 0x79, 0x97, 0xd1, 0x53, 0x01, 0xe1,
+	0x70, 0x25, 0xf1, 0x24, 0x56, 0x93, 0x46, 0x00, 0xc6, 0x72, 0x74, 0xa9, 0xe5, 0xd8, 0x86, 0x41,
+	0x78, 0x1a, 0xc6, 0x49, 0xf8, 0x28, 0x61, 0xb2, 0xf3, 0x06, 0xe1, 0x7e, 0x1f, 0x56, 0xf0, 0x6c,
+	0x5f, 0xee, 0x65, 0x93, 0x3c, 0x61, 0x95, 0x56, 0x41, 0x0d, 0x2b, 0xfc, 0xd5, 0x30, 0x2a, 0xc5,
+	0x76, 0x21, 0x75, 0x41, 0x51, 0x48, 0xa1, 0x0d, 0x79, 0x18, 0x49, 0x8d, 0x50, 0x94, 0x8a, 0x2b,
+	0xe8, 0xb3, 0x59, 0x2f, 0xd0, 0x30, 0x46, 0xac, 0x9e, 0x14, 0x71, 0xc5, 0x88, 0x20, 0x42, 0x33,
+	0x75, 0xb4, 0xeb, 0xc3, 0x92, 0x40, 0x49, 0x51, 0xc4, 0x14, 0xb3, 0x70, 0xd8, 0x2b, 0xd9, 0xf0,
+	0x17, 0x45, 0x5c, 0xe1, 0x44, 0x14, 0xf3, 0xad, 0x86, 0x45, 0xdd, 0xf0, 0x7a, 0x5c, 0xa4, 0x25,
+	0xa1, 0x1b, 0x8d, 0xc0, 0x96, 0xe2, 0xec, 0x20, 0x3d, 0x2c, 0xb2, 0x93, 0x82, 0x95, 0x18, 0x50,
+	0xe2, 0x2d, 0x51, 0x1c, 0x8e, 0x90, 0xd8, 0x00, 0xbd, 0x15, 0x31, 0xd5, 0x05, 0x84, 0x12, 0x3c,
+	0x61, 0xf1, 0xc9, 0xb8, 0x62, 0xd1, 0x81, 0x28, 0x5f, 0x15, 0x12, 0xd8, 0x58, 0xff, 0xff, 0xd2,
+	0xe8, 0xb1, 0x1c, 0xf5, 0x5a, 0x3c, 0xd0, 0x69, 0xc6, 0x03, 0xa5, 0x87, 0xdd, 0xb9, 0x8c, 0x87,
+	0xdd, 0xbd, 0xb4, 0x87, 0xdd, 0x7b, 0x16, 0x0f, 0x7b, 0xee, 0x99, 0x3d, 0xec, 0xf9, 0x67, 0xf3,
+	0xb0, 0x17, 0x6a, 0x1e, 0xb6, 0xff, 0x7d, 0x58, 0x91, 0x67, 0xce, 0x80, 0xfd, 0x62, 0xca, 0xca,
+	0xaa, 0xfd, 0xe8, 0xe9, 0xbf, 0x0b, 0xab, 0x9a, 0xae, 0xcc, 0xb3, 0xb4, 0xc4, 0xd9, 0xb5, 0x90,
+	0x0b, 0x94, 0x74, 0xa8, 0x57, 0xe8, 0xa9, 0xfd, 0x38, 0x0b, 0x54, 0xb1, 0xff, 0x0e, 0x6f, 0xe4,
+	0x93, 0xb8, 0xac, 0xce, 0x6d, 0x84, 0x87, 0x7b, 0x26, 0xfa, 0xcc, 0xc7, 0xbf, 0xfd, 0x7f, 0x70,
+	0x60, 0x59, 0x57, 0x2e, 0xa7, 0xc9, 0xac, 0xba, 0xe4, 0xac, 0xd9, 0xb1, 0xce, 0x9a, 0x9a, 0x6b,
+	0xd7, 0x70, 0xe5, 0x1e, 0x8d, 0x89, 0x37, 0x0f, 0xf4, 0x89, 0xf5, 0xfc, 0xd3, 0xf1, 0x5b, 0xfa,
+	0x04, 0x28, 0xd4, 0x7e, 0xc3, 0x74, 0xd8, 0xc8, 0xf7, 0x6d, 0x9f, 0x02, 0x77, 0x61, 0xd5, 0xf0,
+	0x17, 0x9a, 0xdf, 0xe1, 0x7d, 0x45, 0x94, 0xe7, 0x58, 0xb1, 0x5e, 0x4b, 0x90, 0x40, 0x11, 0xf9,
+	0x1f, 0xc0, 0x55, 0xbd, 0x1c, 0xbe, 0xde, 0x28, 0xfc, 0xda, 0x81, 0x2b, 0x35, 0x16, 0x7c, 0x2c,
+	0x2e, 0x5e, 0x55, 0x34, 0x5b, 0x47, 0x46, 0xc7, 0x46, 0xce, 0xc8, 0x0a, 0xcc, 0x18, 0x25, 0xff,
+	0x4b, 0xd8, 0xa8, 0x0b, 0x23, 0x14, 0xf3, 0x01, 0x69, 0x8c, 0xa8, 0x67, 0x58, 0x3f, 0x2d, 0x12,
+	0x25, 0xd9, 0x15, 0xfc, 0x37, 0x88, 0xaa, 0xe8, 0xaa, 0xd8, 0xae, 0x27, 0x41, 0x06, 0x24, 0xe5,
+	0xe1, 0x1f, 0xc1, 0x46, 0xad, 0x96, 0x14, 0xe8, 0x1d, 0x22, 0x10, 0x59, 0x29, 0x8d, 0xd8, 0x3c,
+	0xaf, 0x64, 0x93, 0xfa, 0x87, 0xb0, 0xf4, 0xf0, 0x1e, 0xd1, 0xb5, 0x1a, 0x17, 0x87, 0xcc, 0x63,
+	0xad, 0xb7, 0x4e, 0xbb, 0xde, 0xba, 0x96, 0xde, 0xde, 0x86, 0x65, 0xc5, 0xf1, 0x59, 0x27, 0xc0,
+	0xfb, 0xb0, 0xa2, 0x85, 0x11, 0x5d, 0x7b, 0x19, 0xe6, 0x4f, 0x27, 0x44, 0xc9, 0xca, 0x6a, 0x51,
+	0x99, 0x03, 0x49, 0xe2, 0xff, 0x0c, 0xd6, 0x78, 0x98, 0x84, 0x36, 0xce, 0xe3, 0x8a, 0x49, 0xc5,
+	0x8a, 0x5d, 0xcc, 0x64, 0x38, 0x2a, 0xae, 0xa8, 0x30, 0x3c, 0x16, 0xcf, 0x21, 0x15, 0xf4, 0x16,
+	0x10, 0x2e, 0x9e, 0x30, 0x49, 0x64, 0x96, 0x14, 0x3f, 0xfd, 0x3d, 0x58, 0x27, 0xdc, 0xf5, 0x22,
+	0x19, 0xc4, 0x0a, 0x59, 0x8b, 0x67, 0xeb, 0x88, 0x4d, 0x60, 0x48, 0xd0, 0xc2, 0x3d, 0xbc, 0xb7,
+	0xc7, 0xd7, 0xba, 0x92, 0x70, 0xcd, 0xc4, 0x5c, 0xe6, 0x82, 0xae, 0x1d, 0x7c, 0xee, 0xd0, 0xe0,
+	0xb3, 0xff, 0x7d, 0x58, 0x33, 0x95, 0xa5, 0x00, 0x2d, 0xe3, 0xe5, 0xbf, 0x80, 0x8d, 0x04, 0x6c,
+	0x92, 0x9d, 0xea, 0x46, 0xda, 0xc8, 0xde, 0x83, 0x35, 0x43, 0x66, 0xd8, 0x8d, 0x4c, 0x6a, 0x96,
+	0x7f, 0x73, 0x0f, 0x33, 0x9c, 0x96, 0xda, 0x6a, 0x70, 0xc0, 0xff, 0x4f, 0x0e, 0xac, 0x3f, 0x28,
+	0x59, 0xb1, 0x57, 0x4f, 0x88, 0xeb, 0x94, 0xba, 0x73, 0x51, 0x4a, 0xbd, 0xd3, 0x96, 0x52, 0xe7,
+	0xce, 0x08, 0x3f, 0x6b, 0x93, 0xb4, 0x3b, 0x45, 0x9d, 0x97, 0x74, 0xf7, 0x7f, 0xe5, 0xc0, 0x15,
+	0x94, 0x4a, 0x66, 0x12, 0xd8, 0x31, 0x2b, 0x58, 0x3a, 0xe2, 0xfd, 0xca, 0x31, 0x25, 0x2e, 0xfb,
+	0x8f, 0xdf, 0xa8, 0x66, 0x91, 0x68, 0x50, 0x43, 0x2f, 0xa0, 0xf3, 0xb2, 0xe4, 0xee, 0x4b, 0xe8,
+	0xd6, 0x55, 0x61, 0x9c, 0x78, 0x3d, 0x6b, 0x73, 0x26, 0x6d, 0x4a, 0x02, 0xff, 0xff, 0x49, 0x05,
+	0x7d, 0x18, 0x27, 0x17, 0x08, 0xc2, 0x5d, 0xff, 0x84, 0xa5, 0xc6, 0x70, 0x69, 0x98, 0xd3, 0xb3,
+	0x62, 0xa2, 0xf6, 0x15, 0xfc, 0xd6, 0xf1, 0x9d, 0x1e, 0xc9, 0x09, 0x5d, 0x85, 0xb9, 0x93, 0x22,
+	0x9b, 0xe6, 0x32, 0x51, 0x24, 0x00, 0xf7, 0x45, 0x2d, 0xee, 0xbc, 0xe5, 0x70, 0x68, 0xb9, 0x94,
+	0xb0, 0xff, 0x1a, 0xfa, 0x88, 0xc3, 0xbf, 0x56, 0xf7, 0x5d, 0xb3, 0xef, 0x50, 0xf6, 0xb7, 0x60,
+	0x2d, 0x8c, 0xa2, 0xb8, 0x8a, 0xb3, 0x34, 0x4c, 0x3e, 0x42, 0x94, 0x0a, 0x97, 0x36, 0xf0, 0xfe,
+	0x3e, 0xcc, 0x3f, 0x10, 0xce, 0xae, 0x0b, 0xbd, 0x4f, 0x09, 0x7f, 0xb5, 0x7d, 0x7e, 0x1c, 0x16,
+	0x91, 0xf4, 0x8a, 0xf9, 0x37, 0xe2, 0x8e, 0xb2, 0x63, 0x75, 0x2a, 0xe6, 0xdf, 0xfe, 0x5f, 0xf7,
+	0x61, 0xd9, 0x9a, 0x75, 0xb3, 0xa4, 0x6d, 0x49, 0xbb, 0x79, 0xb0, 0x80, 0xbe, 0x4d, 0x14, 0xab,
+	0x44, 0x96, 0x02, 0x71, 0x66, 0x16, 0x8c, 0x67, 0x33, 0x64, 0xca, 0x55, 0x68, 0xd6, 0x46, 0xaa,
+	0xe4, 0xe9, 0x9c, 0x49, 0x9e, 0xbe, 0xc5, 0x83, 0x6a, 0xa3, 0x2a, 0xa9, 0x6d, 0xd5, 0x96, 0x84,
+	0x3b, 0x47, 0x9c, 0x44, 0x6e, 0xd5, 0x82, 0xde, 0x7d, 0x09, 0x7a, 0x2c, 0x3d, 0xad, 0x67, 0xe1,
+	0x6b, 0xb9, 0x51, 0x4e, 0xc2, 0x8f, 0x5e, 0x22, 0x23, 0xcb, 0x83, 0x31, 0x83, 0x40, 0x81, 0x68,
+	0xdb, 0x18, 0x72, 0xcd, 0xb3, 0x38, 0xad, 0x64, 0xf6, 0x96, 0x60, 0xdc, 0x1d, 0x95, 0xab, 0x15,
+	0xf9, 0x0e, 0xaf, 0x4d, 0x3a, 0x9a, 0xaf, 0x7d, 0xc3, 0xa4, 0xe6, 0x16, 0xad, 0x2d, 0xad, 0x65,
+	0x45, 0x99, 0x24, 0xdd, 0x0e, 0xcc, 0x71, 0x47, 0xd0, 0x5b, 0x6a, 0xb4, 0x62, 0x4d, 0xfd, 0x40,
+	0x90, 0xb9, 0xdf, 0x93, 0xb3, 0x77, 0xb9, 0x31, 0x23, 0xf1, 0x4f, 0x4e, 0xe7, 0xb7, 0x6a, 0x99,
+	0xdd, 0x76, 0xcd, 0xb6, 0x65, 0xf3, 0x44, 0x98, 0x7f, 0x55, 0x87, 0xf9, 0xaf, 0x03, 0x1c, 0x55,
+	0x59, 0x7e, 0x14, 0x9f, 0xa4, 0x61, 0xe2, 0xad, 0x73, 0x3c, 0xc1, 0xb8, 0x2f, 0xc2, 0xc2, 0x94,
+	0xcf, 0xcb, 0xd2, 0x73, 0x79, 0x53, 0xcb, 0xaa, 0x29, 0x8e, 0x0d, 0x54, 0x29, 0x3f, 0x34, 0x67,
+	0x27, 0xfc, 0x62, 0xcd, 0x15, 0x31, 0x7d, 0x24, 0x68, 0x19, 0x8c, 0xab, 0x35, 0x83, 0xc1, 0x8d,
+	0xe7, 0x68, 0xcc, 0xbc, 0x0d, 0x65, 0x3c, 0x47, 0x63, 0xe6, 0xbe, 0x07, 0x83, 0x88, 0xe5, 0x2c,
+	0x8d, 0xca, 0xcf, 0x52, 0x6f, 0x93, 0x37, 0x7b, 0xbd, 0xad, 0x87, 0xfb, 0x9c, 0x88, 0xa5, 0xa3,
+	0xb3, 0xc0, 0x54, 0x70, 0x77, 0x61, 0x71, 0xcc, 0xc2, 0xa4, 0x1a, 0xef, 0x8d, 0xd9, 0xe8, 0xb1,
+	0xb7, 0x75, 0xc3, 0x21, 0xc1, 0x2e, 0xab, 0xfe, 0xc7, 0x86, 0x2c, 0xa0, 0x75, 0xdc, 0x1f, 0xc1,
+	0x20, 0xcf, 0xca, 0xea, 0x08, 0xa7, 0xb7, 0xe7, 0xdd, 0x70, 0x6a, 0x03, 0x67, 0x18, 0x64, 0xd9,
+	0xe3, 0xc0, 0x90, 0xba, 0xb7, 0x61, 0x21, 0x2f, 0x18, 0xaa, 0xcf, 0xbb, 0x76, 0x41, 0x2d, 0x45,
+	0xe8, 0xbe, 0x0b, 0x83, 0x82, 0x89, 0x60, 0x5e, 0xe9, 0x0d, 0x79, 0xad, 0xe7, 0xdb, 0x6a, 0x05,
+	0x8a, 0x28, 0x30, 0xf4, 0xe8, 0xd3, 0x92, 0xf5, 0xf3, 0x2c, 0x3e, 0xed, 0x37, 0x71, 0x87, 0xff,
+	0x8f, 0x03, 0x9b, 0xed, 0xb2, 0x71, 0x1f, 0x2d, 0x9f, 0x1e, 0x8d, 0xc3, 0x82, 0x09, 0x7f, 0xa2,
+	0x1b, 0x18, 0x04, 0xbf, 0x4e, 0x91, 0x4f, 0x3f, 0x9f, 0x66, 0x55, 0x28, 0x6f, 0xa5, 0x68, 0x58,
+	0xd6, 0x3c, 0x64, 0x45, 0x9c, 0x45, 0x5e, 0x57, 0xd7, 0x14, 0x08, 0xb2, 0xe9, 0xf7, 0xac, 0x8c,
+	0xf3, 0x36, 0x0c, 0xf2, 0x38, 0x2a, 0x3f, 0xe1, 0x41, 0x03, 0x79, 0x58, 0xd0, 0x08, 0xff, 0xe7,
+	0xb0, 0xde, 0xd0, 0x3c, 0xb5, 0x18, 0x8e, 0x6d, 0x31, 0x5e, 0x83, 0xde, 0xb8, 0xaa, 0x54, 0xa4,
+	0x6c, 0xbb, 0x75, 0xec, 0xee, 0xdf, 0x3f, 0xe4, 0xe3, 0xc7, 0x29, 0xfd, 0x2f, 0x60, 0xa3, 0xb5,
+	0x58, 0x5c, 0x56, 0xd0, 0xbb, 0x3c, 0xff, 0xd6, 0x9b, 0x5b, 0xc7, 0xde, 0x65, 0x27, 0xac, 0x1a,
+	0xcb, 0x2e, 0x0f, 0x02, 0x09, 0xf9, 0x0f, 0x60, 0x6b, 0xc6, 0x54, 0x3f, 0xdf, 0x0d, 0x96, 0xa5,
+	0x62, 0x77, 0x91, 0x2d, 0x19, 0x84, 0x7f, 0x08, 0xde, 0xac, 0x15, 0x70, 0x8e, 0x5e, 0x86, 0xd0,
+	0xe7, 0xe1, 0xc4, 0xd3, 0x30, 0x51, 0x37, 0x01, 0x15, 0xec, 0xbf, 0x03, 0x4b, 0x0f, 0x4a, 0x33,
+	0x03, 0xf4, 0x75, 0x01, 0xa7, 0xf5, 0xba, 0x80, 0xed, 0xb1, 0x1d, 0x43, 0x5f, 0xd9, 0xc1, 0x59,
+	0x37, 0x10, 0x59, 0x3a, 0xca, 0x22, 0x8c, 0xab, 0xc9, 0x9d, 0x5f, 0xc1, 0x38, 0x5f, 0xa7, 0x45,
+	0x2c, 0xb5, 0x86, 0x9f, 0x42, 0xfe, 0xb4, 0x62, 0xa9, 0xba, 0xeb, 0xa6, 0x40, 0xf4, 0x7c, 0x8d,
+	0x8d, 0xfe, 0x2c, 0x47, 0x4d, 0x68, 0x2f, 0xc1, 0x69, 0xbf, 0x39, 0xd2, 0x69, 0xdc, 0x1c, 0xd1,
+	0xb7, 0x58, 0xba, 0xf6, 0x2d, 0x16, 0xff, 0xff, 0x3b, 0x00, 0x86, 0xfd, 0xb3, 0xde, 0x1d, 0x39,
+	0xce, 0x8a, 0x49, 0x58, 0xe9, 0xab, 0x2e, 0x1c, 0x72, 0x5f, 0x85, 0xf9, 0x8c, 0x8b, 0x29, 0xfd,
+	0xa8, 0xad, 0xc6, 0x4e, 0x23, 0x7a, 0x11, 0x48, 0x32, 0xce, 0xa8, 0x44, 0x1a, 0x75, 0x9b, 0x52,
+	0x40, 0xc6, 0xbe, 0xce, 0x13, 0xfb, 0xea, 0xff, 0x9d, 0x23, 0xdc, 0x04, 0x1d, 0x98, 0xc4, 0xfa,
+	0x8f, 0x8a, 0x38, 0x3a, 0xd1, 0xf1, 0x38, 0x01, 0x35, 0x12, 0xef, 0x18, 0x15, 0x3a, 0xe6, 0xdd,
+	0x93, 0x02, 0x0b, 0x08, 0x47, 0x63, 0x12, 0x8e, 0xa4, 0xde, 0xf1, 0x93, 0x63, 0xaa, 0xa9, 0x0c,
+	0xba, 0xe1, 0x27, 0x6a, 0xf7, 0x24, 0xac, 0xd8, 0x93, 0xf0, 0x4c, 0xdd, 0xcb, 0x91, 0x20, 0x96,
+	0xa8, 0xe8, 0x8b, 0xb8, 0x8e, 0xa5, 0x40, 0x3b, 0x91, 0xdf, 0xbf, 0x7c, 0x22, 0x5f, 0xee, 0x73,
+	0x91, 0xda, 0xe7, 0xfc, 0xff, 0xe1, 0x80, 0xdb, 0xac, 0x81, 0xee, 0x74, 0x9c, 0xf2, 0x80, 0x57,
+	0x10, 0x56, 0x2a, 0x33, 0x41, 0x51, 0x3c, 0x56, 0x26, 0xc0, 0x3b, 0xd3, 0xa2, 0x54, 0x81, 0x49,
+	0x0b, 0xc7, 0x3d, 0x0d, 0xc3, 0x44, 0x38, 0x64, 0x04, 0x83, 0xad, 0x30, 0xc2, 0x42, 0xc4, 0x2a,
+	0x29, 0xca, 0xff, 0xcf, 0x0e, 0xb8, 0x38, 0x22, 0x2a, 0x4b, 0x88, 0xc1, 0xd6, 0x34, 0x22, 0x97,
+	0x50, 0x1c, 0xeb, 0x12, 0xca, 0x79, 0x57, 0x73, 0x37, 0x61, 0x5e, 0x84, 0xe2, 0xe4, 0xe1, 0x40,
+	0x42, 0x38, 0x15, 0xca, 0x0a, 0xe5, 0x13, 0x83, 0x24, 0x00, 0x1e, 0x28, 0xe4, 0xe5, 0x98, 0x7d,
+	0x11, 0xbe, 0x9b, 0x41, 0xa0, 0xa1, 0x77, 0xa5, 0x48, 0xd4, 0x52, 0x50, 0x7b, 0xe0, 0xd8, 0xf6,
+	0x00, 0xc7, 0x12, 0x23, 0x5b, 0xd9, 0x54, 0x49, 0xa6, 0x40, 0x77, 0x07, 0xdc, 0x69, 0x2a, 0x76,
+	0xd9, 0xb3, 0xfb, 0xe3, 0x82, 0x95, 0xe3, 0x2c, 0x89, 0xa4, 0x90, 0x2d, 0x25, 0xe8, 0x3e, 0x37,
+	0xa8, 0x85, 0xf1, 0x6f, 0xe0, 0xfd, 0xbf, 0xea, 0xc0, 0x22, 0xd1, 0x1f, 0x76, 0x4b, 0x9e, 0x89,
+	0xb4, 0xee, 0x0c, 0xc2, 0x3a, 0x22, 0x75, 0x6a, 0xf7, 0x92, 0x2f, 0x3e, 0x60, 0xbd, 0x0a, 0x73,
+	0xa8, 0xec, 0x52, 0x26, 0x45, 0xaf, 0x91, 0xb5, 0x69, 0x0f, 0x5f, 0x20, 0xe8, 0xb4, 0x45, 0x98,
+	0xb3, 0x6f, 0x22, 0x97, 0xa3, 0x31, 0x8b, 0xa6, 0x09, 0x2b, 0xf4, 0x85, 0x21, 0x85, 0x40, 0x55,
+	0xe5, 0xac, 0x28, 0x79, 0x72, 0x41, 0x64, 0x55, 0x51, 0x9f, 0x0b, 0x42, 0x55, 0xcd, 0x12, 0x14,
+	0xfa, 0x38, 0x2b, 0x9e, 0x84, 0x45, 0x74, 0x0f, 0x8f, 0xa7, 0xe2, 0xa2, 0x07, 0x45, 0xb9, 0xef,
+	0xda, 0x4e, 0xd1, 0xc0, 0x5a, 0x4a, 0xcd, 0x21, 0xb6, 0xdc, 0x21, 0xff, 0xbf, 0x38, 0xb0, 0x84,
+	0x61, 0xad, 0xec, 0x64, 0x2f, 0x4b, 0x8f, 0xe3, 0x13, 0x9d, 0xa0, 0x74, 0x48, 0x82, 0xf2, 0x4d,
+	0x98, 0x1f, 0xf1, 0x52, 0x99, 0xbd, 0x26, 0xf7, 0x8f, 0x74, 0xc5, 0x1d, 0xf1, 0x4f, 0xba, 0xa4,
+	0x82, 0x1c, 0x1d, 0x11, 0x82, 0x7e, 0x26, 0x47, 0xe4, 0x31, 0x2c, 0xe2, 0x90, 0xdc, 0x0b, 0xf3,
+	0x1c, 0xad, 0x74, 0xe3, 0x08, 0xed, 0xd4, 0xe2, 0x5c, 0x8d, 0x43, 0xb8, 0x1c, 0x7d, 0x05, 0x5b,
+	0x33, 0xa3, 0x5b, 0x3b, 0x3c, 0xa7, 0x70, 0x15, 0x69, 0x26, 0xa2, 0xb1, 0x2f, 0xc6, 0x71, 0xc5,
+	0x83, 0x16, 0x38, 0x4f, 0xf9, 0xec, 0x4f, 0xc3, 0x44, 0x46, 0x8b, 0xd5, 0x4d, 0xc7, 0x06, 0x1e,
+	0x69, 0xd9, 0xd3, 0x1a, 0x6d, 0x47, 0xd0, 0xd6, 0xf1, 0xfe, 0x5f, 0xf6, 0x61, 0x01, 0x27, 0xd5,
+	0x61, 0x16, 0xb5, 0xdd, 0xce, 0x41, 0x99, 0xe9, 0x99, 0x58, 0xc1, 0x7a, 0x70, 0xba, 0x64, 0x70,
+	0xbe, 0xee, 0x11, 0xee, 0x76, 0x2d, 0xda, 0x4a, 0x8f, 0x3c, 0x87, 0x59, 0xd4, 0x7a, 0xc4, 0x78,
+	0x15, 0xfd, 0x7d, 0xb9, 0xdd, 0x2d, 0x58, 0xc1, 0x74, 0xea, 0x28, 0x04, 0x9a, 0xc8, 0x7d, 0x01,
+	0xba, 0x49, 0x76, 0xe2, 0xf5, 0x2d, 0x5a, 0x3a, 0x6d, 0x02, 0x2c, 0x47, 0xe9, 0xa2, 0x54, 0x5d,
+	0xc3, 0xc5, 0x4f, 0xf7, 0x0d, 0xeb, 0x02, 0x24, 0x58, 0x61, 0x58, 0xdb, 0x3f, 0x25, 0x74, 0x78,
+	0x05, 0x45, 0x9c, 0xc8, 0xc4, 0x29, 0xae, 0x71, 0xe8, 0x17, 0xa5, 0xee, 0xcb, 0xe6, 0xb8, 0x27,
+	0x8e, 0x6e, 0x2d, 0xc1, 0x0c, 0x45, 0x81, 0x92, 0x90, 0xcc, 0xec, 0x72, 0x43, 0x12, 0xbd, 0xf5,
+	0x58, 0x89, 0xd9, 0x1d, 0xe8, 0x4b, 0xc3, 0xa2, 0x0e, 0x72, 0x6e, 0xd3, 0x98, 0x04, 0x9a, 0xc6,
+	0xfd, 0x1c, 0x36, 0xf2, 0x96, 0x19, 0x58, 0xf2, 0xf3, 0xdc, 0xe2, 0xed, 0xe7, 0xb4, 0xea, 0x9a,
+	0x34, 0x41, 0x7b, 0x4d, 0xbc, 0x5b, 0x4c, 0x0a, 0x4a, 0x6f, 0xcd, 0x12, 0x83, 0x2c, 0xae, 0xc0,
+	0xa2, 0xc3, 0x2d, 0x2f, 0x4a, 0x4b, 0xe1, 0x85, 0x94, 0xde, 0xba, 0x38, 0x5c, 0x1b, 0x0c, 0xda,
+	0xb7, 0x28, 0x2d, 0x8f, 0x18, 0xe6, 0xc8, 0xf9, 0xc9, 0x71, 0x10, 0x18, 0x84, 0xfb, 0x5e, 0xe3,
+	0x9e, 0xe8, 0x95, 0x73, 0x06, 0xaf, 0x46, 0x8b, 0x6d, 0x87, 0xd3, 0x2a, 0x13, 0xb1, 0x39, 0x79,
+	0xa4, 0x24, 0x18, 0x5c, 0x64, 0x55, 0x95, 0xec, 0x1e, 0x57, 0x38, 0xa0, 0xe2, 0x39, 0x03, 0x3f,
+	0x5f, 0xce, 0x05, 0x0d, 0xbc, 0xfb, 0x2a, 0x7f, 0x54, 0xc0, 0xef, 0xec, 0x6f, 0xde, 0x70, 0x48,
+	0xb0, 0x41, 0xce, 0xf0, 0x40, 0x14, 0x06, 0x8a, 0x4a, 0x58, 0x88, 0x38, 0x2b, 0xe2, 0xea, 0x8c,
+	0x1f, 0x2d, 0xe7, 0x02, 0x0d, 0x63, 0xa4, 0x59, 0x3a, 0x2e, 0x72, 0x95, 0x79, 0x56, 0xa4, 0xf9,
+	0x53, 0x5a, 0x16, 0xd8, 0xa4, 0xee, 0x6d, 0x58, 0xc4, 0x15, 0xbc, 0x9b, 0xc4, 0x61, 0xc9, 0x4a,
+	0xef, 0x9a, 0x15, 0x2c, 0xfd, 0x58, 0x95, 0x04, 0x94, 0xe8, 0x9b, 0x1c, 0xe1, 0xde, 0x86, 0x81,
+	0x66, 0x2a, 0xbd, 0x3c, 0x47, 0x7b, 0x79, 0xdb, 0x30, 0x50, 0xd6, 0x44, 0x99, 0x27, 0x83, 0xf0,
+	0xff, 0xab, 0x03, 0xcb, 0x56, 0x57, 0x70, 0xfb, 0xc9, 0xf9, 0x17, 0xde, 0xd3, 0x56, 0xc6, 0x8f,
+	0xa2, 0xf0, 0x60, 0x2c, 0x3d, 0x26, 0xb9, 0x3b, 0x78, 0xad, 0x3a, 0x99, 0x26, 0x2c, 0x50, 0x84,
+	0xee, 0x6b, 0x30, 0x2f, 0x5c, 0x24, 0xaf, 0x7b, 0x41, 0x15, 0x49, 0xe7, 0x97, 0xb0, 0xde, 0x28,
+	0xe4, 0x61, 0x1e, 0xc6, 0x0a, 0x75, 0x5f, 0xa2, 0x95, 0xcb, 0x21, 0x63, 0x45, 0x20, 0xc8, 0x4c,
+	0x58, 0xe8, 0x1c, 0x41, 0x49, 0x58, 0xc8, 0xff, 0x23, 0x07, 0xd6, 0x1b, 0xcc, 0x78, 0xa4, 0x38,
+	0x8e, 0xf4, 0xf1, 0x02, 0xbf, 0xdd, 0x9f, 0xa2, 0x9a, 0xa2, 0x23, 0x96, 0xf0, 0x5c, 0xad, 0xe4,
+	0xff, 0xd2, 0x2c, 0x79, 0x76, 0x0e, 0x0d, 0xad, 0x30, 0xb0, 0xb4, 0xf6, 0xf0, 0xc7, 0xb0, 0x56,
+	0x27, 0x78, 0xa6, 0x09, 0xb0, 0x57, 0x97, 0xba, 0xbe, 0xfd, 0x39, 0x35, 0xc7, 0x48, 0x9d, 0x68,
+	0x3b, 0xe6, 0x44, 0xeb, 0xdf, 0x82, 0x15, 0x7b, 0x9d, 0xf0, 0x14, 0x60, 0x91, 0xa1, 0x05, 0x95,
+	0x0c, 0x14, 0xe8, 0x07, 0x5c, 0x60, 0x3b, 0xb8, 0x2f, 0xd2, 0x97, 0x78, 0xaf, 0xb5, 0x96, 0xbe,
+	0x54, 0x5c, 0x55, 0x71, 0x7b, 0x92, 0xc5, 0x7f, 0x09, 0xd6, 0x09, 0x4f, 0x19, 0xa4, 0x6f, 0x4f,
+	0x9e, 0xde, 0xe4, 0xcd, 0xdb, 0x61, 0xff, 0x76, 0xca, 0xf7, 0x61, 0x9d, 0x50, 0x3e, 0x73, 0xe4,
+	0xff, 0x4f, 0x1d, 0x9a, 0xe9, 0xcb, 0x4e, 0xca, 0x4b, 0xa5, 0xaf, 0xc4, 0x51, 0x30, 0x49, 0xb2,
+	0x27, 0x9c, 0x5b, 0x3f, 0x90, 0x10, 0x1a, 0x3b, 0x9d, 0x29, 0x2e, 0x65, 0xc0, 0x9d, 0x60, 0xf8,
+	0x6e, 0xaf, 0x02, 0xee, 0xb8, 0xdb, 0x87, 0x71, 0x82, 0x82, 0x95, 0x71, 0x3a, 0x52, 0x1e, 0xa7,
+	0x00, 0x44, 0x46, 0x2a, 0x42, 0x47, 0x72, 0x5e, 0xb4, 0x20, 0x20, 0x89, 0x67, 0x45, 0x21, 0xdf,
+	0x44, 0x48, 0xc8, 0x7f, 0x09, 0x36, 0x6a, 0xfd, 0x90, 0xba, 0x58, 0x13, 0xfb, 0x35, 0x76, 0x61,
+	0x89, 0x6f, 0xcd, 0x18, 0x04, 0xd8, 0xe7, 0xaf, 0x1e, 0xce, 0x79, 0x26, 0x66, 0x12, 0x62, 0x1d,
+	0x2b, 0x21, 0xb6, 0x0c, 0x8b, 0x24, 0xc9, 0xe7, 0xff, 0x41, 0x0f, 0x96, 0xac, 0xf4, 0xdd, 0x0a,
+	0x74, 0xf4, 0x08, 0x75, 0x0e, 0xf6, 0x51, 0x21, 0xd6, 0xab, 0x07, 0x1c, 0x0f, 0x82, 0xc1, 0x76,
+	0x78, 0x40, 0xbb, 0x54, 0xe7, 0x1f, 0x01, 0x91, 0x77, 0x1a, 0x3d, 0xeb, 0x9d, 0xc6, 0x2b, 0xb0,
+	0x10, 0x49, 0xc1, 0xe6, 0xac, 0x24, 0x1a, 0xed, 0x51, 0xa0, 0x68, 0xd0, 0x93, 0x8a, 0xb2, 0xd1,
+	0x63, 0x56, 0x04, 0x59, 0x56, 0x99, 0xa7, 0x45, 0x36, 0x12, 0x1d, 0xf8, 0x38, 0x8d, 0xd8, 0x53,
+	0xdc, 0xc3, 0x59, 0xb1, 0x1b, 0x45, 0xdc, 0x8e, 0x89, 0xc3, 0x6d, 0x4b, 0x09, 0xde, 0x12, 0x61,
+	0x4f, 0xd9, 0x68, 0x8a, 0x9b, 0xa7, 0x68, 0x57, 0x3a, 0xf1, 0x75, 0x34, 0x8f, 0x44, 0xb0, 0xc9,
+	0x7d, 0x7e, 0xdd, 0x75, 0x20, 0x42, 0x68, 0x0a, 0x16, 0x4b, 0x34, 0x2a, 0xf9, 0xcd, 0x91, 0x6e,
+	0xc0, 0xbf, 0x91, 0x73, 0x96, 0xb3, 0x22, 0xe4, 0x2f, 0xea, 0xc4, 0x7d, 0x85, 0x45, 0xc1, 0xb9,
+	0x86, 0xd6, 0x83, 0xb6, 0x44, 0x06, 0xed, 0x55, 0xe8, 0x8f, 0xc2, 0x3c, 0x1c, 0xe1, 0x6e, 0xb7,
+	0x6c, 0xf9, 0x67, 0xb8, 0x7b, 0xec, 0xc9, 0xa2, 0x40, 0x13, 0xb9, 0x3f, 0x84, 0xc5, 0x38, 0x47,
+	0xaf, 0x2d, 0x89, 0x47, 0x95, 0xf2, 0x6a, 0x94, 0xe7, 0x74, 0x70, 0xa8, 0x4a, 0x02, 0x4a, 0xe5,
+	0xbe, 0x0f, 0xab, 0x93, 0xb8, 0xc4, 0xfb, 0x52, 0xda, 0x29, 0x5e, 0xb5, 0x86, 0xe2, 0xe0, 0x70,
+	0x37, 0x49, 0xb2, 0x51, 0xc8, 0x63, 0x1e, 0x75, 0x5a, 0xff, 0x5f, 0x02, 0x18, 0xce, 0x8d, 0xcd,
+	0x8c, 0x04, 0x17, 0x3a, 0x76, 0x70, 0x41, 0xa9, 0x4b, 0x04, 0x74, 0xf8, 0xb7, 0x3f, 0x86, 0x25,
+	0xda, 0xd8, 0x33, 0x70, 0x5b, 0x83, 0x6e, 0xae, 0xc3, 0x78, 0xf8, 0x89, 0x0b, 0x5e, 0x3b, 0x7b,
+	0xea, 0xf9, 0xa5, 0x46, 0xf8, 0x7f, 0xe1, 0xc0, 0x12, 0x55, 0x62, 0x4b, 0xa6, 0xd3, 0x87, 0xa5,
+	0x51, 0x3e, 0xdd, 0xcb, 0x26, 0x93, 0xb8, 0xaa, 0x58, 0x24, 0x27, 0xbb, 0x85, 0x93, 0x34, 0x01,
+	0x9b, 0x84, 0x31, 0x7f, 0x1a, 0xd9, 0xd5, 0x34, 0x1a, 0x57, 0x0b, 0x9e, 0x76, 0x75, 0xf0, 0xf4,
+	0x26, 0xac, 0x8a, 0x2f, 0xd3, 0x84, 0x08, 0xa1, 0xd6, 0xd1, 0x86, 0xd2, 0x34, 0x34, 0x4f, 0x29,
+	0x35, 0xda, 0x0f, 0x61, 0xfd, 0xee, 0x53, 0x36, 0xb2, 0xed, 0xfc, 0xc5, 0x57, 0x14, 0x48, 0xf0,
+	0xb1, 0x63, 0x07, 0x1f, 0xe5, 0xa1, 0xa4, 0xab, 0x0f, 0x25, 0xfe, 0x0f, 0xc0, 0xa5, 0x4d, 0x48,
+	0x3b, 0xb1, 0x09, 0xf3, 0xb8, 0x56, 0x34, 0x7b, 0x09, 0xf9, 0x8f, 0x60, 0x0d, 0xa9, 0x79, 0x80,
+	0xfe, 0xf2, 0xf2, 0x18, 0x6e, 0x1d, 0xca, 0x4d, 0x44, 0x51, 0xa2, 0x58, 0xbc, 0xf2, 0x58, 0x0a,
+	0x04, 0xe0, 0xbf, 0x0c, 0xeb, 0xa4, 0x0d, 0x23, 0x90, 0xb4, 0xb7, 0xc2, 0x52, 0x4a, 0xc8, 0x7f,
+	0x00, 0xcb, 0x48, 0xfc, 0xf0, 0x9e, 0x92, 0x66, 0xe6, 0x65, 0x9a, 0x19, 0x1a, 0x69, 0x97, 0x61,
+	0x1f, 0x56, 0x14, 0xdb, 0xf3, 0x05, 0xb0, 0x1e, 0x19, 0x77, 0xec, 0x47, 0xc6, 0x3e, 0x93, 0x3d,
+	0xe1, 0xd9, 0x9f, 0x6f, 0xae, 0x2e, 0x14, 0x81, 0xb3, 0x92, 0x11, 0x7d, 0x09, 0xf9, 0x57, 0xc1,
+	0xa5, 0xcd, 0x08, 0x81, 0xfd, 0x17, 0xf9, 0x35, 0x1b, 0x6b, 0xa4, 0xda, 0xb7, 0x68, 0x17, 0xd6,
+	0x0c, 0xa1, 0xac, 0x1c, 0xc2, 0x22, 0xde, 0xde, 0xbc, 0xdc, 0x6e, 0x8b, 0x69, 0x83, 0x22, 0x1b,
+	0xb1, 0xb2, 0x3c, 0x50, 0x4f, 0x79, 0x0c, 0x02, 0xa5, 0x4e, 0xb3, 0x8f, 0x43, 0xb9, 0x9a, 0xfa,
+	0x81, 0x84, 0xfc, 0x5b, 0xb0, 0x24, 0x9a, 0x90, 0x0a, 0x3e, 0xe7, 0xb5, 0xb6, 0x7f, 0x17, 0x96,
+	0x77, 0xab, 0x2a, 0x1c, 0x8d, 0xef, 0xc9, 0x87, 0x66, 0x17, 0x2b, 0xd1, 0x85, 0x5e, 0x14, 0xca,
+	0xcc, 0xc8, 0x52, 0xc0, 0xbf, 0xfd, 0x00, 0xdf, 0x47, 0x15, 0xd5, 0x87, 0x32, 0x94, 0x23, 0x79,
+	0xcd, 0xbc, 0x33, 0x52, 0xf7, 0xd0, 0x34, 0xcf, 0x2e, 0xe1, 0xf9, 0x15, 0x6c, 0xea, 0x8d, 0xdd,
+	0x5e, 0xa7, 0xf4, 0xaa, 0x0c, 0xf1, 0xca, 0xda, 0x8f, 0x65, 0x36, 0xe9, 0x0c, 0x0f, 0xed, 0x5d,
+	0xd8, 0x6a, 0xb4, 0x25, 0xb5, 0x77, 0xa1, 0x42, 0xfc, 0x77, 0x88, 0x07, 0x62, 0xcd, 0x8a, 0xef,
+	0xc2, 0x92, 0xa6, 0xfb, 0x79, 0x1c, 0x35, 0xeb, 0x46, 0xbe, 0x07, 0x9b, 0xf5, 0xba, 0x72, 0xa2,
+	0xe4, 0xa4, 0x24, 0xe0, 0xd7, 0x08, 0x14, 0xdb, 0x5b, 0xb0, 0x96, 0x25, 0xd1, 0x9e, 0x75, 0x55,
+	0x4a, 0xb0, 0x6e, 0xe0, 0x91, 0x36, 0x65, 0x4f, 0xf6, 0x5a, 0xae, 0x55, 0x35, 0xf0, 0xfe, 0x35,
+	0xd8, 0x6a, 0xb4, 0x28, 0x85, 0x79, 0xd7, 0x12, 0x86, 0x3a, 0xa7, 0x97, 0xe8, 0xa3, 0xcd, 0x97,
+	0xfa, 0xab, 0xfe, 0x9f, 0x38, 0x00, 0xbb, 0xd3, 0x6a, 0x2c, 0x03, 0x76, 0x43, 0xe8, 0x63, 0x86,
+	0x83, 0x38, 0x65, 0x1a, 0x16, 0x2f, 0xbd, 0xca, 0xf2, 0x49, 0x56, 0x44, 0xe6, 0xa5, 0x97, 0x80,
+	0xf9, 0x1b, 0xe7, 0x69, 0x35, 0x56, 0xb1, 0x24, 0xfc, 0xc6, 0x81, 0x66, 0x13, 0xe3, 0x72, 0x0a,
+	0x00, 0xfd, 0xa2, 0x92, 0xbb, 0x34, 0xa1, 0x74, 0x76, 0x84, 0xef, 0x69, 0x23, 0x45, 0x1c, 0xea,
+	0x24, 0x2e, 0xab, 0xe2, 0xac, 0xca, 0x1e, 0xb3, 0x54, 0x79, 0x4f, 0x16, 0xd2, 0x0f, 0xe5, 0x4d,
+	0x25, 0x7c, 0xce, 0x4d, 0x0c, 0x81, 0xb8, 0xb4, 0xe0, 0xd0, 0x4b, 0x0b, 0xb8, 0x39, 0x84, 0x2a,
+	0x5b, 0x83, 0x9f, 0xee, 0x0b, 0x44, 0x62, 0xe3, 0x79, 0x18, 0x55, 0x88, 0x4e, 0xf8, 0x2f, 0xc2,
+	0x3a, 0x69, 0xc2, 0x38, 0xf9, 0x7c, 0xb1, 0x38, 0x64, 0xb1, 0xfc, 0x5c, 0xcb, 0x52, 0x8e, 0xc9,
+	0x75, 0xa1, 0x82, 0xe5, 0x99, 0x72, 0x6f, 0xf1, 0xfb, 0xdb, 0x90, 0xa4, 0x1c, 0x9f, 0x2b, 0xc9,
+	0x43, 0x70, 0x39, 0x61, 0xe3, 0x0c, 0xd3, 0xa2, 0x97, 0xab, 0x30, 0x77, 0x9c, 0xa9, 0x7c, 0x53,
+	0x3f, 0x10, 0x00, 0x62, 0xf3, 0x62, 0x9a, 0x32, 0x69, 0xd6, 0x04, 0xe0, 0xef, 0xc2, 0x22, 0xe7,
+	0xbb, 0xcf, 0x12, 0x56, 0xf1, 0x13, 0xdc, 0x34, 0xad, 0xc2, 0x13, 0xa6, 0xa6, 0x9c, 0x02, 0xb1,
+	0x24, 0x62, 0xe2, 0x0a, 0xb3, 0xf4, 0x7d, 0x24, 0xe8, 0xef, 0xc2, 0x15, 0x4b, 0x34, 0xd9, 0x8b,
+	0x5b, 0xda, 0x15, 0x77, 0xac, 0xb0, 0x12, 0x69, 0x4e, 0xb9, 0xe7, 0xfe, 0x8f, 0x61, 0x85, 0xa3,
+	0x3f, 0xda, 0x53, 0x3d, 0xe3, 0x0e, 0xfb, 0x59, 0x30, 0x15, 0xbf, 0xb0, 0xd1, 0x0f, 0x24, 0xd4,
+	0xde, 0x37, 0xff, 0xdf, 0xc8, 0x71, 0xfa, 0x68, 0x6f, 0x2f, 0x4c, 0xa3, 0x38, 0x0a, 0x2b, 0xd6,
+	0x16, 0x35, 0xd5, 0xef, 0x16, 0x3b, 0xcd, 0x77, 0x8b, 0xf4, 0xed, 0x61, 0xb7, 0xf9, 0xf6, 0x70,
+	0x08, 0xfd, 0x24, 0x2c, 0xab, 0x07, 0x25, 0x8b, 0xa4, 0x1f, 0xa5, 0x61, 0xff, 0xdf, 0x3b, 0xb0,
+	0x24, 0x9b, 0xd7, 0x97, 0xfc, 0x8b, 0x69, 0xaa, 0x52, 0xe0, 0xfc, 0x5b, 0x4c, 0x7e, 0x54, 0x50,
+	0x74, 0x20, 0xb4, 0x22, 0x52, 0xe0, 0x36, 0x52, 0x5c, 0x5c, 0x1f, 0x25, 0x61, 0x3c, 0x61, 0x91,
+	0xb8, 0x9f, 0x2f, 0x64, 0xa9, 0x61, 0xd5, 0x2b, 0x05, 0xd4, 0x8f, 0x90, 0x46, 0x81, 0xfe, 0x9f,
+	0x3b, 0xb0, 0xaa, 0x75, 0x29, 0x87, 0xe2, 0xd5, 0xda, 0x50, 0x6c, 0xd1, 0xa1, 0x20, 0x3a, 0xd3,
+	0xc7, 0xa5, 0xa6, 0x18, 0x9d, 0x56, 0x31, 0xb6, 0x61, 0x30, 0x2d, 0x6d, 0x49, 0x0d, 0x82, 0x9f,
+	0x5e, 0xf1, 0x68, 0x22, 0x8a, 0x85, 0x9c, 0x04, 0xe3, 0xbe, 0x24, 0x92, 0x52, 0x65, 0xed, 0xd6,
+	0x35, 0x55, 0xa5, 0xc8, 0x54, 0x95, 0x7e, 0x40, 0x8e, 0xd5, 0x78, 0x71, 0xe2, 0x99, 0x7c, 0x4b,
+	0x9a, 0x92, 0xea, 0xea, 0x94, 0x94, 0xbf, 0x05, 0x1b, 0x35, 0x9e, 0xd2, 0x7c, 0x6e, 0xc0, 0x95,
+	0x80, 0x25, 0x59, 0x18, 0xc9, 0xa5, 0x2a, 0x0f, 0xa7, 0x1f, 0xc0, 0x55, 0x1b, 0xfd, 0x15, 0x1b,
+	0xa1, 0x7b, 0xdc, 0x0c, 0xbc, 0xcc, 0xf8, 0xd1, 0x0f, 0x3f, 0xae, 0x73, 0x90, 0xe3, 0xe3, 0xc1,
+	0x42, 0x98, 0xe7, 0x49, 0xcc, 0x74, 0xf2, 0x5d, 0x82, 0xee, 0x9b, 0x38, 0x69, 0x45, 0x3b, 0x32,
+	0x64, 0xa4, 0xe2, 0xbc, 0x6d, 0xa2, 0x04, 0x9a, 0xd8, 0x4f, 0xf1, 0x02, 0xe3, 0x87, 0x21, 0x86,
+	0x87, 0xce, 0x0e, 0x45, 0x10, 0xe6, 0xf2, 0xb7, 0x29, 0x4d, 0xe6, 0x58, 0x1c, 0x28, 0x04, 0x80,
+	0x6b, 0xa0, 0x62, 0x93, 0x3c, 0x51, 0x79, 0xc4, 0x7e, 0xa0, 0x61, 0xff, 0x77, 0x0e, 0x6c, 0xd6,
+	0x1b, 0x94, 0xf1, 0x80, 0xd7, 0xed, 0xa8, 0x90, 0x99, 0x7e, 0x75, 0x7a, 0x1d, 0x2e, 0x42, 0xb9,
+	0x78, 0x93, 0xea, 0xd4, 0x23, 0x21, 0x54, 0xd4, 0xa3, 0x2c, 0xab, 0xcc, 0x51, 0x47, 0x81, 0xb8,
+	0xe4, 0xc6, 0x71, 0xa5, 0x66, 0x19, 0xff, 0xe6, 0xbd, 0x8b, 0xcb, 0x92, 0x95, 0xf2, 0x60, 0x23,
+	0x21, 0xc4, 0x33, 0xf1, 0x86, 0x45, 0x1c, 0x63, 0x24, 0xe4, 0xdf, 0x26, 0x5d, 0x90, 0x27, 0x7f,
+	0x39, 0xcd, 0x3c, 0x13, 0x28, 0x96, 0x66, 0x51, 0x82, 0xb8, 0xf6, 0xb7, 0x1a, 0x95, 0x8c, 0x0b,
+	0x2e, 0xc2, 0xa0, 0xea, 0x50, 0x22, 0x20, 0xf7, 0x6d, 0xe8, 0xcb, 0x8e, 0xaa, 0x38, 0xe3, 0xf3,
+	0x33, 0x34, 0x22, 0x19, 0x6a, 0x72, 0x5c, 0x70, 0xc7, 0x61, 0x92, 0x3c, 0x0a, 0x47, 0x8f, 0xf5,
+	0x82, 0xd3, 0x08, 0xbc, 0x77, 0x6a, 0x7a, 0xf0, 0x20, 0x8f, 0x88, 0x73, 0xb7, 0x09, 0xf3, 0xe1,
+	0x88, 0x5f, 0x2a, 0x90, 0xb2, 0x08, 0x88, 0x0e, 0x4e, 0xe7, 0x92, 0x83, 0x83, 0x93, 0x23, 0x9b,
+	0xa6, 0x95, 0x9e, 0x1c, 0x08, 0x50, 0x15, 0xf5, 0x6c, 0x15, 0xdd, 0x87, 0xad, 0x86, 0x50, 0x52,
+	0x43, 0x54, 0x13, 0xce, 0x33, 0x69, 0xc2, 0x5f, 0x83, 0x15, 0xf9, 0xc2, 0x5e, 0xad, 0xcf, 0x9f,
+	0xc2, 0xaa, 0xc6, 0x98, 0x85, 0x75, 0x2a, 0x50, 0x6a, 0xdc, 0x24, 0x58, 0x7b, 0xb5, 0xdf, 0xa9,
+	0xbf, 0xda, 0xf7, 0xef, 0xc2, 0x15, 0x99, 0x82, 0xa9, 0xdd, 0x69, 0x36, 0x49, 0x1b, 0xe7, 0xe2,
+	0xa4, 0x8d, 0x7f, 0x0b, 0x5c, 0x8b, 0xcd, 0x79, 0xe7, 0x9a, 0x2f, 0x61, 0x5d, 0xd2, 0xee, 0x46,
+	0xd1, 0xb9, 0xa4, 0x96, 0x18, 0x9d, 0x4b, 0x88, 0x71, 0x15, 0x5c, 0xca, 0x5a, 0xda, 0x39, 0xd3,
+	0xe0, 0x3e, 0x4b, 0xfe, 0xa9, 0x1a, 0xe4, 0xac, 0x65, 0x83, 0x3f, 0x83, 0xab, 0x12, 0x6b, 0x4f,
+	0xce, 0x6f, 0xa7, 0xcd, 0x2d, 0xd8, 0xa8, 0x71, 0x97, 0xcd, 0xee, 0xc0, 0x26, 0xc9, 0x65, 0x5d,
+	0x3c, 0x10, 0x9f, 0xc3, 0x56, 0x83, 0x5e, 0x8e, 0xbf, 0xcc, 0x98, 0xdd, 0x53, 0x19, 0x33, 0xe7,
+	0xfc, 0x8c, 0x99, 0xa2, 0xf3, 0xc7, 0xe0, 0x91, 0xc2, 0x7b, 0x59, 0x14, 0x1f, 0x9f, 0x9d, 0xdf,
+	0xfb, 0x7a, 0x4b, 0x9d, 0x4b, 0xb6, 0xf4, 0x1c, 0x5c, 0x6b, 0x69, 0x49, 0x6a, 0xe2, 0xdf, 0x39,
+	0xa8, 0x8a, 0xe8, 0x88, 0x55, 0xe6, 0xde, 0xcc, 0xb9, 0x52, 0xf0, 0x2b, 0x32, 0x32, 0x68, 0x65,
+	0x7e, 0xc4, 0x89, 0xa0, 0xec, 0x4b, 0x3a, 0xdd, 0x67, 0xf8, 0xb5, 0x8d, 0x6b, 0xb0, 0xd5, 0x10,
+	0x45, 0x8a, 0xf9, 0x9b, 0x0e, 0x2c, 0x7c, 0x6a, 0xe2, 0x74, 0xad, 0xd1, 0xe4, 0xe9, 0xa3, 0x94,
+	0x55, 0x3a, 0x9a, 0xcc, 0x21, 0x7a, 0xc9, 0xa8, 0x6b, 0x5f, 0x32, 0x32, 0x57, 0x9c, 0x7a, 0xd6,
+	0x15, 0xa7, 0xe6, 0x45, 0xa5, 0xeb, 0x00, 0x93, 0xb0, 0xfc, 0xc5, 0x14, 0x8f, 0x2d, 0x4c, 0x06,
+	0xcb, 0x09, 0x86, 0x24, 0xc5, 0x17, 0xac, 0xa4, 0xb8, 0x94, 0xb7, 0x35, 0x29, 0x4e, 0x7e, 0xf4,
+	0xa2, 0x6f, 0xfd, 0xe8, 0xc5, 0x37, 0x49, 0xe2, 0x7d, 0x09, 0xab, 0xb2, 0xcd, 0xbb, 0x69, 0x24,
+	0x2e, 0x35, 0x7f, 0xdd, 0x31, 0x14, 0x71, 0xce, 0xae, 0x8a, 0x73, 0xfa, 0x13, 0x58, 0x94, 0xac,
+	0xf9, 0xaf, 0x83, 0xdc, 0x34, 0x61, 0x4f, 0x3b, 0x51, 0x23, 0x89, 0x4c, 0x18, 0xf4, 0x0d, 0x18,
+	0x30, 0x29, 0x8c, 0x9a, 0xb1, 0x9b, 0x36, 0xad, 0x92, 0x35, 0x30, 0x84, 0xe8, 0x58, 0xc9, 0xd2,
+	0x46, 0x82, 0xe8, 0x72, 0xed, 0xfa, 0xbb, 0xb0, 0x51, 0xe3, 0x60, 0x9e, 0xc8, 0x5d, 0x92, 0xc5,
+	0x55, 0x70, 0x25, 0x8e, 0x18, 0x08, 0x3c, 0xdb, 0x58, 0x58, 0x7d, 0xb6, 0xe9, 0xa7, 0xf4, 0xb6,
+	0x47, 0x93, 0xaf, 0x2e, 0xf7, 0x5f, 0xd6, 0xb2, 0x1d, 0xa4, 0x65, 0xce, 0x46, 0x15, 0x39, 0x48,
+	0xd6, 0x67, 0xb6, 0xff, 0x21, 0x6c, 0xd6, 0x89, 0x65, 0x93, 0x3f, 0xa8, 0xf7, 0xc4, 0xb5, 0x5b,
+	0x14, 0x0f, 0xfe, 0x54, 0x6f, 0x6e, 0x69, 0x95, 0x36, 0xde, 0xba, 0x34, 0xda, 0xdc, 0x82, 0x8d,
+	0x1a, 0xad, 0x5c, 0x86, 0xe2, 0x69, 0x22, 0x75, 0xb7, 0xcf, 0x7b, 0x9a, 0x48, 0x5d, 0xe8, 0x67,
+	0xc8, 0x98, 0x7d, 0x20, 0xa2, 0x79, 0x56, 0xc8, 0xb1, 0x7d, 0x1e, 0x9b, 0x70, 0x62, 0xc7, 0x0a,
+	0x27, 0x5e, 0x81, 0x75, 0xc2, 0xc1, 0x8a, 0x26, 0x1e, 0x62, 0x13, 0x97, 0x89, 0x26, 0x4a, 0x42,
+	0x59, 0x59, 0x64, 0x16, 0x1f, 0xa4, 0xf9, 0xc5, 0xd5, 0xaf, 0x82, 0x4b, 0x49, 0x25, 0x83, 0xaf,
+	0x38, 0xd3, 0xcb, 0x6c, 0x72, 0x24, 0x09, 0xda, 0x39, 0x3f, 0x09, 0x6a, 0x4e, 0xc4, 0x5d, 0x7a,
+	0x22, 0xf6, 0x3f, 0x87, 0x55, 0xdd, 0xd6, 0xde, 0x38, 0x4c, 0x4f, 0x98, 0xfe, 0x99, 0x22, 0x87,
+	0xfc, 0x4c, 0x91, 0x1a, 0xf9, 0x8e, 0x6d, 0x47, 0xa5, 0x53, 0xd8, 0xa5, 0x4e, 0xa1, 0x7f, 0x17,
+	0xd6, 0x35, 0x4b, 0x3d, 0xa4, 0xaf, 0xc1, 0xc2, 0x88, 0xb3, 0x57, 0x53, 0x7e, 0xd3, 0x5c, 0xd6,
+	0xa1, 0xad, 0x07, 0x8a, 0xcc, 0xff, 0x9f, 0x0e, 0x17, 0x6d, 0x37, 0xcf, 0x13, 0xbd, 0xd9, 0xf9,
+	0x32, 0xed, 0x62, 0xaf, 0x1a, 0xd5, 0x59, 0x5e, 0x46, 0x7a, 0xda, 0xa9, 0x9f, 0xfd, 0x9b, 0x11,
+	0x0c, 0x71, 0xae, 0x17, 0xf6, 0x54, 0x9d, 0x4a, 0x14, 0xcc, 0x7f, 0xf2, 0x33, 0x4c, 0x31, 0x7e,
+	0x71, 0x47, 0xfd, 0x56, 0xa0, 0x41, 0xf8, 0xff, 0xd6, 0x81, 0x15, 0x23, 0xdf, 0x39, 0x4f, 0x5b,
+	0x8d, 0x9e, 0x3a, 0x96, 0xf3, 0x4c, 0x54, 0xd2, 0xbd, 0x94, 0x4a, 0x90, 0x3f, 0x3f, 0x6c, 0xe8,
+	0xf0, 0x19, 0x02, 0xfe, 0x1e, 0xac, 0x11, 0x39, 0xd4, 0x99, 0x7d, 0xa1, 0xe0, 0x32, 0xd5, 0x7f,
+	0xeb, 0xc1, 0x96, 0x38, 0x50, 0x54, 0xfe, 0x6f, 0x1d, 0xce, 0x45, 0x6c, 0x27, 0xe7, 0x4f, 0xba,
+	0x21, 0xf4, 0xb3, 0x53, 0x56, 0x14, 0x71, 0xa4, 0xe2, 0x28, 0x1a, 0x76, 0xdf, 0xad, 0xfd, 0x4a,
+	0xdc, 0xf7, 0x4c, 0xb3, 0x16, 0xeb, 0x6f, 0xfb, 0x95, 0xad, 0x58, 0xc5, 0xaa, 0x89, 0x7a, 0x4e,
+	0xa0, 0x3a, 0xbf, 0x47, 0xfe, 0x4f, 0x60, 0xcd, 0x10, 0xea, 0xf7, 0x91, 0xfd, 0x5c, 0xe2, 0x6a,
+	0x3f, 0x38, 0xa4, 0x49, 0x35, 0x01, 0x26, 0xa2, 0x0f, 0xd1, 0x99, 0x92, 0x76, 0xff, 0x35, 0x58,
+	0x12, 0xa0, 0x09, 0x57, 0x8f, 0xcf, 0x72, 0x56, 0x10, 0x76, 0x83, 0x80, 0xa2, 0xfc, 0x31, 0x0d,
+	0x39, 0x5f, 0xc2, 0x9a, 0x5d, 0xfc, 0xf3, 0x98, 0xb3, 0xd2, 0x27, 0x34, 0xf0, 0x5b, 0xb3, 0x7a,
+	0xbf, 0x84, 0xb5, 0xfb, 0xf7, 0xbf, 0x0c, 0x58, 0x19, 0xff, 0x92, 0x7d, 0x2b, 0xe9, 0x2e, 0xe3,
+	0xd6, 0xcd, 0x05, 0x02, 0x40, 0xea, 0xb1, 0xb8, 0x62, 0x2c, 0x1f, 0x63, 0x08, 0x08, 0x07, 0x90,
+	0xb4, 0x2d, 0x04, 0xba, 0xfd, 0xf7, 0xcf, 0xc3, 0xe0, 0x70, 0xfa, 0x28, 0x89, 0x47, 0xbb, 0x87,
+	0x07, 0xee, 0x3b, 0xfc, 0xf7, 0xd5, 0xf8, 0xbd, 0xc9, 0x8d, 0xfa, 0x83, 0x69, 0x2e, 0xec, 0x70,
+	0xb3, 0x8e, 0x96, 0x1d, 0xfb, 0x67, 0xee, 0x07, 0xfc, 0x77, 0xfe, 0xc4, 0xf6, 0xee, 0x6e, 0x19,
+	0x32, 0xcb, 0x65, 0x18, 0x7a, 0xcd, 0x02, 0xcd, 0xe1, 0x1d, 0xf3, 0xeb, 0x6e, 0x1b, 0xb5, 0x87,
+	0xf2, 0xcd, 0xd6, 0xe9, 0x2d, 0x04, 0xdd, 0xba, 0xbc, 0x62, 0x46, 0x5a, 0xb7, 0x76, 0xd7, 0xa1,
+	0xd7, 0x2c, 0xd0, 0x1c, 0xde, 0x57, 0x3f, 0x25, 0x86, 0xb7, 0xb4, 0xad, 0x79, 0xa8, 0x33, 0x1b,
+	0xc3, 0xad, 0x06, 0xbe, 0x26, 0x3c, 0x7f, 0x33, 0xb4, 0x41, 0xa9, 0xb2, 0xbc, 0x45, 0x78, 0x2b,
+	0x9a, 0xa5, 0x84, 0x97, 0x6f, 0xba, 0x68, 0x1b, 0x74, 0x9a, 0x0e, 0xbd, 0x66, 0x41, 0x4d, 0x78,
+	0xbe, 0x49, 0x52, 0xe1, 0xe9, 0xf6, 0x3a, 0xdc, 0x6a, 0xe0, 0x75, 0xf5, 0x3d, 0x00, 0xb3, 0x49,
+	0xba, 0xa4, 0x21, 0x7b, 0x8b, 0x1d, 0x5e, 0x6b, 0x29, 0xa9, 0xf5, 0x42, 0x98, 0x55, 0xda, 0x0b,
+	0x6b, 0x97, 0x1d, 0x7a, 0xcd, 0x82, 0x5a, 0x2f, 0xb8, 0xf1, 0xa4, 0xbd, 0xa0, 0xfb, 0xd3, 0x70,
+	0xab, 0x81, 0xd7, 0xd5, 0xdf, 0x85, 0x79, 0x91, 0x63, 0x75, 0x55, 0x4a, 0xcc, 0xca, 0xe4, 0x0e,
+	0x37, 0x6a, 0x58, 0x55, 0xf1, 0xa6, 0xf3, 0x9a, 0xe3, 0x7e, 0x42, 0x7e, 0xd4, 0x97, 0x2f, 0x80,
+	0xe7, 0xda, 0x9f, 0xc4, 0x0b, 0x56, 0xdb, 0xed, 0x85, 0x5a, 0x94, 0x4f, 0xea, 0x3f, 0x11, 0xfc,
+	0x5c, 0xeb, 0x7b, 0xf6, 0x59, 0xdc, 0x9a, 0x93, 0x5b, 0xbf, 0xde, 0x76, 0xad, 0xd8, 0x30, 0x95,
+	0xc9, 0x6b, 0x16, 0x68, 0x0e, 0x6f, 0xc2, 0xbc, 0x78, 0x75, 0xae, 0x55, 0x63, 0x3d, 0x73, 0x1f,
+	0x6e, 0xd4, 0xb0, 0x64, 0x66, 0x2c, 0x1d, 0xb1, 0x4a, 0x1b, 0x7e, 0x3a, 0xae, 0xd6, 0x6e, 0x33,
+	0xf4, 0x9a, 0x05, 0xcd, 0xa5, 0x85, 0x31, 0xbf, 0xba, 0x89, 0x6f, 0x5d, 0x5a, 0x15, 0xad, 0xfe,
+	0x29, 0x1d, 0x9a, 0xec, 0xa4, 0x6c, 0x19, 0x1a, 0x73, 0x91, 0x6b, 0xb8, 0xdd, 0x5e, 0xa8, 0xb8,
+	0xbd, 0xe6, 0xb8, 0x01, 0xf9, 0xed, 0x13, 0x69, 0xaf, 0x9e, 0xaf, 0x57, 0xb2, 0xad, 0xd6, 0xf5,
+	0x59, 0xc5, 0x5a, 0xc6, 0xcf, 0xc8, 0x8f, 0x4d, 0x0b, 0x1b, 0xb2, 0xdd, 0xf2, 0xeb, 0xa1, 0xc6,
+	0x92, 0x3c, 0x3f, 0xa3, 0x54, 0x33, 0xa4, 0x42, 0x8a, 0xac, 0x64, 0x53, 0x48, 0x2b, 0x3f, 0x3a,
+	0xbc, 0x3e, 0xab, 0xb8, 0x95, 0xa7, 0xb4, 0x36, 0x4d, 0x39, 0x2c, 0x9b, 0x73, 0x7d, 0x56, 0x71,
+	0xeb, 0x4c, 0xe7, 0xd6, 0xef, 0xb9, 0x66, 0xcf, 0x8c, 0x0d, 0xdc, 0x6e, 0x2f, 0x9c, 0xd1, 0x6b,
+	0x6e, 0xcc, 0x5b, 0x7a, 0x4d, 0x4d, 0xfa, 0xf5, 0x59, 0xc5, 0xd4, 0xb8, 0x99, 0x0b, 0x29, 0xda,
+	0xb8, 0x35, 0xae, 0xc1, 0x0c, 0xaf, 0xb5, 0x94, 0x68, 0x26, 0xfb, 0x30, 0xd0, 0x77, 0x48, 0xf4,
+	0x22, 0xa8, 0xdf, 0x5c, 0x19, 0x7a, 0xcd, 0x02, 0xcb, 0xc8, 0x48, 0x51, 0xa4, 0xee, 0x2d, 0x6a,
+	0x4b, 0xed, 0xd7, 0x5a, 0x4a, 0xc8, 0x4e, 0x33, 0x2f, 0xee, 0x2e, 0xe8, 0xb5, 0x6c, 0x5d, 0x65,
+	0x18, 0xb6, 0x62, 0xa5, 0x00, 0x07, 0xb0, 0x48, 0x2e, 0x2c, 0xb8, 0xd7, 0x48, 0xb4, 0xca, 0xbe,
+	0xc4, 0x30, 0x9c, 0x5d, 0x24, 0x59, 0xbd, 0x0e, 0x3d, 0xfe, 0x7b, 0x5c, 0x2e, 0xf9, 0x5d, 0x78,
+	0x25, 0xff, 0x15, 0x0b, 0x47, 0xed, 0x98, 0xf6, 0x40, 0xb4, 0x12, 0xeb, 0xfe, 0xd0, 0xd0, 0x6b,
+	0x16, 0x68, 0x0e, 0x1f, 0xc2, 0x22, 0x09, 0xd7, 0xba, 0xb5, 0x27, 0x32, 0xd4, 0xa2, 0x0d, 0xdb,
+	0x8a, 0xe8, 0x9c, 0x30, 0xf1, 0x56, 0x3d, 0x10, 0x8d, 0xe8, 0xee, 0xf0, 0x5a, 0x4b, 0x09, 0x11,
+	0x66, 0xd9, 0xc4, 0x50, 0x19, 0x99, 0x5b, 0x8d, 0xa0, 0xed, 0xf0, 0x5a, 0x4b, 0x09, 0x5d, 0x42,
+	0x56, 0x5c, 0x54, 0x2f, 0xa1, 0xb6, 0x58, 0xec, 0x70, 0xbb, 0xbd, 0x90, 0x2e, 0xa1, 0x5a, 0x70,
+	0x54, 0x2f, 0xa1, 0xf6, 0x20, 0xeb, 0xf0, 0xfa, 0xac, 0x62, 0xcd, 0xf3, 0x01, 0xac, 0x90, 0x42,
+	0x54, 0xd9, 0x77, 0x9a, 0x75, 0xac, 0xa0, 0xe9, 0xf0, 0xc6, 0x6c, 0x82, 0x19, 0x6c, 0xf7, 0x59,
+	0xf2, 0xed, 0xb0, 0x0d, 0x60, 0xb5, 0x16, 0xb8, 0x24, 0x1a, 0x68, 0x8b, 0xad, 0x0e, 0xaf, 0xcf,
+	0x2a, 0xa6, 0x63, 0x64, 0x05, 0xb0, 0xf4, 0x18, 0xb5, 0x05, 0xc6, 0x86, 0xdb, 0xed, 0x85, 0x74,
+	0x1a, 0x93, 0xa8, 0x95, 0x9e, 0xc6, 0xcd, 0xf8, 0xd6, 0x70, 0xd8, 0x56, 0x44, 0x77, 0x1d, 0x3b,
+	0x1a, 0xe5, 0x6e, 0xd7, 0x83, 0x4e, 0x34, 0xa2, 0x35, 0x7c, 0x7e, 0x46, 0x69, 0x4b, 0x37, 0xa5,
+	0xf5, 0xad, 0x75, 0xd3, 0xb6, 0xbd, 0xdb, 0xed, 0x85, 0x9a, 0xdb, 0x1d, 0x18, 0xe8, 0x6b, 0x1c,
+	0xb6, 0xdf, 0x42, 0xee, 0x8e, 0x0c, 0xbd, 0x66, 0x01, 0xd9, 0xac, 0x0d, 0x8f, 0x72, 0x5c, 0xe7,
+	0x51, 0x8e, 0x67, 0xf0, 0x28, 0xc7, 0x16, 0x8f, 0x0f, 0xe5, 0x1d, 0x0a, 0xd9, 0xa7, 0x6b, 0x94,
+	0xd8, 0xee, 0xd1, 0xb0, 0xad, 0x88, 0xfa, 0xf8, 0x32, 0xf7, 0xad, 0x7d, 0x7c, 0xfb, 0x56, 0xc4,
+	0x70, 0xb3, 0x8e, 0xd6, 0x75, 0x5f, 0x87, 0xde, 0x21, 0xcf, 0x83, 0xaa, 0xa9, 0x66, 0x0e, 0xb3,
+	0xc3, 0x2b, 0x16, 0x8e, 0x56, 0xe1, 0xbe, 0xa3, 0xaa, 0x42, 0x5d, 0xc6, 0x2b, 0x16, 0x8e, 0x4a,
+	0xa8, 0x7e, 0xd6, 0x5a, 0xbb, 0x74, 0x56, 0x12, 0x6e, 0xb8, 0x59, 0x47, 0xd3, 0x65, 0x53, 0x4b,
+	0x94, 0xba, 0x8d, 0x64, 0x9f, 0x95, 0x75, 0x1d, 0x5e, 0x9f, 0x55, 0xdc, 0xca, 0x53, 0x1a, 0xb7,
+	0x06, 0x4f, 0xdb, 0xbc, 0x5d, 0x9f, 0x55, 0xac, 0x79, 0x1e, 0xc0, 0x12, 0xcd, 0xad, 0xbb, 0xc3,
+	0xd6, 0x84, 0xbb, 0xe0, 0xd6, 0x9e, 0x8c, 0x57, 0xac, 0x1e, 0xcd, 0xf3, 0xf7, 0x13, 0x3f, 0xfc,
+	0xc7, 0x01, 0x00, 0x9c, 0xa5, 0x5e, 0xb6, 0x87, 0x67, 0x00, 0x00,
 }
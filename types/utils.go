@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -14,7 +15,7 @@ func (p *UserPod) LookupContainer(idOrName string) *UserContainer {
 	if p == nil {
 		return nil
 	}
-	for _, c := range p.Containers {
+	for _, c := range p.AllContainers() {
 		if c.Id == idOrName || c.Name == idOrName {
 			return c
 		}
@@ -22,6 +23,13 @@ func (p *UserPod) LookupContainer(idOrName string) *UserContainer {
 	return nil
 }
 
+// AllContainers returns the init containers followed by the containers.
+func (p *UserPod) AllContainers() []*UserContainer {
+	all := make([]*UserContainer, 0, len(p.InitContainers)+len(p.Containers))
+	all = append(all, p.InitContainers...)
+	return append(all, p.Containers...)
+}
+
 // CloneGlobalPart() clone the static part of a pod spec, and leave the remains
 // empty.
 func (p *UserPod) CloneGlobalPart() *UserPod {
@@ -36,6 +44,15 @@ func (p *UserPod) CloneGlobalPart() *UserPod {
 		Dns:           p.Dns,
 		PortmappingWhiteLists: p.PortmappingWhiteLists,
 
+		AutoRemove:       p.AutoRemove,
+		TtlAfterFinished: p.TtlAfterFinished,
+		Runtime:          p.Runtime,
+		Priority:         p.Priority,
+		NetworkPolicy:    p.NetworkPolicy,
+		DnsOptions:       p.DnsOptions,
+		DnsSearch:        p.DnsSearch,
+		HostAliases:      p.HostAliases,
+
 		Labels:     map[string]string{},
 		Containers: []*UserContainer{},
 		Files:      []*UserFile{},
@@ -45,6 +62,45 @@ func (p *UserPod) CloneGlobalPart() *UserPod {
 	}
 }
 
+const (
+	POLICY_INGRESS = "Ingress"
+	POLICY_EGRESS  = "Egress"
+)
+
+// IsolateIngress tells whether the traffic into the pod is restricted by
+// the ingress rules.
+func (np *NetworkPolicy) IsolateIngress() bool {
+	if np == nil {
+		return false
+	}
+	if len(np.PolicyTypes) == 0 {
+		return true
+	}
+	for _, t := range np.PolicyTypes {
+		if t == POLICY_INGRESS {
+			return true
+		}
+	}
+	return false
+}
+
+// IsolateEgress tells whether the traffic from the pod is restricted by the
+// egress rules.
+func (np *NetworkPolicy) IsolateEgress() bool {
+	if np == nil {
+		return false
+	}
+	if len(np.PolicyTypes) == 0 {
+		return len(np.Egress) > 0
+	}
+	for _, t := range np.PolicyTypes {
+		if t == POLICY_EGRESS {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *UserPod) ReorganizeContainers(allowAbsent bool) error {
 	if p.Log == nil {
 		p.Log = &PodLogConfig{}
@@ -71,7 +127,7 @@ func (p *UserPod) ReorganizeContainers(allowAbsent bool) error {
 		files[file.Name] = file
 	}
 
-	for idx, c := range p.Containers {
+	for idx, c := range p.AllContainers() {
 
 		if c.Name == "" {
 			_, img, _ := utils.ParseImageRepoTag(c.Image)
@@ -79,7 +135,11 @@ func (p *UserPod) ReorganizeContainers(allowAbsent bool) error {
 				img = ""
 			}
 
-			c.Name = fmt.Sprintf("%s-%s-%d", p.Id, img, idx)
+			if idx < len(p.InitContainers) {
+				c.Name = fmt.Sprintf("%s-%s-init-%d", p.Id, img, idx)
+			} else {
+				c.Name = fmt.Sprintf("%s-%s-%d", p.Id, img, idx-len(p.InitContainers))
+			}
 		}
 
 		if p.Tty && !c.Tty {
@@ -214,7 +274,7 @@ func (pm *PortMapping) EqualTo(other *PortMapping) bool {
 	} else if other == nil || pm == nil {
 		return false
 	}
-	return pm.Protocol == other.Protocol && pm.ContainerPort == other.ContainerPort && pm.HostPort == other.ContainerPort
+	return pm.Protocol == other.Protocol && pm.ContainerPort == other.ContainerPort && pm.HostPort == other.HostPort
 }
 
 func (pm *PortMapping) SameDestWith(other *PortMapping) bool {
@@ -397,3 +457,40 @@ func (c *UserContainer) ToPodPortmappings(ignoreError bool) ([]*PortMapping, err
 	}
 	return result, nil
 }
+
+// UnmarshalJSON sets WeightSet if the weight is in the JSON, so that the
+// weight 0 in the pod files drains the backend rather than defaults to 1.
+func (b *UserServiceBackend) UnmarshalJSON(data []byte) error {
+	type backend UserServiceBackend
+	if err := json.Unmarshal(data, (*backend)(b)); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for k, v := range fields {
+		if strings.EqualFold(k, "weight") && string(v) != "null" {
+			b.WeightSet = true
+		}
+	}
+	return nil
+}
+
+// UnmarshalYAML sets WeightSet if the weight is in the YAML, as the JSON.
+func (b *UserServiceBackend) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type backend UserServiceBackend
+	if err := unmarshal((*backend)(b)); err != nil {
+		return err
+	}
+	var fields map[string]interface{}
+	if err := unmarshal(&fields); err != nil {
+		return err
+	}
+	for k, v := range fields {
+		if strings.EqualFold(k, "weight") && v != nil {
+			b.WeightSet = true
+		}
+	}
+	return nil
+}
@@ -43,8 +43,21 @@ func (pod *UserPod) Validate() error {
 		}
 	}
 
+	if pod.TtlAfterFinished < 0 {
+		return fmt.Errorf("incorrect ttlAfterFinished %d.", pod.TtlAfterFinished)
+	}
+	if (pod.AutoRemove || pod.TtlAfterFinished > 0) && pod.Type != "job" {
+		return errors.New("autoRemove and ttlAfterFinished are only valid for the job pods")
+	}
+
 	hasGw := false
 	for idx, config := range pod.Interfaces {
+		if config.Network != "" && config.Bridge != "" {
+			return fmt.Errorf("in interface %d, bridge could not be configured with network %s", idx, config.Network)
+		}
+		if err := config.Bandwidth.Validate(); err != nil {
+			return fmt.Errorf("in interface %d, %v", idx, err)
+		}
 		if config.Gateway == "" {
 			continue
 		}
@@ -74,9 +87,14 @@ func (pod *UserPod) Validate() error {
 			return errors.New("Services IP:Port@Protocol combination does not unique")
 		}
 	}
+	for _, srv := range pod.Services {
+		if err := srv.Validate(); err != nil {
+			return err
+		}
+	}
 
 	var permReg = regexp.MustCompile("0[0-7]{3}")
-	for idx, container := range pod.Containers {
+	for idx, container := range pod.AllContainers() {
 
 		if uniq, _ := keySet(container.Volumes); !uniq {
 			return fmt.Errorf("in container %d, volume source are not unique", idx)
@@ -106,6 +124,16 @@ func (pod *UserPod) Validate() error {
 			}
 		}
 
+		for _, hook := range []*UserContainerHook{container.PostStart, container.PreStop} {
+			if err := hook.validate(); err != nil {
+				return fmt.Errorf("in container %d, %v", idx, err)
+			}
+		}
+
+		if err := container.Resources.validate(pod.Resource); err != nil {
+			return fmt.Errorf("in container %d, %v", idx, err)
+		}
+
 		if container.Cache != "" {
 			if _, ok := volume_caches[container.Cache]; !ok {
 				return fmt.Errorf("in volume %d, does not support cache %s.", idx, container.Cache)
@@ -113,6 +141,10 @@ func (pod *UserPod) Validate() error {
 		}
 	}
 
+	if err := validateDependencies(pod); err != nil {
+		return err
+	}
+
 	for idx, v := range pod.Volumes {
 		if v.Format == "" {
 			continue
@@ -139,6 +171,217 @@ func (pod *UserPod) Validate() error {
 		}
 	}
 
+	for idx, alias := range pod.HostAliases {
+		if ip := net.ParseIP(alias.Ip); ip == nil {
+			return fmt.Errorf("in host alias %d, incorrect ip %s.", idx, alias.Ip)
+		}
+		if len(alias.Hostnames) == 0 {
+			return fmt.Errorf("in host alias %d, no hostnames.", idx)
+		}
+		for _, name := range alias.Hostnames {
+			if name == "" || strings.ContainsAny(name, " \t\n") {
+				return fmt.Errorf("in host alias %d, incorrect hostname %q.", idx, name)
+			}
+		}
+	}
+
+	if err := pod.NetworkPolicy.validate(); err != nil {
+		return fmt.Errorf("in network policy, %v", err)
+	}
+
+	return nil
+}
+
+// Validate checks the named network, and fills the default gateway.
+func (n *Network) Validate() error {
+	if !utils.IsDNSLabel(n.Name) {
+		return fmt.Errorf("network name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, n.Name)
+	}
+
+	ip, subnet, err := net.ParseCIDR(n.Subnet)
+	if err != nil || ip.To4() == nil {
+		return fmt.Errorf("incorrect subnet %s of network %s, an IPv4 CIDR is expected.", n.Subnet, n.Name)
+	}
+	if !ip.Equal(subnet.IP) {
+		return fmt.Errorf("incorrect subnet %s of network %s, it should be %s.", n.Subnet, n.Name, subnet)
+	}
+	if ones, bits := subnet.Mask.Size(); bits-ones < 2 {
+		return fmt.Errorf("subnet %s of network %s is too small.", n.Subnet, n.Name)
+	}
+
+	if n.Gateway == "" {
+		gw := make(net.IP, len(subnet.IP.To4()))
+		copy(gw, subnet.IP.To4())
+		gw[len(gw)-1]++
+		n.Gateway = gw.String()
+	}
+	gw := net.ParseIP(n.Gateway)
+	if gw == nil || !subnet.Contains(gw) || gw.Equal(subnet.IP) {
+		return fmt.Errorf("incorrect gateway %s of network %s, it should be an address of %s.", n.Gateway, n.Name, n.Subnet)
+	}
+
+	if len(n.Bridge) > 15 {
+		return fmt.Errorf("bridge name %s of network %s exceeds the maximum length 15", n.Bridge, n.Name)
+	}
+	if n.Mtu != 0 && (n.Mtu < 68 || n.Mtu > 65535) {
+		return fmt.Errorf("incorrect mtu %d of network %s.", n.Mtu, n.Name)
+	}
+	return nil
+}
+
+func validateDependencies(pod *UserPod) error {
+	for idx, container := range pod.InitContainers {
+		if len(container.DependsOn) > 0 {
+			return fmt.Errorf("in init container %d, dependsOn is not supported.", idx)
+		}
+	}
+
+	names := make(map[string]*UserContainer)
+	for _, container := range pod.Containers {
+		if container.Name != "" {
+			names[container.Name] = container
+		}
+	}
+	for idx, container := range pod.Containers {
+		for _, d := range container.DependsOn {
+			dc, ok := names[d.Container]
+			if !ok {
+				return fmt.Errorf("in container %d, dependency %s does not exist in container list.", idx, d.Container)
+			}
+			switch d.DependencyCondition() {
+			case DEPENDENCY_STARTED, DEPENDENCY_COMPLETED:
+			case DEPENDENCY_HEALTHY:
+				if dc.HealthCheck == nil || len(dc.HealthCheck.Command) == 0 {
+					return fmt.Errorf("in container %d, dependency %s has no health check.", idx, d.Container)
+				}
+			default:
+				return fmt.Errorf("in container %d, does not support dependency condition %s.", idx, d.Condition)
+			}
+		}
+	}
+
+	_, err := SortContainerDependencies(pod.Containers)
+	return err
+}
+
+func (hook *UserContainerHook) validate() error {
+	if hook == nil {
+		return nil
+	}
+	if (len(hook.Command) == 0) == (hook.Http == nil) {
+		return errors.New("a lifecycle hook should have either a command or an http request.")
+	}
+	if hook.Http != nil && (hook.Http.Port <= 0 || hook.Http.Port > 65535) {
+		return fmt.Errorf("incorrect http port %d of lifecycle hook.", hook.Http.Port)
+	}
+	return nil
+}
+
+func (np *NetworkPolicy) validate() error {
+	if np == nil {
+		return nil
+	}
+	for _, t := range np.PolicyTypes {
+		if t != POLICY_INGRESS && t != POLICY_EGRESS {
+			return fmt.Errorf("does not support policy type %s.", t)
+		}
+	}
+	for dir, rules := range map[string][]*NetworkPolicyRule{"ingress": np.Ingress, "egress": np.Egress} {
+		for idx, rule := range rules {
+			for _, peer := range rule.Peers {
+				if (peer.Cidr == "") == (len(peer.PodSelector) == 0) {
+					return fmt.Errorf("in %s rule %d, a peer should have either a cidr or a podSelector.", dir, idx)
+				}
+				if _, _, err := net.ParseCIDR(peer.Cidr); peer.Cidr != "" && err != nil {
+					return fmt.Errorf("in %s rule %d, incorrect cidr %s.", dir, idx, peer.Cidr)
+				}
+			}
+			for _, port := range rule.Ports {
+				if port.Protocol != "" && port.Protocol != "tcp" && port.Protocol != "udp" {
+					return fmt.Errorf("in %s rule %d, does not support protocol %s.", dir, idx, port.Protocol)
+				}
+				if port.Port < 0 || port.Port > 65535 {
+					return fmt.Errorf("in %s rule %d, incorrect port %d.", dir, idx, port.Port)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the rate limits of an interface, the burst is only valid
+// with the rate.
+func (bw *InterfaceBandwidth) Validate() error {
+	if bw == nil {
+		return nil
+	}
+	if bw.IngressRate == 0 && bw.IngressBurst > 0 {
+		return errors.New("ingressBurst could not be configured without ingressRate.")
+	}
+	if bw.EgressRate == 0 && bw.EgressBurst > 0 {
+		return errors.New("egressBurst could not be configured without egressRate.")
+	}
+	return nil
+}
+
+var (
+	ipvsSchedulers = map[string]bool{
+		"rr": true, "wrr": true, "lc": true, "wlc": true, "lblc": true,
+		"lblcr": true, "dh": true, "sh": true, "sed": true, "nq": true,
+	}
+	serviceForwardModes = map[string]bool{
+		"masquerading": true, "gatewaying": true, "tunneling": true,
+	}
+)
+
+func (srv *UserService) Validate() error {
+	if srv.Name != "" && !utils.IsDNSLabel(srv.Name) {
+		return fmt.Errorf("Service name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, srv.Name)
+	}
+	if srv.Scheduler != "" && !ipvsSchedulers[srv.Scheduler] {
+		return fmt.Errorf("unsupported scheduler %s of service %s:%d", srv.Scheduler, srv.ServiceIP, srv.ServicePort)
+	}
+	if srv.ForwardMode != "" && !serviceForwardModes[srv.ForwardMode] {
+		return fmt.Errorf("unsupported forward mode %s of service %s:%d", srv.ForwardMode, srv.ServiceIP, srv.ServicePort)
+	}
+	if srv.PersistenceTimeout < 0 {
+		return fmt.Errorf("persistenceTimeout of service %s:%d should not be negative", srv.ServiceIP, srv.ServicePort)
+	}
+	for _, b := range srv.Hosts {
+		if b.Weight < 0 {
+			return fmt.Errorf("weight of backend %s:%d should not be negative", b.HostIP, b.HostPort)
+		}
+	}
+	if hc := srv.HealthCheck; hc != nil {
+		if hc.Interval < 0 || hc.Timeout < 0 || hc.UnhealthyThreshold < 0 || hc.HealthyThreshold < 0 {
+			return fmt.Errorf("health check of service %s:%d should not have negative values", srv.ServiceIP, srv.ServicePort)
+		}
+		if strings.ToLower(srv.Protocol) != "tcp" {
+			return fmt.Errorf("only tcp service could be health checked, service %s:%d is %s", srv.ServiceIP, srv.ServicePort, srv.Protocol)
+		}
+	}
+	return nil
+}
+
+func (r *UserContainerResources) validate(pod *UserResource) error {
+	if r == nil {
+		return nil
+	}
+	if r.CpuShares < 0 || r.CpuQuota < 0 || r.CpuPeriod < 0 || r.Memory < 0 || r.PidsLimit < 0 {
+		return errors.New("the resources should not be negative.")
+	}
+	if r.CpuShares > 0 && r.CpuShares < 2 {
+		return fmt.Errorf("incorrect cpuShares %d, the minimum is 2.", r.CpuShares)
+	}
+	if r.CpuQuota > 0 && r.CpuQuota < 1000 {
+		return fmt.Errorf("incorrect cpuQuota %d, the minimum is 1000.", r.CpuQuota)
+	}
+	if r.CpuPeriod > 0 && (r.CpuPeriod < 1000 || r.CpuPeriod > 1000000) {
+		return fmt.Errorf("incorrect cpuPeriod %d, it should be in [1000, 1000000].", r.CpuPeriod)
+	}
+	if pod != nil && pod.Memory > 0 && r.Memory > pod.Memory {
+		return fmt.Errorf("memory limit %dMB exceeds the pod memory %dMB.", r.Memory, pod.Memory)
+	}
 	return nil
 }
 